@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -17,6 +19,7 @@ import (
 	airfaretracing "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/db/tracing"
 	matchclient "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/match"
 	tpclient "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/travelpayouts/http/client"
+	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/lib/metrics"
 	grpcapi "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/transport/grpc"
 	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 	"github.com/redis/go-redis/v9"
@@ -49,7 +52,13 @@ func main() {
 
 	log.Info("airfare-provider starting", zap.String("grpc_addr", fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)))
 
-	matchConn, err := grpc.NewClient(cfg.MatchAdapter.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	matchConn, err := grpc.NewClient(cfg.MatchAdapter.Address(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			airfaretracing.UnaryClientInterceptor("airfare-provider/match-client"),
+			metrics.UnaryClientInterceptor(),
+		),
+	)
 	if err != nil {
 		log.Fatal("failed to connect match-adapter grpc", zap.Error(err), zap.String("addr", cfg.MatchAdapter.Address()))
 	}
@@ -71,6 +80,8 @@ func main() {
 		}
 	}()
 
+	metrics.RegisterRedisPool(redisClient)
+
 	airfareCache := cacheredis.NewAirfareCacheRepository(redisClient)
 	fareSource := tpclient.NewClient(
 		cfg.Travelpayouts.BaseURL,
@@ -88,15 +99,38 @@ func main() {
 		matchDayWindowPolicyFromConfig(cfg.MatchDayWindows),
 	)
 
-	app := grpcapp.New(log, cfg.GRPC.Host, cfg.GRPC.Port, func(s *grpc.Server) {
+	trustedPeers, err := grpcapp.ParseTrustedPeers(cfg.GRPC.TrustedPeers)
+	if err != nil {
+		log.Fatal("invalid grpc trusted peers", zap.Error(err))
+	}
+
+	app := grpcapp.New(log, cfg.GRPC.Host, cfg.GRPC.Port, trustedPeers, func(s *grpc.Server) {
 		grpcapi.Register(s, log, airfareService)
 	})
 
-	errCh := make(chan error, 1)
+	errCh := make(chan error, 2)
 	go func() {
 		errCh <- app.Run()
 	}()
 
+	var metricsSrv *http.Server
+	if cfg.Metrics.Enabled {
+		metricsAddr := fmt.Sprintf("%s:%d", cfg.Metrics.Host, cfg.Metrics.Port)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:    metricsAddr,
+			Handler: mux,
+		}
+
+		log.Info("metrics http starting", zap.String("http_addr", metricsAddr))
+		go func() {
+			if err := metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("metrics http: %w", err)
+			}
+		}()
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -106,7 +140,16 @@ func main() {
 		app.Stop()
 	case err := <-errCh:
 		if err != nil {
-			log.Error("gRPC server stopped", zap.Error(err))
+			log.Error("server stopped", zap.Error(err))
+		}
+		app.Stop()
+	}
+
+	if metricsSrv != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Warn("failed to shutdown metrics http server", zap.Error(err))
 		}
 	}
 }
@@ -4,30 +4,46 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
+	"strings"
 	"time"
 
+	airfaretracing "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/db/tracing"
+	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/lib/metrics"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
 type GrpcApp struct {
-	log        *zap.Logger
-	gRPCServer *grpc.Server
-	addr       string
+	log          *zap.Logger
+	gRPCServer   *grpc.Server
+	healthServer *health.Server
+	addr         string
 }
 
-func New(log *zap.Logger, host string, port int, register func(*grpc.Server)) *GrpcApp {
+func New(log *zap.Logger, host string, port int, trustedPeers []netip.Prefix, register func(*grpc.Server)) *GrpcApp {
 	addr := fmt.Sprintf("%s:%d", host, port)
 
 	gRPCServer := grpc.NewServer(
+		// the gateway pings idle connections every 30s by default
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
 		grpc.ChainUnaryInterceptor(
-			recoveryInterceptor(log),
+			airfaretracing.UnaryServerInterceptor("airfare-provider/grpc"),
+			metrics.UnaryServerInterceptor(),
+
 			loggingInterceptor(log),
+			forwardMetadataInterceptor(trustedPeers),
 		),
 	)
 
@@ -40,9 +56,10 @@ func New(log *zap.Logger, host string, port int, register func(*grpc.Server)) *G
 	reflection.Register(gRPCServer)
 
 	return &GrpcApp{
-		log:        log,
-		gRPCServer: gRPCServer,
-		addr:       addr,
+		log:          log,
+		gRPCServer:   gRPCServer,
+		healthServer: healthServer,
+		addr:         addr,
 	}
 }
 
@@ -65,6 +82,8 @@ func (a *GrpcApp) Run() error {
 
 func (a *GrpcApp) Stop() {
 	a.log.Info("stopping gRPC server", zap.String("addr", a.addr))
+	// health checking clients move their calls to other replicas
+	a.healthServer.Shutdown()
 	a.gRPCServer.GracefulStop()
 }
 
@@ -80,6 +99,12 @@ func loggingInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 			zap.String("code", code.String()),
 			zap.Duration("duration", time.Since(start)),
 		}
+		if id := incomingValue(ctx, requestIDMetadataKey); id != "" {
+			fields = append(fields, zap.String("request_id", id))
+		}
+		if id := airfaretracing.TraceID(ctx); id != "" {
+			fields = append(fields, zap.String("trace_id", id))
+		}
 
 		if err != nil {
 			log.Error("gRPC request failed", append(fields, zap.Error(err))...)
@@ -91,6 +116,101 @@ func loggingInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+const (
+	// clientIPMetadataKey carries the end user address set by the gateway.
+	clientIPMetadataKey = "x-client-ip"
+	// requestIDMetadataKey carries the id of the gateway HTTP request.
+	requestIDMetadataKey = "x-request-id"
+)
+
+// forwardMetadataInterceptor passes the end user address and the request id
+// on to outgoing calls, so that match-adapter limits the user and not this
+// service, and its logs can be matched with the gateway request. The user
+// address is only taken from trusted peers, any other caller is passed on
+// with its own address.
+func forwardMetadataInterceptor(trustedPeers []netip.Prefix) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		host := peerHost(ctx)
+		clientIP := host
+		if isTrustedPeer(host, trustedPeers) {
+			if value := incomingValue(ctx, clientIPMetadataKey); value != "" {
+				clientIP = value
+			}
+		}
+		if clientIP != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, clientIPMetadataKey, clientIP)
+		}
+		if value := incomingValue(ctx, requestIDMetadataKey); value != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, requestIDMetadataKey, value)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ParseTrustedPeers reads the addresses and CIDRs of the services allowed to
+// pass the end user address on.
+func ParseTrustedPeers(values []string) ([]netip.Prefix, error) {
+	const op = "grpcapp.ParseTrustedPeers"
+
+	peers := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if strings.Contains(v, "/") {
+			prefix, err := netip.ParsePrefix(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			peers = append(peers, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		addr = addr.Unmap()
+		peers = append(peers, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return peers, nil
+}
+
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func isTrustedPeer(host string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func incomingValue(ctx context.Context, key string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
 func recoveryInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		defer func() {
@@ -0,0 +1,43 @@
+package grpcapp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestForwardMetadataInterceptor(t *testing.T) {
+	trusted, err := ParseTrustedPeers([]string{"10.0.0.0/24"})
+	if err != nil {
+		t.Fatalf("parse trusted peers: %v", err)
+	}
+	interceptor := forwardMetadataInterceptor(trusted)
+
+	forwarded := func(peerIP string) []string {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 51234},
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientIPMetadataKey, "203.0.113.7", requestIDMetadataKey, "req-1"))
+
+		var out metadata.MD
+		_, _ = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			out, _ = metadata.FromOutgoingContext(ctx)
+			return nil, nil
+		})
+		if got := out.Get(requestIDMetadataKey); len(got) != 1 || got[0] != "req-1" {
+			t.Fatalf("expected the request id to be forwarded, got %v", got)
+		}
+		return out.Get(clientIPMetadataKey)
+	}
+
+	if got := forwarded("10.0.0.5"); len(got) != 1 || got[0] != "203.0.113.7" {
+		t.Fatalf("expected the gateway client ip, got %v", got)
+	}
+	if got := forwarded("198.51.100.9"); len(got) != 1 || got[0] != "198.51.100.9" {
+		t.Fatalf("expected the untrusted peer ip, got %v", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	derr "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/ports"
+	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/lib/metrics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
@@ -15,6 +16,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// moscowLocation is fixed: Moscow has no DST and the service does not ship tzdata.
+var moscowLocation = time.FixedZone("MSK", 3*60*60)
+
 type AirfareService struct {
 	log         *zap.Logger
 	matchReader ports.MatchReader
@@ -143,15 +147,18 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 	if s.cache != nil {
 		cached, err := s.cache.GetByMatchAndOrigin(ctx, matchID, originIATA)
 		if err == nil {
+			metrics.CacheRequests.WithLabelValues(metrics.CacheHit).Inc()
 			logger.Info("airfare cache hit")
 			span.AddEvent("airfare.cache.hit")
 			return cached, nil
 		}
 		if errors.Is(err, derr.ErrAirfareNotFound) {
+			metrics.CacheRequests.WithLabelValues(metrics.CacheMiss).Inc()
 			logger.Info("airfare cache miss")
 			span.AddEvent("airfare.cache.miss")
 		}
 		if !errors.Is(err, derr.ErrAirfareNotFound) {
+			metrics.CacheRequests.WithLabelValues(metrics.CacheError).Inc()
 			logger.Warn("redis cache read failed", zap.Error(err))
 			span.RecordError(err)
 		}
@@ -167,6 +174,11 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 
 	destinationIATA := strings.ToUpper(strings.TrimSpace(match.DestinationIATA))
 	normalizedOrigin := strings.ToUpper(strings.TrimSpace(originIATA))
+	if destinationIATA == "" {
+		logger.Warn("match has no destination airport")
+		span.SetStatus(otelcodes.Error, "no destination")
+		return ports.AirfareByMatch{}, derr.ErrNoDestination
+	}
 	if normalizedOrigin == destinationIATA {
 		logger.Warn(
 			"invalid route: origin equals destination",
@@ -188,16 +200,21 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 	result := ports.AirfareByMatch{
 		MatchID:     match.MatchID,
 		TicketsLink: match.TicketsLink,
-		Slots:       buildDefaultSlots(kickoffUTC),
+		Slots:       buildDefaultSlots(matchDay(kickoffUTC, match.KickoffTentative)),
+	}
+	if match.KickoffTentative {
+		logger.Info("kickoff is not confirmed, searching whole match day")
+		span.AddEvent("airfare.kickoff.unconfirmed")
 	}
 
 	if s.fareSource != nil {
 		sourceCalls := 0
 		sourceFailures := 0
 		for i := range result.Slots {
-			attempts := s.buildFareSearchAttempts(result.Slots[i], normalizedOrigin, destinationIATA, kickoffUTC)
+			attempts := s.buildFareSearchAttempts(result.Slots[i], normalizedOrigin, destinationIATA, kickoffUTC, match.TransferTime, match.KickoffTentative)
 			selectedLevel := ports.WindowLevelStrict
 			selectedPrices := []int64{}
+			slotResult := metrics.SlotFailed
 
 			for attemptIdx, attempt := range attempts {
 				sourceCalls++
@@ -226,6 +243,10 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 				if selectedPrices == nil {
 					selectedPrices = []int64{}
 				}
+				slotResult = metrics.SlotEmpty
+				if len(selectedPrices) > 0 {
+					slotResult = metrics.SlotPriced
+				}
 
 				if len(selectedPrices) > 0 || attemptIdx == len(attempts)-1 {
 					break
@@ -234,6 +255,7 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 
 			result.Slots[i].WindowLevel = selectedLevel
 			result.Slots[i].Prices = selectedPrices
+			metrics.SlotWindowLevels.WithLabelValues(windowLevelToString(selectedLevel), slotResult).Inc()
 		}
 		if sourceCalls > 0 && sourceFailures == sourceCalls {
 			span.SetStatus(otelcodes.Error, "all source calls failed")
@@ -241,6 +263,10 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 		}
 	}
 
+	result.FetchedAt = time.Now().UTC()
+	if s.cache != nil && s.cacheTTL > 0 {
+		result.ExpiresAt = result.FetchedAt.Add(s.cacheTTL)
+	}
 	if s.cache != nil {
 		if err := s.cache.SetByMatchAndOrigin(ctx, matchID, originIATA, result, s.cacheTTL); err != nil {
 			logger.Warn("redis cache write failed", zap.Error(err))
@@ -254,8 +280,16 @@ func (s *AirfareService) GetAirfareByMatch(ctx context.Context, matchID int64, o
 	return result, nil
 }
 
-func buildDefaultSlots(kickoffUTC time.Time) []ports.FareSlot {
-	day := time.Date(kickoffUTC.Year(), kickoffUTC.Month(), kickoffUTC.Day(), 0, 0, 0, 0, time.UTC)
+// matchDay is the UTC day of a confirmed kickoff. A placeholder kickoff only
+// carries the match date, which match-adapter publishes in Moscow time.
+func matchDay(kickoffUTC time.Time, tentative bool) time.Time {
+	if tentative {
+		kickoffUTC = kickoffUTC.In(moscowLocation)
+	}
+	return time.Date(kickoffUTC.Year(), kickoffUTC.Month(), kickoffUTC.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func buildDefaultSlots(day time.Time) []ports.FareSlot {
 
 	return []ports.FareSlot{
 		{Kind: ports.SlotOutDMinus2, Direction: ports.DirectionOut, DateUTC: day.AddDate(0, 0, -2), Prices: []int64{}, WindowLevel: ports.WindowLevelStrict},
@@ -267,7 +301,7 @@ func buildDefaultSlots(kickoffUTC time.Time) []ports.FareSlot {
 	}
 }
 
-func (s *AirfareService) buildFareSearchAttempts(slot ports.FareSlot, originIATA, destinationIATA string, kickoffUTC time.Time) []fareSearchAttempt {
+func (s *AirfareService) buildFareSearchAttempts(slot ports.FareSlot, originIATA, destinationIATA string, kickoffUTC time.Time, transfer time.Duration, kickoffTentative bool) []fareSearchAttempt {
 	base := ports.FareSearch{
 		DateUTC: slot.DateUTC,
 	}
@@ -280,11 +314,28 @@ func (s *AirfareService) buildFareSearchAttempts(slot ports.FareSlot, originIATA
 		base.DestinationIATA = strings.ToUpper(strings.TrimSpace(originIATA))
 	}
 
+	if transfer < 0 {
+		transfer = 0
+	}
+
+	// Arrive-by and depart-after windows around a placeholder kickoff would be
+	// made up, so match day slots search the whole day instead.
+	if kickoffTentative && (slot.Kind == ports.SlotOutD0ArriveBy || slot.Kind == ports.SlotRetD0DepartAfter) {
+		return []fareSearchAttempt{
+			{
+				level:  ports.WindowLevelWholeDay,
+				search: base,
+			},
+		}
+	}
+
+	// Windows are relative to the kickoff at the stadium, so the airport side
+	// is shifted by the airport-stadium transfer.
 	switch slot.Kind {
 	case ports.SlotOutD0ArriveBy:
-		return s.outboundDayMatchAttempts(base, kickoffUTC)
+		return s.outboundDayMatchAttempts(base, kickoffUTC.Add(-transfer))
 	case ports.SlotRetD0DepartAfter:
-		return s.returnDayMatchAttempts(base, kickoffUTC)
+		return s.returnDayMatchAttempts(base, kickoffUTC.Add(transfer))
 	}
 
 	return []fareSearchAttempt{
@@ -394,6 +445,8 @@ func windowLevelToString(level ports.WindowLevel) string {
 		return "SOFT_1"
 	case ports.WindowLevelSoft2:
 		return "SOFT_2"
+	case ports.WindowLevelWholeDay:
+		return "WHOLE_DAY"
 	default:
 		return "UNKNOWN"
 	}
@@ -8,6 +8,8 @@ import (
 
 	derr "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/ports"
+	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/lib/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/zap"
 )
 
@@ -116,6 +118,9 @@ func TestGetAirfareByMatch_NormalizesKickoffToUTC(t *testing.T) {
 	if len(fares.searches) != 6 {
 		t.Fatalf("expected 6 fare searches, got %d", len(fares.searches))
 	}
+	if got.FetchedAt.IsZero() || got.ExpiresAt.Sub(got.FetchedAt) != 10*time.Minute {
+		t.Fatalf("unexpected fetched_at %s and expires_at %s", got.FetchedAt, got.ExpiresAt)
+	}
 	arriveBy := fares.searches[2].ArriveNotLaterUTC
 	if arriveBy == nil || !arriveBy.Equal(time.Date(2026, 2, 27, 17, 30, 0, 0, time.UTC)) {
 		t.Fatalf("unexpected arrive-by constraint: %v", arriveBy)
@@ -168,6 +173,8 @@ func TestGetAirfareByMatch_FallsBackToSoftWindowsForDayMatchSlots(t *testing.T)
 		},
 	}
 	svc := NewAirfareService(zap.NewNop(), reader, fares, cache, 10*time.Minute, DefaultMatchDayWindowPolicy())
+	soft2Before := testutil.ToFloat64(metrics.SlotWindowLevels.WithLabelValues("SOFT_2", metrics.SlotPriced))
+	missBefore := testutil.ToFloat64(metrics.CacheRequests.WithLabelValues(metrics.CacheMiss))
 
 	got, err := svc.GetAirfareByMatch(context.Background(), 16114, "MOW")
 	if err != nil {
@@ -176,6 +183,12 @@ func TestGetAirfareByMatch_FallsBackToSoftWindowsForDayMatchSlots(t *testing.T)
 	if len(got.Slots) != 6 {
 		t.Fatalf("unexpected slots count: got %d want 6", len(got.Slots))
 	}
+	if soft2 := testutil.ToFloat64(metrics.SlotWindowLevels.WithLabelValues("SOFT_2", metrics.SlotPriced)) - soft2Before; soft2 != 2 {
+		t.Fatalf("expected 2 slots counted at SOFT_2, got %v", soft2)
+	}
+	if miss := testutil.ToFloat64(metrics.CacheRequests.WithLabelValues(metrics.CacheMiss)) - missBefore; miss != 1 {
+		t.Fatalf("expected 1 cache miss, got %v", miss)
+	}
 
 	outDayMatch := got.Slots[2]
 	if outDayMatch.WindowLevel != ports.WindowLevelSoft2 {
@@ -236,3 +249,92 @@ func TestGetAirfareByMatch_InvalidRoute_OriginEqualsDestination(t *testing.T) {
 		t.Fatalf("fare source must not be called for invalid route, calls=%d", len(fares.searches))
 	}
 }
+
+func TestGetAirfareByMatch_ShiftsDayMatchWindowsByTransfer(t *testing.T) {
+	reader := &testMatchReader{
+		match: ports.MatchSnapshot{
+			MatchID:         16114,
+			KickoffUTC:      time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC),
+			DestinationIATA: "KGD",
+			TransferTime:    30 * time.Minute,
+		},
+	}
+	fares := &testFareSource{}
+	svc := NewAirfareService(zap.NewNop(), reader, fares, nil, 10*time.Minute, DefaultMatchDayWindowPolicy())
+
+	if _, err := svc.GetAirfareByMatch(context.Background(), 16114, "MOW"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var arriveNotLater, departNotBefore string
+	for _, search := range fares.searches {
+		if search.ArriveNotLaterUTC != nil {
+			arriveNotLater = search.ArriveNotLaterUTC.UTC().Format(time.RFC3339)
+		}
+		if search.DepartNotBeforeUTC != nil {
+			departNotBefore = search.DepartNotBeforeUTC.UTC().Format(time.RFC3339)
+		}
+	}
+	if arriveNotLater != "2026-02-27T17:00:00Z" {
+		t.Fatalf("unexpected strict arrival bound: %s", arriveNotLater)
+	}
+	if departNotBefore != "2026-02-28T00:00:00Z" {
+		t.Fatalf("unexpected strict departure bound: %s", departNotBefore)
+	}
+}
+
+func TestGetAirfareByMatch_NoDestination(t *testing.T) {
+	reader := &testMatchReader{
+		match: ports.MatchSnapshot{
+			MatchID:    18000,
+			KickoffUTC: time.Date(2026, 5, 24, 16, 0, 0, 0, time.UTC),
+		},
+	}
+	fares := &testFareSource{}
+	svc := NewAirfareService(zap.NewNop(), reader, fares, nil, 10*time.Minute, DefaultMatchDayWindowPolicy())
+
+	_, err := svc.GetAirfareByMatch(context.Background(), 18000, "MOW")
+	if !errors.Is(err, derr.ErrNoDestination) {
+		t.Fatalf("expected ErrNoDestination, got %v", err)
+	}
+	if len(fares.searches) != 0 {
+		t.Fatalf("expected no fare searches, got %d", len(fares.searches))
+	}
+}
+
+func TestGetAirfareByMatch_SearchesWholeDayForUnconfirmedKickoff(t *testing.T) {
+	// placeholder kickoff at 00:00 MSK belongs to March 7, not to the UTC day
+	reader := &testMatchReader{
+		match: ports.MatchSnapshot{
+			MatchID:          16300,
+			KickoffUTC:       time.Date(2026, 3, 6, 21, 0, 0, 0, time.UTC),
+			DestinationIATA:  "LED",
+			KickoffTentative: true,
+		},
+	}
+	fares := &testFareSource{}
+	svc := NewAirfareService(zap.NewNop(), reader, fares, nil, 10*time.Minute, DefaultMatchDayWindowPolicy())
+
+	got, err := svc.GetAirfareByMatch(context.Background(), 16300, "MOW")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantDay := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	if !got.Slots[2].DateUTC.Equal(wantDay) {
+		t.Fatalf("unexpected D0 date: got %s want %s", got.Slots[2].DateUTC, wantDay)
+	}
+	for _, slot := range got.Slots[2:4] {
+		if slot.WindowLevel != ports.WindowLevelWholeDay {
+			t.Fatalf("unexpected window level for %v: got %v", slot.Kind, slot.WindowLevel)
+		}
+	}
+	if len(fares.searches) != 6 {
+		t.Fatalf("expected 6 fare searches, got %d", len(fares.searches))
+	}
+	for _, search := range fares.searches {
+		if search.ArriveNotBeforeUTC != nil || search.ArriveNotLaterUTC != nil || search.DepartNotBeforeUTC != nil {
+			t.Fatalf("expected no time bounds, got %+v", search)
+		}
+	}
+}
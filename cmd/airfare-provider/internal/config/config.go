@@ -15,6 +15,7 @@ type Config struct {
 	AirfareCacheTTL time.Duration         `yaml:"airfare_cache_ttl" env:"AIRFARE_CACHE_TTL" env-default:"30m"`
 	Log             LogConfig             `yaml:"log"`
 	GRPC            GRPCConfig            `yaml:"grpc"`
+	Metrics         MetricsConfig         `yaml:"metrics"`
 	DB              DBConfig              `yaml:"db"`
 	Redis           RedisConfig           `yaml:"redis"`
 	MatchAdapter    MatchAdapterConfig    `yaml:"match_adapter"`
@@ -30,6 +31,16 @@ type GRPCConfig struct {
 	Host    string        `yaml:"host" env:"GRPC_HOST"`
 	Port    int           `yaml:"port" env:"GRPC_PORT"`
 	Timeout time.Duration `yaml:"timeout" env:"GRPC_TIMEOUT"`
+	// TrustedPeers are addresses or CIDRs of the gateway. x-client-ip is only
+	// taken from them, other callers are passed on with their own address.
+	TrustedPeers []string `yaml:"trusted_peers" env:"GRPC_TRUSTED_PEERS" env-separator:","`
+}
+
+// MetricsConfig serves Prometheus metrics on /metrics.
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled" env:"METRICS_ENABLED" env-default:"true"`
+	Host    string `yaml:"host" env:"METRICS_HOST" env-default:"0.0.0.0"`
+	Port    int    `yaml:"port" env:"METRICS_PORT" env-default:"9092"`
 }
 
 type DBConfig struct {
@@ -8,4 +8,10 @@ var (
 	ErrMatchNotFound   = errors.New("match not found")
 	ErrSourceTemporary = errors.New("temporary source failure")
 	ErrAirfareNotFound = errors.New("airfare not found")
+	ErrNoDestination   = errors.New("match destination airport is unknown")
+	ErrRateLimited     = errors.New("rate limited by upstream")
+
+	// ErrMatchSourceUnavailable means match-adapter could not load the match,
+	// as opposed to ErrSourceTemporary of the airfare source.
+	ErrMatchSourceUnavailable = errors.New("match source unavailable")
 )
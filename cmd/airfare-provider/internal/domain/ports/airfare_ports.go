@@ -14,6 +14,12 @@ type MatchSnapshot struct {
 	AwayClubID      string
 	City            string
 	Stadium         string
+	// TransferTime is the typical travel time from DestinationIATA to the stadium,
+	// zero when the stadium is not in the dictionary.
+	TransferTime time.Duration
+	// KickoffTentative is true while KickoffUTC is a placeholder and only the
+	// match date is known.
+	KickoffTentative bool
 }
 
 type MatchReader interface {
@@ -47,6 +53,7 @@ const (
 	WindowLevelStrict
 	WindowLevelSoft1
 	WindowLevelSoft2
+	WindowLevelWholeDay
 )
 
 type FareSlot struct {
@@ -61,6 +68,10 @@ type AirfareByMatch struct {
 	MatchID     int64
 	TicketsLink string
 	Slots       []FareSlot
+	// FetchedAt is when the prices were loaded from the source; ExpiresAt is
+	// when the cache drops them, zero if they were not cached.
+	FetchedAt time.Time
+	ExpiresAt time.Time
 }
 
 type AirfareCache interface {
@@ -0,0 +1,127 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor continues the W3C trace context of the caller with a
+// server span named after the gRPC method.
+func UnaryServerInterceptor(tracerName string) grpc.UnaryServerInterceptor {
+	tracer := otel.Tracer(tracerName)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		}
+
+		ctx, span := tracer.Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(rpcAttributes(info.FullMethod)...),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		endRPCSpan(span, err, serverFault)
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor starts a client span for an outgoing call and sends
+// its trace context in the request metadata.
+func UnaryClientInterceptor(tracerName string) grpc.UnaryClientInterceptor {
+	tracer := otel.Tracer(tracerName)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(rpcAttributes(method)...),
+		)
+		defer span.End()
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endRPCSpan(span, err, func(codes.Code) bool { return true })
+		return err
+	}
+}
+
+// TraceID returns the id of the trace in ctx or an empty string.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "grpc")}
+	// full method is /package.Service/Method
+	if service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/"); ok {
+		attrs = append(attrs,
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		)
+	}
+	return attrs
+}
+
+func endRPCSpan(span trace.Span, err error, isError func(codes.Code) bool) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(code)))
+	if err != nil && isError(code) {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, code.String())
+	}
+}
+
+// serverFault tells server side failures from rejected requests, which are
+// not errors of the server span.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal,
+		codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
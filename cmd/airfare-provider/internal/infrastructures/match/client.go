@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	derr "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/errors"
@@ -54,7 +55,9 @@ func (c *Client) GetMatch(ctx context.Context, matchID int64) (ports.MatchSnapsh
 			case codes.NotFound:
 				return ports.MatchSnapshot{}, derr.ErrMatchNotFound
 			case codes.Unavailable, codes.DeadlineExceeded:
-				return ports.MatchSnapshot{}, derr.ErrSourceTemporary
+				return ports.MatchSnapshot{}, derr.ErrMatchSourceUnavailable
+			case codes.ResourceExhausted:
+				return ports.MatchSnapshot{}, derr.ErrRateLimited
 			}
 		}
 		return ports.MatchSnapshot{}, fmt.Errorf("get match from match-adapter: %w", err)
@@ -71,13 +74,31 @@ func (c *Client) GetMatch(ctx context.Context, matchID int64) (ports.MatchSnapsh
 	span.SetStatus(otelcodes.Ok, "ok")
 
 	return ports.MatchSnapshot{
-		MatchID:         match.GetMatchId(),
-		KickoffUTC:      match.GetKickoffUtc().AsTime().UTC(),
-		DestinationIATA: match.GetDestinationAirportIata(),
-		TicketsLink:     match.GetTicketsLink(),
-		HomeClubID:      match.GetClubHomeId(),
-		AwayClubID:      match.GetClubAwayId(),
-		City:            match.GetCity(),
-		Stadium:         match.GetStadium(),
+		MatchID:          match.GetMatchId(),
+		KickoffUTC:       match.GetKickoffUtc().AsTime().UTC(),
+		DestinationIATA:  match.GetDestinationAirportIata(),
+		TicketsLink:      match.GetTicketsLink(),
+		HomeClubID:       match.GetClubHomeId(),
+		AwayClubID:       match.GetClubAwayId(),
+		City:             match.GetCity(),
+		Stadium:          match.GetStadium(),
+		TransferTime:     transferTime(match.GetVenue(), match.GetDestinationAirportIata()),
+		KickoffTentative: match.GetKickoffTentative(),
 	}, nil
 }
+
+// transferTime prefers the airport the fares are searched for and falls back to
+// the nearest one, which is the case for city codes like MOW.
+func transferTime(venue *matchv1.Stadium, destinationIATA string) time.Duration {
+	airports := venue.GetAirports()
+	if len(airports) == 0 {
+		return 0
+	}
+
+	for _, airport := range airports {
+		if strings.EqualFold(airport.GetIata(), strings.TrimSpace(destinationIATA)) {
+			return time.Duration(airport.GetTransferMinutes()) * time.Minute
+		}
+	}
+	return time.Duration(airports[0].GetTransferMinutes()) * time.Minute
+}
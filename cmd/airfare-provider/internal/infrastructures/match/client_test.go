@@ -26,6 +26,10 @@ func (m *matchAdapterClientMock) GetMatch(ctx context.Context, in *matchv1.GetMa
 	return m.resp, nil
 }
 
+func (m *matchAdapterClientMock) GetMatches(ctx context.Context, in *matchv1.GetMatchesRequest, opts ...grpc.CallOption) (*matchv1.GetMatchesResponse, error) {
+	return &matchv1.GetMatchesResponse{}, nil
+}
+
 func (m *matchAdapterClientMock) GetUpcomingMatches(ctx context.Context, in *matchv1.GetUpcomingMatchesRequest, opts ...grpc.CallOption) (*matchv1.GetUpcomingMatchesResponse, error) {
 	return &matchv1.GetUpcomingMatchesResponse{}, nil
 }
@@ -34,6 +38,18 @@ func (m *matchAdapterClientMock) GetClubs(ctx context.Context, in *matchv1.GetCl
 	return &matchv1.GetClubsResponse{}, nil
 }
 
+func (m *matchAdapterClientMock) ListMatches(ctx context.Context, in *matchv1.ListMatchesRequest, opts ...grpc.CallOption) (*matchv1.ListMatchesResponse, error) {
+	return &matchv1.ListMatchesResponse{}, nil
+}
+
+func (m *matchAdapterClientMock) GetPastMatches(ctx context.Context, in *matchv1.GetPastMatchesRequest, opts ...grpc.CallOption) (*matchv1.GetPastMatchesResponse, error) {
+	return &matchv1.GetPastMatchesResponse{}, nil
+}
+
+func (m *matchAdapterClientMock) GetStadium(ctx context.Context, in *matchv1.GetStadiumRequest, opts ...grpc.CallOption) (*matchv1.GetStadiumResponse, error) {
+	return &matchv1.GetStadiumResponse{}, nil
+}
+
 func TestClient_GetMatch_MapsNotFound(t *testing.T) {
 	c := NewClient(&matchAdapterClientMock{
 		err: status.Error(codes.NotFound, "not found"),
@@ -45,6 +61,17 @@ func TestClient_GetMatch_MapsNotFound(t *testing.T) {
 	}
 }
 
+func TestClient_GetMatch_MapsUnavailable(t *testing.T) {
+	c := NewClient(&matchAdapterClientMock{
+		err: status.Error(codes.Unavailable, "match source unavailable"),
+	}, time.Second)
+
+	_, err := c.GetMatch(context.Background(), 16114)
+	if !errors.Is(err, derr.ErrMatchSourceUnavailable) {
+		t.Fatalf("unexpected error: got %v want %v", err, derr.ErrMatchSourceUnavailable)
+	}
+}
+
 func TestClient_GetMatch_MapsResponseAndUTC(t *testing.T) {
 	kickoffMSK := time.Date(2026, 2, 27, 22, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
 	c := NewClient(&matchAdapterClientMock{
@@ -89,3 +116,22 @@ func TestClient_GetMatch_IncompletePayload(t *testing.T) {
 		t.Fatal("expected incomplete payload error")
 	}
 }
+
+func TestTransferTime(t *testing.T) {
+	venue := &matchv1.Stadium{
+		Airports: []*matchv1.StadiumAirport{
+			{Iata: "SVO", TransferMinutes: 40},
+			{Iata: "VKO", TransferMinutes: 60},
+		},
+	}
+
+	if got := transferTime(venue, "vko"); got != 60*time.Minute {
+		t.Fatalf("unexpected transfer for VKO: %s", got)
+	}
+	if got := transferTime(venue, "MOW"); got != 40*time.Minute {
+		t.Fatalf("expected nearest airport for city code, got %s", got)
+	}
+	if got := transferTime(nil, "LED"); got != 0 {
+		t.Fatalf("expected zero transfer without venue, got %s", got)
+	}
+}
@@ -3,6 +3,7 @@ package travelpayouts
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/ports"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/travelpayouts/dto"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/infrastructures/travelpayouts/mappers"
+	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/lib/metrics"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
@@ -81,8 +83,10 @@ func (c *Client) GetPrices(ctx context.Context, search ports.FareSearch) ([]int6
 		return nil, wrapped
 	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		observeCall(start, transportResult(err))
 		wrapped := fmt.Errorf("travelpayouts request: %w", err)
 		span.RecordError(wrapped)
 		span.SetStatus(otelcodes.Error, "request failed")
@@ -91,6 +95,7 @@ func (c *Client) GetPrices(ctx context.Context, search ports.FareSearch) ([]int6
 	defer resp.Body.Close()
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		observeCall(start, metrics.ResultStatusError)
 		err := fmt.Errorf("travelpayouts status: %s", resp.Status)
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, "non-2xx response")
@@ -99,18 +104,37 @@ func (c *Client) GetPrices(ctx context.Context, search ports.FareSearch) ([]int6
 
 	var payload dto.PriceForDatesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		observeCall(start, metrics.ResultDecodeError)
 		wrapped := fmt.Errorf("decode travelpayouts response: %w", err)
 		span.RecordError(wrapped)
 		span.SetStatus(otelcodes.Error, "decode failed")
 		return nil, wrapped
 	}
 
+	observeCall(start, metrics.ResultOK)
+
 	prices := mappers.ExtractPrices(payload.Data, search)
 	span.SetAttributes(attribute.Int("airfare.prices_count", len(prices)))
 	span.SetStatus(otelcodes.Ok, "ok")
 	return prices, nil
 }
 
+func observeCall(start time.Time, result string) {
+	metrics.SourceRequests.WithLabelValues(result).Inc()
+	metrics.SourceDuration.Observe(time.Since(start).Seconds())
+}
+
+func transportResult(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return metrics.ResultTimeout
+	case errors.Is(err, context.Canceled):
+		return metrics.ResultCanceled
+	default:
+		return metrics.ResultUnavailable
+	}
+}
+
 func (c *Client) buildURL(search ports.FareSearch) (string, error) {
 	departDate := search.DateUTC.UTC().Format("2006-01-02")
 	u, err := url.Parse(c.baseURL + "/aviasales/v3/prices_for_dates")
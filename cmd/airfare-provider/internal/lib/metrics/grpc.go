@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcServerRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_requests_total",
+		Help: "gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+	grpcServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_request_duration_seconds",
+		Help:    "Latency of gRPC requests by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	grpcClientRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_requests_total",
+		Help: "Outgoing gRPC calls by method and status code.",
+	}, []string{"method", "code"})
+	grpcClientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_request_duration_seconds",
+		Help:    "Latency of outgoing gRPC calls by method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err).String()
+		grpcServerRequests.WithLabelValues(info.FullMethod, code).Inc()
+		grpcServerDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		code := status.Code(err).String()
+		grpcClientRequests.WithLabelValues(method, code).Inc()
+		grpcClientDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
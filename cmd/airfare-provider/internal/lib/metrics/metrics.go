@@ -0,0 +1,60 @@
+// Package metrics holds the Prometheus collectors of airfare-provider. They
+// live in the default registry, which Handler serves.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "airfare_cache_requests_total",
+		Help: "Airfare cache reads by result: hit, miss or error.",
+	}, []string{"result"})
+
+	SlotWindowLevels = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "airfare_slot_window_level_total",
+		Help: "Airfare slots by the window level they were resolved at and whether prices were found.",
+	}, []string{"level", "result"})
+
+	SourceRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "travelpayouts_requests_total",
+		Help: "Travelpayouts API calls by result.",
+	}, []string{"result"})
+	SourceDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "travelpayouts_request_duration_seconds",
+		Help:    "Latency of Travelpayouts API calls.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+const (
+	CacheHit   = "hit"
+	CacheMiss  = "miss"
+	CacheError = "error"
+)
+
+// Slot results.
+const (
+	SlotPriced = "priced"
+	SlotEmpty  = "empty"
+	SlotFailed = "failed"
+)
+
+// Source request results.
+const (
+	ResultOK          = "ok"
+	ResultStatusError = "status_error"
+	ResultDecodeError = "decode_error"
+	ResultUnavailable = "unavailable"
+	ResultTimeout     = "timeout"
+	ResultCanceled    = "canceled"
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+// RegisterRedisPool exposes the connection pool of a Redis client.
+func RegisterRedisPool(client *redis.Client) {
+	stat := func(value func(*redis.PoolStats) uint32) func() float64 {
+		return func() float64 { return float64(value(client.PoolStats())) }
+	}
+
+	prometheus.MustRegister(
+		counterFunc("redis_pool_hits_total", "Times a free connection was found in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Hits })),
+		counterFunc("redis_pool_misses_total", "Times a free connection was not found in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Misses })),
+		counterFunc("redis_pool_timeouts_total", "Times a wait for a connection timed out.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Timeouts })),
+		counterFunc("redis_pool_stale_connections_total", "Stale connections removed from the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.StaleConns })),
+		gaugeFunc("redis_pool_total_connections", "Connections in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.TotalConns })),
+		gaugeFunc("redis_pool_idle_connections", "Idle connections in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.IdleConns })),
+	)
+}
+
+func gaugeFunc(name, help string, value func() float64) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, value)
+}
+
+func counterFunc(name, help string, value func() float64) prometheus.Collector {
+	return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, value)
+}
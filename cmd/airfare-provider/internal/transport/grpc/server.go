@@ -3,16 +3,18 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/application/service"
 	derr "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/ports"
 	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type serverAPI struct {
@@ -30,32 +32,32 @@ func Register(gRPCServer *grpc.Server, log *zap.Logger, airfareService *service.
 
 func (s *serverAPI) GetPricesForRules(ctx context.Context, req *airfarev1.GetPricesForRulesRequest) (*airfarev1.GetPricesForRulesResponse, error) {
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("", "request is required")
 	}
 
 	if strings.TrimSpace(req.GetOriginIata()) == "" {
-		return nil, status.Error(codes.InvalidArgument, "origin is required")
+		return nil, invalidArgument("origin", "origin is required")
 	}
 
 	if strings.TrimSpace(req.GetDestinationIata()) == "" {
-		return nil, status.Error(codes.InvalidArgument, "destination is required")
+		return nil, invalidArgument("destination", "destination is required")
 	}
 
 	if req.GetTopN() == 0 {
-		return nil, status.Error(codes.InvalidArgument, "top_n must be greater than 0")
+		return nil, invalidArgument("top_n", "top_n must be greater than 0")
 	}
 
 	if len(req.GetRules()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "rules must not be empty")
+		return nil, invalidArgument("rules", "rules must not be empty")
 	}
 
 	for i, rule := range req.GetRules() {
 		if rule == nil {
-			return nil, status.Errorf(codes.InvalidArgument, "rules[%d] is required", i)
+			return nil, invalidArgument(fmt.Sprintf("rules[%d]", i), fmt.Sprintf("rules[%d] is required", i))
 		}
 
 		if rule.GetType() == airfarev1.RuleType_RULE_TYPE_UNSPECIFIED {
-			return nil, status.Errorf(codes.InvalidArgument, "rules[%d].type must be set", i)
+			return nil, invalidArgument(fmt.Sprintf("rules[%d].type", i), fmt.Sprintf("rules[%d].type must be set", i))
 		}
 
 		if err := validateTimeConstraint(rule, i); err != nil {
@@ -80,13 +82,13 @@ func (s *serverAPI) GetPricesForRules(ctx context.Context, req *airfarev1.GetPri
 
 func (s *serverAPI) GetAirfareByMatch(ctx context.Context, req *airfarev1.GetAirfareByMatchRequest) (*airfarev1.GetAirfareByMatchResponse, error) {
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("", "request is required")
 	}
 	if req.GetMatchId() <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "match_id must be positive")
+		return nil, invalidArgument("match_id", "match_id must be positive")
 	}
 	if strings.TrimSpace(req.GetOriginIata()) == "" {
-		return nil, status.Error(codes.InvalidArgument, "origin_iata is required")
+		return nil, invalidArgument("origin_iata", "origin_iata is required")
 	}
 
 	result, err := s.service.GetAirfareByMatch(ctx, req.GetMatchId(), req.GetOriginIata())
@@ -109,6 +111,12 @@ func (s *serverAPI) GetAirfareByMatch(ctx context.Context, req *airfarev1.GetAir
 			WindowLevel: mapWindowLevel(slot.WindowLevel),
 		})
 	}
+	if !result.FetchedAt.IsZero() {
+		resp.FetchedAt = timestamppb.New(result.FetchedAt)
+	}
+	if !result.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(result.ExpiresAt)
+	}
 
 	return resp, nil
 }
@@ -118,21 +126,25 @@ func validateTimeConstraint(rule *airfarev1.Rule, idx int) error {
 	switch rule.GetType() {
 	case airfarev1.RuleType_RULE_TYPE_OUT_ARRIVE_BY:
 		if tc == nil || tc.GetNotAfter() == nil || tc.GetNotBefore() != nil {
-			return status.Errorf(codes.InvalidArgument, "rules[%d].time_constraint must have only not_after for RULE_OUT_ARRIVE_BY", idx)
+			return invalidArgument(timeConstraintField(idx), fmt.Sprintf("rules[%d].time_constraint must have only not_after for RULE_OUT_ARRIVE_BY", idx))
 		}
 	case airfarev1.RuleType_RULE_TYPE_RET_DEPART_AFTER:
 		if tc == nil || tc.GetNotBefore() == nil || tc.GetNotAfter() != nil {
-			return status.Errorf(codes.InvalidArgument, "rules[%d].time_constraint must have only not_before for RULE_RET_DEPART_AFTER", idx)
+			return invalidArgument(timeConstraintField(idx), fmt.Sprintf("rules[%d].time_constraint must have only not_before for RULE_RET_DEPART_AFTER", idx))
 		}
 	default:
 		if tc != nil {
-			return status.Errorf(codes.InvalidArgument, "rules[%d].time_constraint must be empty for %s", idx, rule.GetType().String())
+			return invalidArgument(timeConstraintField(idx), fmt.Sprintf("rules[%d].time_constraint must be empty for %s", idx, rule.GetType().String()))
 		}
 	}
 
 	return nil
 }
 
+func timeConstraintField(idx int) string {
+	return fmt.Sprintf("rules[%d].time_constraint", idx)
+}
+
 func stubOptions(topN uint32) []*airfarev1.PriceOption {
 	options := []*airfarev1.PriceOption{
 		{Price: 10000, Currency: "RUB", Deeplink: ""},
@@ -154,19 +166,25 @@ func stubOptions(topN uint32) []*airfarev1.PriceOption {
 func mapGetAirfareByMatchError(err error) error {
 	switch {
 	case errors.Is(err, derr.ErrInvalidOrigin):
-		return status.Error(codes.InvalidArgument, "origin_iata is invalid")
+		return invalidArgument("origin_iata", "origin_iata is invalid")
 	case errors.Is(err, derr.ErrInvalidRoute):
-		return status.Error(codes.InvalidArgument, "origin_iata and destination_iata must differ")
+		return statusError(codes.InvalidArgument, commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION, "origin_iata and destination_iata must differ")
 	case errors.Is(err, derr.ErrMatchNotFound):
-		return status.Error(codes.NotFound, "match not found")
+		return statusError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND, "match not found")
+	case errors.Is(err, derr.ErrNoDestination):
+		return statusError(codes.FailedPrecondition, commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN, "match destination airport is unknown")
+	case errors.Is(err, derr.ErrMatchSourceUnavailable):
+		return statusError(codes.Unavailable, commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE, "match source unavailable")
 	case errors.Is(err, derr.ErrSourceTemporary):
-		return status.Error(codes.Unavailable, "source temporarily unavailable")
+		return statusError(codes.Unavailable, commonv1.ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE, "source temporarily unavailable")
+	case errors.Is(err, derr.ErrRateLimited):
+		return statusError(codes.ResourceExhausted, commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED, "too many lookups of unknown matches")
 	case errors.Is(err, context.DeadlineExceeded):
-		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		return statusError(codes.DeadlineExceeded, commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT, "deadline exceeded")
 	case errors.Is(err, context.Canceled):
-		return status.Error(codes.Canceled, "request canceled")
+		return statusError(codes.Canceled, commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED, "request canceled")
 	default:
-		return status.Error(codes.Internal, "internal error")
+		return statusError(codes.Internal, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "internal error")
 	}
 }
 
@@ -208,6 +226,8 @@ func mapWindowLevel(level ports.WindowLevel) airfarev1.FareWindowLevel {
 		return airfarev1.FareWindowLevel_FARE_WINDOW_LEVEL_SOFT_1
 	case ports.WindowLevelSoft2:
 		return airfarev1.FareWindowLevel_FARE_WINDOW_LEVEL_SOFT_2
+	case ports.WindowLevelWholeDay:
+		return airfarev1.FareWindowLevel_FARE_WINDOW_LEVEL_WHOLE_DAY
 	default:
 		return airfarev1.FareWindowLevel_FARE_WINDOW_LEVEL_UNSPECIFIED
 	}
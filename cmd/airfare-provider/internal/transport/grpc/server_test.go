@@ -9,7 +9,9 @@ import (
 	derr "github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/airfare-provider/internal/domain/ports"
 	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -60,6 +62,43 @@ func TestGetAirfareByMatch_MapsNotFound(t *testing.T) {
 	if status.Code(err) != codes.NotFound {
 		t.Fatalf("unexpected code: got %v want %v", status.Code(err), codes.NotFound)
 	}
+	if reason := errorReason(err); reason != commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND.String() {
+		t.Fatalf("unexpected reason: got %q", reason)
+	}
+}
+
+func TestMapGetAirfareByMatchError_KeepsSourceOfFailure(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason commonv1.ErrorReason
+	}{
+		{err: derr.ErrMatchSourceUnavailable, code: codes.Unavailable, reason: commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE},
+		{err: derr.ErrSourceTemporary, code: codes.Unavailable, reason: commonv1.ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE},
+		{err: derr.ErrInvalidRoute, code: codes.InvalidArgument, reason: commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION},
+		{err: derr.ErrNoDestination, code: codes.FailedPrecondition, reason: commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason.String(), func(t *testing.T) {
+			err := mapGetAirfareByMatchError(tt.err)
+			if status.Code(err) != tt.code {
+				t.Fatalf("unexpected code: got %v want %v", status.Code(err), tt.code)
+			}
+			if reason := errorReason(err); reason != tt.reason.String() {
+				t.Fatalf("unexpected reason: got %q want %s", reason, tt.reason)
+			}
+		})
+	}
+}
+
+func errorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == errorDomain {
+			return info.GetReason()
+		}
+	}
+	return ""
 }
 
 func TestGetAirfareByMatch_Success(t *testing.T) {
@@ -0,0 +1,515 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	apiauth "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/auth"
+	apihandlers "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/handlers"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/openapi"
+	apirouter "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/router"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	airfareclient "github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/airfare"
+	matchclient "github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	specPath        = "../../../openapi.yaml"
+	adminToken      = "contract-admin-token"
+	unknownMatchID  = 999
+	revokableKeyID  = "contract-key"
+	unknownClubPath = "/v1/clubs/77"
+)
+
+// contractCase is one request to the gateway; its response must be described
+// by openapi.yaml.
+type contractCase struct {
+	method string
+	target string
+	key    string
+	body   string
+	status int
+}
+
+// TestContract runs every route against openapi.yaml with fake backends:
+// each response must match the document, and every operation of the document
+// must be served successfully at least once.
+func TestContract(t *testing.T) {
+	doc, err := openapi.Load(specPath)
+	if err != nil {
+		t.Fatalf("load openapi: %v", err)
+	}
+	closeSchemas(doc)
+	validator, err := openapi.New(zap.NewNop(), doc, true, false)
+	if err != nil {
+		t.Fatalf("new validator: %v", err)
+	}
+	specRouter, err := legacy.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("new spec router: %v", err)
+	}
+	router := newContractRouter(t, validator, fakeMatchAdapter{})
+
+	cases := []contractCase{
+		{method: http.MethodGet, target: "/v1/clubs", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/clubs/3", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/clubs/abc", status: http.StatusBadRequest},
+		{method: http.MethodGet, target: unknownClubPath, status: http.StatusNotFound},
+		{method: http.MethodGet, target: "/v1/clubs/3/calendar.ics", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/clubs/3/matches", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/clubs/3/matches?from=2026-01-01&to=2026-12-31&order=desc", status: http.StatusOK},
+		{method: http.MethodGet, target: unknownClubPath + "/matches", status: http.StatusNotFound},
+		{method: http.MethodGet, target: "/v1/clubs/3/matches/upcoming-with-airfare?origin_iata=MOW", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches?ids=16114,16115,999", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches?ids=999", status: http.StatusNotFound},
+		{method: http.MethodGet, target: "/v1/matches?from=2026-01-01&to=2026-03-31", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches", status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/v1/matches/upcoming?limit=5&club_id=3", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/upcoming?limit=0", status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/v1/matches/upcoming.ics", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=MOW", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=12", status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=MOW&sort=price&direction=outbound&only_with_airfare=true", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=MOW&sort=round_trip&max_price=8000&max_round_trip_price=15000", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=MOW&sort=cheapest", status: http.StatusBadRequest},
+		{method: http.MethodGet, target: "/v1/matches/16114", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/999", status: http.StatusNotFound},
+		{method: http.MethodGet, target: "/v1/matches/16114/airfare?origin_iata=MOW", status: http.StatusOK},
+		{method: http.MethodGet, target: "/v1/matches/999/airfare?origin_iata=MOW", status: http.StatusNotFound},
+		{method: http.MethodGet, target: "/v1/admin/keys", status: http.StatusUnauthorized},
+		{method: http.MethodGet, target: "/v1/admin/keys", key: adminToken, status: http.StatusOK},
+		{method: http.MethodPost, target: "/v1/admin/keys", key: adminToken, body: `{"name":"partner","scopes":["matches","airfare"]}`, status: http.StatusCreated},
+		{method: http.MethodPost, target: "/v1/admin/keys", key: adminToken, body: `{"name":"partner","scopes":["root"]}`, status: http.StatusBadRequest},
+		{method: http.MethodDelete, target: "/v1/admin/keys/" + revokableKeyID, key: adminToken, status: http.StatusNoContent},
+		{method: http.MethodDelete, target: "/v1/admin/keys/missing", key: adminToken, status: http.StatusNotFound},
+	}
+
+	served := make(map[string]bool)
+	for _, tc := range cases {
+		t.Run(tc.method+" "+tc.target, func(t *testing.T) {
+			req, rec := serveContract(router, tc, "")
+			if rec.Code != tc.status {
+				t.Fatalf("status %d, want %d: %s", rec.Code, tc.status, rec.Body.String())
+			}
+			if err := validator.ValidateResponse(req, rec.Code, rec.Header(), rec.Body.Bytes()); err != nil {
+				t.Fatalf("response does not match openapi.yaml: %v", err)
+			}
+
+			if route, _, err := specRouter.FindRoute(req); err == nil && rec.Code < http.StatusBadRequest {
+				served[tc.method+" "+route.Path] = true
+			}
+
+			etag := rec.Header().Get("ETag")
+			if etag == "" || rec.Code != http.StatusOK {
+				return
+			}
+			req, rec = serveContract(router, tc, etag)
+			if rec.Code != http.StatusNotModified {
+				t.Fatalf("If-None-Match: status %d, want 304", rec.Code)
+			}
+			if err := validator.ValidateResponse(req, rec.Code, rec.Header(), rec.Body.Bytes()); err != nil {
+				t.Fatalf("304 does not match openapi.yaml: %v", err)
+			}
+		})
+	}
+
+	// metrics are served on the internal metrics port only
+	if _, rec := serveContract(router, contractCase{method: http.MethodGet, target: "/metrics"}, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("GET /metrics on the public router: status %d, want 404", rec.Code)
+	}
+
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			if !served[method+" "+path] {
+				t.Errorf("%s %s of openapi.yaml has no successful contract case", method, path)
+			}
+		}
+	}
+}
+
+// closeSchemas makes the object schemas of doc reject members they do not
+// list, so that a field a handler sends without documenting it fails the test.
+// allOf is merged into one object first, otherwise its parts would reject
+// each other's members.
+func closeSchemas(doc *openapi3.T) {
+	seen := make(map[*openapi3.Schema]bool)
+	var walk func(ref *openapi3.SchemaRef)
+	walk = func(ref *openapi3.SchemaRef) {
+		if ref == nil || ref.Value == nil || seen[ref.Value] {
+			return
+		}
+		s := ref.Value
+		seen[s] = true
+
+		if len(s.AllOf) > 0 {
+			merged := openapi3.Schemas{}
+			for _, part := range s.AllOf {
+				for name, prop := range part.Value.Properties {
+					merged[name] = prop
+				}
+				s.Required = append(s.Required, part.Value.Required...)
+			}
+			s.Type = &openapi3.Types{openapi3.TypeObject}
+			s.Properties = merged
+			s.AllOf = nil
+		}
+		if len(s.Properties) > 0 && s.AdditionalProperties.Has == nil && s.AdditionalProperties.Schema == nil {
+			closed := false
+			s.AdditionalProperties.Has = &closed
+		}
+
+		for _, prop := range s.Properties {
+			walk(prop)
+		}
+		walk(s.Items)
+		walk(s.AdditionalProperties.Schema)
+	}
+
+	for _, schema := range doc.Components.Schemas {
+		walk(schema)
+	}
+	for _, item := range doc.Paths.Map() {
+		for _, op := range item.Operations() {
+			for _, resp := range op.Responses.Map() {
+				for _, media := range resp.Value.Content {
+					walk(media.Schema)
+				}
+			}
+		}
+	}
+}
+
+func serveContract(router http.Handler, tc contractCase, etag string) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+	if tc.body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if tc.key != "" {
+		req.Header.Set(apiauth.Header, tc.key)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return req, rec
+}
+
+func TestCatalogQueriesShareOneLoad(t *testing.T) {
+	doc, err := openapi.Load(specPath)
+	if err != nil {
+		t.Fatalf("load openapi: %v", err)
+	}
+	validator, err := openapi.New(zap.NewNop(), doc, true, false)
+	if err != nil {
+		t.Fatalf("new validator: %v", err)
+	}
+	adapter := fakeMatchAdapter{upcomingCalls: &atomic.Int32{}}
+	router := newContractRouter(t, validator, adapter)
+
+	bodies := make(map[string]string)
+	for _, query := range []string{
+		"",
+		"&sort=price&direction=outbound",
+		"&sort=round_trip&max_round_trip_price=15000",
+		"&only_with_airfare=true",
+	} {
+		_, rec := serveContract(router, contractCase{method: http.MethodGet, target: "/v1/matches/upcoming-with-airfare?origin_iata=MOW" + query}, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%q: status %d: %s", query, rec.Code, rec.Body.String())
+		}
+		bodies[query] = rec.Body.String()
+	}
+
+	if calls := adapter.upcomingCalls.Load(); calls != 1 {
+		t.Fatalf("expected sorting and filters to share one load, got %d", calls)
+	}
+	if bodies["&only_with_airfare=true"] == bodies[""] {
+		t.Fatal("expected the filter to apply to the cached page")
+	}
+}
+
+// newContractRouter wires the gateway like main does, with backends served
+// over in-memory gRPC connections.
+func newContractRouter(t *testing.T, validator *openapi.Validator, matchAdapter fakeMatchAdapter) *apirouter.Router {
+	t.Helper()
+
+	log := zap.NewNop()
+	matchClient := matchclient.NewClient(matchv1.NewMatchAdapterServiceClient(
+		fakeBackend(t, func(s *grpc.Server) { matchv1.RegisterMatchAdapterServiceServer(s, matchAdapter) }),
+	), time.Second)
+	airfareClient := airfareclient.NewClient(airfarev1.NewAirfareProviderServiceClient(
+		fakeBackend(t, func(s *grpc.Server) { airfarev1.RegisterAirfareProviderServiceServer(s, fakeAirfareProvider{}) }),
+	), time.Second)
+
+	key, _, err := apikey.NewKey("revokable", []apikey.Scope{apikey.ScopeMatches}, apikey.Limits{}, time.Now())
+	if err != nil {
+		t.Fatalf("new key: %v", err)
+	}
+	key.ID = revokableKeyID
+	store := &memoryKeyStore{keys: map[string]apikey.Key{key.ID: key}}
+	guard := apiauth.New(log, store, allowLimiter{}, apiauth.Config{
+		AdminToken:      adminToken,
+		AnonymousScopes: []apikey.Scope{apikey.ScopeMatches, apikey.ScopeAirfare, apikey.ScopeCatalog},
+		AnonymousLimits: apikey.Limits{RatePerMinute: 60, Burst: 10, DailyQuota: 1000},
+	}, func(*http.Request) string { return "203.0.113.7" })
+
+	router := apirouter.New()
+	router.Use(requestIDMiddleware(), languageMiddleware(i18n.RU), validator.Middleware())
+	registerRoutes(log, router, guard, routeHandlers{
+		club:     apihandlers.NewClubHandler(log, matchClient, time.Second, time.Hour),
+		match:    apihandlers.NewMatchHandler(log, matchClient, time.Second, 30*time.Second),
+		calendar: apihandlers.NewCalendarHandler(log, matchClient, time.Second, "https://fan-avia.example", "MOW"),
+		catalog:  apihandlers.NewCatalogHandler(log, matchClient, airfareClient, time.Second, "MOW", httpcache.NewCache(16), time.Minute),
+		airfare:  apihandlers.NewAirfareHandler(log, airfareClient, time.Second, "MOW"),
+		key:      apihandlers.NewAPIKeyHandler(log, store, apikey.Limits{RatePerMinute: 300, Burst: 60, DailyQuota: 100000}, time.Second),
+	})
+	return router
+}
+
+func fakeBackend(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial fake backend: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func reasonError(code codes.Code, reason commonv1.ErrorReason, msg string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{Reason: reason.String(), Domain: "fan-avia"})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+var contractClubs = []*matchv1.Club{
+	{ClubId: "1", NameRu: "Спартак Москва", NameEn: "Spartak Moscow", Logo: "1.svg", City: "Москва", CityEn: "Moscow", AirportIata: "MOW"},
+	{ClubId: "3", NameRu: "Зенит", NameEn: "Zenit", Logo: "3.svg", City: "Санкт-Петербург", CityEn: "Saint Petersburg", AirportIata: "LED"},
+	{ClubId: "444", NameRu: "Балтика", NameEn: "Baltika", Logo: "444.svg", City: "Калининград", CityEn: "Kaliningrad", AirportIata: "KGD"},
+}
+
+func contractMatches(includeClubs bool) []*matchv1.Match {
+	clubs := make(map[string]*matchv1.Club, len(contractClubs))
+	for _, c := range contractClubs {
+		clubs[c.GetClubId()] = c
+	}
+	homeScore, awayScore := int32(2), int32(1)
+	matches := []*matchv1.Match{
+		{
+			MatchId:                16114,
+			KickoffUtc:             timestamppb.New(time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC)),
+			Competition:            "rpl",
+			City:                   "Санкт-Петербург",
+			CityEn:                 "Saint Petersburg",
+			Stadium:                "Газпром Арена",
+			DestinationAirportIata: "LED",
+			ClubHomeId:             "3",
+			ClubAwayId:             "444",
+			TicketsLink:            "https://tickets.fc-zenit.ru/",
+			HomeScore:              &homeScore,
+			AwayScore:              &awayScore,
+			Venue: &matchv1.Stadium{
+				StadiumId: "gazprom-arena",
+				Name:      "Газпром Арена",
+				NameEn:    "Gazprom Arena",
+				City:      "Санкт-Петербург",
+				CityEn:    "Saint Petersburg",
+				Latitude:  59.973,
+				Longitude: 30.22,
+				Timezone:  "Europe/Moscow",
+				Airports:  []*matchv1.StadiumAirport{{Iata: "LED", TransferMinutes: 50}},
+			},
+		},
+		{
+			// time TBC, the city is not in the dictionary
+			MatchId:                16115,
+			KickoffUtc:             timestamppb.New(time.Date(2026, 3, 7, 21, 0, 0, 0, time.UTC)),
+			KickoffTentative:       true,
+			Competition:            "rpl",
+			City:                   "Москва",
+			Stadium:                "Лукойл Арена",
+			DestinationAirportIata: "MOW",
+			ClubHomeId:             "1",
+			ClubAwayId:             "3",
+		},
+	}
+	if includeClubs {
+		for _, m := range matches {
+			m.HomeClub, m.AwayClub = clubs[m.GetClubHomeId()], clubs[m.GetClubAwayId()]
+		}
+	}
+	return matches
+}
+
+type fakeMatchAdapter struct {
+	matchv1.UnimplementedMatchAdapterServiceServer
+	upcomingCalls *atomic.Int32
+}
+
+func (fakeMatchAdapter) GetMatch(_ context.Context, req *matchv1.GetMatchRequest) (*matchv1.GetMatchResponse, error) {
+	for _, m := range contractMatches(req.GetIncludeClubs()) {
+		if m.GetMatchId() == req.GetMatchId() {
+			return &matchv1.GetMatchResponse{Match: m}, nil
+		}
+	}
+	return nil, reasonError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND, "match not found")
+}
+
+func (fakeMatchAdapter) GetMatches(_ context.Context, req *matchv1.GetMatchesRequest) (*matchv1.GetMatchesResponse, error) {
+	byID := make(map[int64]*matchv1.Match)
+	for _, m := range contractMatches(req.GetIncludeClubs()) {
+		byID[m.GetMatchId()] = m
+	}
+	resp := &matchv1.GetMatchesResponse{}
+	for _, id := range req.GetMatchIds() {
+		if m, ok := byID[id]; ok {
+			resp.Matches = append(resp.Matches, m)
+			continue
+		}
+		resp.Errors = append(resp.Errors, &matchv1.MatchError{
+			MatchId: id,
+			Reason:  matchv1.MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND,
+			Message: "match not found",
+		})
+	}
+	return resp, nil
+}
+
+func (f fakeMatchAdapter) GetUpcomingMatches(_ context.Context, req *matchv1.GetUpcomingMatchesRequest) (*matchv1.GetUpcomingMatchesResponse, error) {
+	if f.upcomingCalls != nil {
+		f.upcomingCalls.Add(1)
+	}
+	return &matchv1.GetUpcomingMatchesResponse{Matches: contractMatches(req.GetIncludeClubs())}, nil
+}
+
+func (fakeMatchAdapter) ListMatches(_ context.Context, req *matchv1.ListMatchesRequest) (*matchv1.ListMatchesResponse, error) {
+	return &matchv1.ListMatchesResponse{Matches: contractMatches(req.GetIncludeClubs()), NextCursor: "next"}, nil
+}
+
+func (fakeMatchAdapter) GetClubs(context.Context, *matchv1.GetClubsRequest) (*matchv1.GetClubsResponse, error) {
+	return &matchv1.GetClubsResponse{Clubs: contractClubs}, nil
+}
+
+type fakeAirfareProvider struct {
+	airfarev1.UnimplementedAirfareProviderServiceServer
+}
+
+func (fakeAirfareProvider) GetAirfareByMatch(_ context.Context, req *airfarev1.GetAirfareByMatchRequest) (*airfarev1.GetAirfareByMatchResponse, error) {
+	switch req.GetMatchId() {
+	case unknownMatchID:
+		return nil, reasonError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND, "match not found")
+	case 16115:
+		return nil, reasonError(codes.InvalidArgument, commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION, "origin_iata and destination_iata must differ")
+	}
+
+	fetchedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	slot := func(t airfarev1.FareSlotType, d airfarev1.FareDirection, date string, prices ...int64) *airfarev1.FareSlot {
+		return &airfarev1.FareSlot{Slot: t, Direction: d, Date: date, Prices: prices, WindowLevel: airfarev1.FareWindowLevel_FARE_WINDOW_LEVEL_STRICT}
+	}
+	return &airfarev1.GetAirfareByMatchResponse{
+		MatchId:     req.GetMatchId(),
+		TicketsLink: "https://tickets.fc-zenit.ru/",
+		Slots: []*airfarev1.FareSlot{
+			slot(airfarev1.FareSlotType_FARE_SLOT_OUT_D_MINUS_2, airfarev1.FareDirection_FARE_DIRECTION_OUTBOUND, "2026-02-25"),
+			slot(airfarev1.FareSlotType_FARE_SLOT_OUT_D_MINUS_1, airfarev1.FareDirection_FARE_DIRECTION_OUTBOUND, "2026-02-26", 7278, 8100),
+			slot(airfarev1.FareSlotType_FARE_SLOT_OUT_D0_ARRIVE_BY, airfarev1.FareDirection_FARE_DIRECTION_OUTBOUND, "2026-02-27", 9900),
+			slot(airfarev1.FareSlotType_FARE_SLOT_RET_D0_DEPART_AFTER, airfarev1.FareDirection_FARE_DIRECTION_RETURN, "2026-02-27"),
+			slot(airfarev1.FareSlotType_FARE_SLOT_RET_D_PLUS_1, airfarev1.FareDirection_FARE_DIRECTION_RETURN, "2026-02-28", 6308),
+			slot(airfarev1.FareSlotType_FARE_SLOT_RET_D_PLUS_2, airfarev1.FareDirection_FARE_DIRECTION_RETURN, "2026-03-01", 6653),
+		},
+		FetchedAt: timestamppb.New(fetchedAt),
+		ExpiresAt: timestamppb.New(fetchedAt.Add(30 * time.Minute)),
+	}, nil
+}
+
+type memoryKeyStore struct {
+	mu   sync.Mutex
+	keys map[string]apikey.Key
+}
+
+func (s *memoryKeyStore) GetKey(_ context.Context, id string) (apikey.Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k, ok := s.keys[id]
+	if !ok {
+		return apikey.Key{}, apikey.ErrKeyNotFound
+	}
+	return k, nil
+}
+
+func (s *memoryKeyStore) SaveKey(_ context.Context, k apikey.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[k.ID] = k
+	return nil
+}
+
+func (s *memoryKeyStore) ListKeys(context.Context) ([]apikey.Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]apikey.Key, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func (s *memoryKeyStore) RevokeKey(_ context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k, ok := s.keys[id]
+	if !ok {
+		return apikey.ErrKeyNotFound
+	}
+	k.RevokedAt = at
+	s.keys[id] = k
+	return nil
+}
+
+type allowLimiter struct{}
+
+func (allowLimiter) Allow(_ context.Context, _ string, limits apikey.Limits, _ time.Time) (apikey.Decision, error) {
+	return apikey.Decision{
+		Allowed:        true,
+		Limit:          limits.RatePerMinute,
+		Remaining:      limits.Burst - 1,
+		QuotaLimit:     limits.DailyQuota,
+		QuotaRemaining: limits.DailyQuota - 1,
+		QuotaReset:     time.Hour,
+	}, nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -10,18 +11,39 @@ import (
 	"syscall"
 	"time"
 
+	apiauth "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/auth"
 	apihandlers "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/handlers"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/openapi"
+	apirouter "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/router"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
 	airfareclient "github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/airfare"
 	matchclient "github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	redisclient "github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/redis"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/config"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/metrics"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/requestid"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/resilience"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/tracing"
 	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
 	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // client side health checking
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
+const clientIPMetadataKey = "x-client-ip"
+
+// upstreamServiceConfig spreads calls over every address a backend name
+// resolves to and skips replicas whose gRPC health check is not SERVING.
+const upstreamServiceConfig = `{"loadBalancingConfig":[{"round_robin":{}}],"healthCheckConfig":{"serviceName":""}}`
+
 func main() {
 	cfg := config.MustLoad()
 	log := setupLogger(cfg.Log.Level)
@@ -29,10 +51,43 @@ func main() {
 		_ = log.Sync()
 	}()
 
+	tp, err := tracing.InitTracer("api-gateway", cfg.Jaeger.Address)
+	if err != nil {
+		log.Fatal("failed to init tracer", zap.Error(err))
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tp.Shutdown(shutdownCtx); err != nil {
+			log.Warn("failed to shutdown tracer provider", zap.Error(err))
+		}
+	}()
+
+	defaultLang, ok := i18n.Parse(cfg.Defaults.Language)
+	if !ok {
+		log.Fatal("unsupported default language", zap.String("language", cfg.Defaults.Language))
+	}
+
 	addr := fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port)
 	log.Info("api-gateway starting", zap.String("http_addr", addr))
 
-	airfareConn, err := grpc.NewClient(cfg.Clients.Airfare.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	keepaliveParams := keepalive.ClientParameters{
+		Time:                cfg.Clients.KeepaliveTime,
+		Timeout:             cfg.Clients.KeepaliveTimeout,
+		PermitWithoutStream: true,
+	}
+	airfareConn, err := grpc.NewClient(cfg.Clients.Airfare.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(upstreamServiceConfig),
+		grpc.WithKeepaliveParams(keepaliveParams),
+		grpc.WithChainUnaryInterceptor(
+			tracing.UnaryClientInterceptor("api-gateway/airfare-client"),
+			metrics.UnaryClientInterceptor(),
+			resilience.NewBreaker("airfare-provider", cfg.Clients.Airfare.BreakerFailures, cfg.Clients.Airfare.BreakerOpenTimeout).UnaryClientInterceptor(),
+			resilience.RetryInterceptor(cfg.Clients.Airfare.RetriesCount, cfg.Clients.Airfare.RetryBackoff),
+			resilience.HedgeInterceptor(cfg.Clients.Airfare.HedgeDelay),
+		),
+	)
 	if err != nil {
 		log.Fatal("failed to connect airfare-provider grpc", zap.Error(err), zap.String("addr", cfg.Clients.Airfare.Address))
 	}
@@ -46,7 +101,18 @@ func main() {
 		airfarev1.NewAirfareProviderServiceClient(airfareConn),
 		cfg.Clients.Airfare.Timeout,
 	)
-	matchConn, err := grpc.NewClient(cfg.Clients.Match.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	matchConn, err := grpc.NewClient(cfg.Clients.Match.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(upstreamServiceConfig),
+		grpc.WithKeepaliveParams(keepaliveParams),
+		grpc.WithChainUnaryInterceptor(
+			tracing.UnaryClientInterceptor("api-gateway/match-client"),
+			metrics.UnaryClientInterceptor(),
+			resilience.NewBreaker("match-adapter", cfg.Clients.Match.BreakerFailures, cfg.Clients.Match.BreakerOpenTimeout).UnaryClientInterceptor(),
+			resilience.RetryInterceptor(cfg.Clients.Match.RetriesCount, cfg.Clients.Match.RetryBackoff),
+			resilience.HedgeInterceptor(cfg.Clients.Match.HedgeDelay),
+		),
+	)
 	if err != nil {
 		log.Fatal("failed to connect match-adapter grpc", zap.Error(err), zap.String("addr", cfg.Clients.Match.Address))
 	}
@@ -61,8 +127,9 @@ func main() {
 	)
 
 	airfareHandler := apihandlers.NewAirfareHandler(log, airfareClient, cfg.Clients.Airfare.Timeout, cfg.Defaults.OriginIATA)
-	matchHandler := apihandlers.NewMatchHandler(log, matchClient, cfg.Clients.Match.Timeout)
-	clubHandler := apihandlers.NewClubHandler(log, matchClient, cfg.Clients.Match.Timeout)
+	matchHandler := apihandlers.NewMatchHandler(log, matchClient, cfg.Clients.Match.Timeout, cfg.Cache.MatchesMaxAge)
+	clubHandler := apihandlers.NewClubHandler(log, matchClient, cfg.Clients.Match.Timeout, cfg.Cache.ClubsMaxAge)
+	calendarHandler := apihandlers.NewCalendarHandler(log, matchClient, cfg.Clients.Match.Timeout, cfg.Site.BaseURL, cfg.Defaults.OriginIATA)
 	catalogTimeout := 20 * time.Second
 	if cfg.HTTP.WriteTimeout > 0 {
 		adjusted := cfg.HTTP.WriteTimeout - 500*time.Millisecond
@@ -73,35 +140,104 @@ func main() {
 			catalogTimeout = adjusted
 		}
 	}
-	catalogHandler := apihandlers.NewCatalogHandler(log, matchClient, airfareClient, catalogTimeout, cfg.Defaults.OriginIATA)
-
-	mux := http.NewServeMux()
-	mux.HandleFunc("/healthz", healthHandler)
-	mux.HandleFunc("/v1/stub", stubHandler(log))
-	mux.HandleFunc("/v1/clubs", clubHandler.GetClubs)
-	mux.HandleFunc("/v1/matches", matchHandler.GetMatches)
-	mux.HandleFunc("/v1/matches/upcoming", matchHandler.GetUpcomingMatches)
-	mux.HandleFunc("/v1/matches/upcoming-with-airfare", catalogHandler.GetUpcomingWithAirfare)
-	mux.HandleFunc("/v1/matches/", func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasSuffix(r.URL.Path, "/airfare") {
-			airfareHandler.GetAirfareByMatch(w, r)
-			return
+	catalogHandler := apihandlers.NewCatalogHandler(log, matchClient, airfareClient, catalogTimeout, cfg.Defaults.OriginIATA,
+		httpcache.NewCache(cfg.Cache.CatalogSize), cfg.Cache.CatalogTTL)
+
+	// guard stays nil with auth disabled and then lets every request through.
+	var guard *apiauth.Guard
+	var keyHandler *apihandlers.APIKeyHandler
+	if cfg.Auth.Enabled {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			Username: cfg.Redis.Username,
+			DB:       cfg.Redis.DB,
+		})
+		defer func() {
+			if err := redisClient.Close(); err != nil {
+				log.Warn("failed to close redis client", zap.Error(err))
+			}
+		}()
+
+		keyStore := redisclient.NewKeyStore(redisClient)
+		authCfg, err := buildAuthConfig(cfg.Auth)
+		if err != nil {
+			log.Fatal("invalid auth config", zap.Error(err))
+		}
+		guard = apiauth.New(log, keyStore, redisclient.NewLimiter(redisClient), authCfg, func(r *http.Request) string {
+			return clientIP(r, cfg.HTTP.TrustForwardedFor)
+		})
+		keyHandler = apihandlers.NewAPIKeyHandler(log, keyStore, apikey.Limits{
+			RatePerMinute: cfg.Auth.KeyDefaults.RatePerMinute,
+			Burst:         cfg.Auth.KeyDefaults.Burst,
+			DailyQuota:    cfg.Auth.KeyDefaults.DailyQuota,
+		}, 5*time.Second)
+		log.Info("api keys enabled", zap.String("redis_addr", cfg.Redis.Addr))
+	}
+
+	router := apirouter.New()
+	router.Use(
+		requestIDMiddleware(),
+		languageMiddleware(defaultLang),
+		tracing.Middleware("api-gateway/http", router.Route),
+		metrics.Middleware(router.Route),
+		loggingMiddleware(log),
+		clientIPMiddleware(cfg.HTTP.TrustForwardedFor),
+	)
+	if cfg.OpenAPI.ValidateRequests || cfg.OpenAPI.ValidateResponses {
+		doc, err := openapi.Load(cfg.OpenAPI.SpecPath)
+		if err != nil {
+			log.Fatal("failed to load openapi document", zap.Error(err), zap.String("path", cfg.OpenAPI.SpecPath))
+		}
+		validator, err := openapi.New(log, doc, cfg.OpenAPI.ValidateRequests, cfg.OpenAPI.ValidateResponses)
+		if err != nil {
+			log.Fatal("failed to build openapi validator", zap.Error(err))
 		}
-		matchHandler.GetMatch(w, r)
+		router.Use(validator.Middleware())
+		log.Info("openapi validation enabled",
+			zap.Bool("requests", cfg.OpenAPI.ValidateRequests),
+			zap.Bool("responses", cfg.OpenAPI.ValidateResponses),
+		)
+	}
+	registerRoutes(log, router, guard, routeHandlers{
+		club:     clubHandler,
+		match:    matchHandler,
+		calendar: calendarHandler,
+		catalog:  catalogHandler,
+		airfare:  airfareHandler,
+		key:      keyHandler,
 	})
 
 	server := &http.Server{
 		Addr:         addr,
-		Handler:      loggingMiddleware(log, mux),
+		Handler:      router,
 		ReadTimeout:  cfg.HTTP.ReadTimeout,
 		WriteTimeout: cfg.HTTP.WriteTimeout,
 	}
 
-	errCh := make(chan error, 1)
+	errCh := make(chan error, 2)
 	go func() {
 		errCh <- server.ListenAndServe()
 	}()
 
+	var metricsSrv *http.Server
+	if cfg.Metrics.Enabled {
+		metricsAddr := fmt.Sprintf("%s:%d", cfg.Metrics.Host, cfg.Metrics.Port)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:    metricsAddr,
+			Handler: mux,
+		}
+
+		log.Info("metrics http starting", zap.String("http_addr", metricsAddr))
+		go func() {
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				errCh <- fmt.Errorf("metrics http: %w", err)
+			}
+		}()
+	}
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -113,6 +249,11 @@ func main() {
 		if err := server.Shutdown(shutdownCtx); err != nil {
 			log.Error("http shutdown error", zap.Error(err))
 		}
+		if metricsSrv != nil {
+			if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+				log.Error("metrics http shutdown error", zap.Error(err))
+			}
+		}
 	case err := <-errCh:
 		if err != nil && err != http.ErrServerClosed {
 			log.Error("http server stopped", zap.Error(err))
@@ -120,6 +261,62 @@ func main() {
 	}
 }
 
+// routeHandlers serve the public API; key is nil while auth is disabled.
+type routeHandlers struct {
+	club     *apihandlers.ClubHandler
+	match    *apihandlers.MatchHandler
+	calendar *apihandlers.CalendarHandler
+	catalog  *apihandlers.CatalogHandler
+	airfare  *apihandlers.AirfareHandler
+	key      *apihandlers.APIKeyHandler
+}
+
+// registerRoutes adds the routes of openapi.yaml and the service ones.
+func registerRoutes(log *zap.Logger, router *apirouter.Router, guard *apiauth.Guard, h routeHandlers) {
+	router.Get("/healthz", healthHandler)
+	router.Handle("", "/v1/stub", stubHandler(log))
+	router.Get("/v1/clubs", guard.Require(apikey.ScopeMatches, h.club.GetClubs))
+	router.Get("/v1/clubs/{id}", guard.Require(apikey.ScopeMatches, h.club.GetClub))
+	router.Get("/v1/clubs/{id}/calendar.ics", guard.Require(apikey.ScopeMatches, h.calendar.GetClubCalendar))
+	router.Get("/v1/clubs/{id}/matches", guard.Require(apikey.ScopeMatches, h.match.GetClubMatches))
+	router.Get("/v1/clubs/{id}/matches/upcoming-with-airfare", guard.Require(apikey.ScopeCatalog, h.catalog.GetClubUpcomingWithAirfare))
+	router.Get("/v1/matches", guard.Require(apikey.ScopeMatches, h.match.GetMatches))
+	router.Get("/v1/matches/upcoming", guard.Require(apikey.ScopeMatches, h.match.GetUpcomingMatches))
+	router.Get("/v1/matches/upcoming.ics", guard.Require(apikey.ScopeMatches, h.calendar.GetUpcomingCalendar))
+	router.Get("/v1/matches/upcoming-with-airfare", guard.Require(apikey.ScopeCatalog, h.catalog.GetUpcomingWithAirfare))
+	router.Get("/v1/matches/{id}", guard.Require(apikey.ScopeMatches, h.match.GetMatch))
+	router.Get("/v1/matches/{id}/airfare", guard.Require(apikey.ScopeAirfare, h.airfare.GetAirfareByMatch))
+	if h.key != nil {
+		router.Handle(http.MethodPost, "/v1/admin/keys", guard.Require(apikey.ScopeAdmin, h.key.IssueKey))
+		router.Get("/v1/admin/keys", guard.Require(apikey.ScopeAdmin, h.key.ListKeys))
+		router.Handle(http.MethodDelete, "/v1/admin/keys/{id}", guard.Require(apikey.ScopeAdmin, h.key.RevokeKey))
+	}
+}
+
+func buildAuthConfig(cfg config.AuthConfig) (apiauth.Config, error) {
+	scopes := make([]apikey.Scope, 0, len(cfg.Anonymous.Scopes))
+	for _, raw := range cfg.Anonymous.Scopes {
+		scope, ok := apikey.ParseScope(strings.TrimSpace(raw))
+		if !ok {
+			return apiauth.Config{}, fmt.Errorf("unknown anonymous scope %q", raw)
+		}
+		if scope == apikey.ScopeAdmin {
+			return apiauth.Config{}, fmt.Errorf("admin scope cannot be anonymous")
+		}
+		scopes = append(scopes, scope)
+	}
+
+	return apiauth.Config{
+		AdminToken:      cfg.AdminToken,
+		AnonymousScopes: scopes,
+		AnonymousLimits: apikey.Limits{
+			RatePerMinute: cfg.Anonymous.RatePerMinute,
+			Burst:         cfg.Anonymous.Burst,
+			DailyQuota:    cfg.Anonymous.DailyQuota,
+		},
+	}, nil
+}
+
 func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte("ok"))
@@ -134,16 +331,81 @@ func stubHandler(log *zap.Logger) http.HandlerFunc {
 	}
 }
 
-func loggingMiddleware(log *zap.Logger, next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-		next.ServeHTTP(w, r)
-		log.Info("http request",
-			zap.String("method", r.Method),
-			zap.String("path", r.URL.Path),
-			zap.Duration("duration", time.Since(start)),
-		)
-	})
+func loggingMiddleware(log *zap.Logger) apirouter.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			next.ServeHTTP(w, r)
+			log.Info("http request",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("request_id", requestid.FromContext(r.Context())),
+				zap.String("trace_id", tracing.TraceID(r.Context())),
+				zap.Duration("duration", time.Since(start)),
+			)
+		})
+	}
+}
+
+// requestIDMiddleware keeps a valid X-Request-ID of the client or makes a new
+// one, echoes it in the response and sends it to the backends.
+func requestIDMiddleware() apirouter.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(requestid.Header)
+			if !requestid.Valid(id) {
+				id = requestid.New()
+			}
+			w.Header().Set(requestid.Header, id)
+
+			ctx := requestid.WithContext(r.Context(), id)
+			ctx = metadata.AppendToOutgoingContext(ctx, requestid.MetadataKey, id)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// languageMiddleware picks the language of the response from Accept-Language.
+func languageMiddleware(fallback i18n.Lang) apirouter.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lang := i18n.Negotiate(r.Header.Get("Accept-Language"), fallback)
+			w.Header().Set("Content-Language", string(lang))
+			w.Header().Add("Vary", "Accept-Language")
+			next.ServeHTTP(w, r.WithContext(i18n.WithContext(r.Context(), lang)))
+		})
+	}
+}
+
+// clientIPMiddleware passes the client address to the backends so that they
+// can limit expensive calls per user.
+func clientIPMiddleware(trustForwardedFor bool) apirouter.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := clientIP(r, trustForwardedFor); ip != "" {
+				ctx := metadata.AppendToOutgoingContext(r.Context(), clientIPMetadataKey, ip)
+				r = r.WithContext(ctx)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+			parts := strings.Split(xff, ",")
+			if ip := strings.TrimSpace(parts[len(parts)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
 
 func setupLogger(level string) *zap.Logger {
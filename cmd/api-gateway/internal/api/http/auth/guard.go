@@ -0,0 +1,205 @@
+// Package auth guards gateway routes with API key scopes, rate limits and
+// daily quotas. Callers without a key are limited by their address.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"go.uber.org/zap"
+)
+
+const Header = "X-API-Key"
+
+type Config struct {
+	// AdminToken is accepted as a key with every scope and no limits so that
+	// the first keys can be issued. Empty disables it.
+	AdminToken      string
+	AnonymousScopes []apikey.Scope
+	AnonymousLimits apikey.Limits
+}
+
+// Principal is the caller of a guarded route.
+type Principal struct {
+	KeyID  string // empty for anonymous callers and the admin token
+	Scopes []apikey.Scope
+	Limits apikey.Limits
+	// Subject names the buckets of the caller: key:{id} or ip:{address}.
+	Subject string
+}
+
+func (p Principal) Anonymous() bool {
+	return p.KeyID == "" && p.Subject != adminSubject
+}
+
+func (p Principal) hasScope(scope apikey.Scope) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+const adminSubject = "admin"
+
+type principalKey struct{}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+type Guard struct {
+	log      *zap.Logger
+	store    apikey.Store
+	limiter  apikey.Limiter
+	cfg      Config
+	clientIP func(*http.Request) string
+	now      func() time.Time
+}
+
+func New(log *zap.Logger, store apikey.Store, limiter apikey.Limiter, cfg Config, clientIP func(*http.Request) string) *Guard {
+	return &Guard{
+		log:      log,
+		store:    store,
+		limiter:  limiter,
+		cfg:      cfg,
+		clientIP: clientIP,
+		now:      time.Now,
+	}
+}
+
+// Require lets a request through to h when its caller has the scope and is
+// within its limits. A nil Guard lets every request through, which is how
+// the gateway runs with auth disabled.
+func (g *Guard) Require(scope apikey.Scope, h http.HandlerFunc) http.HandlerFunc {
+	if g == nil {
+		return h
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal, p := g.authenticate(r)
+		if p != nil {
+			problem.Write(w, r, p)
+			return
+		}
+
+		if !principal.hasScope(scope) {
+			if principal.Anonymous() {
+				problem.Write(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_UNAUTHENTICATED,
+					"an API key with scope "+string(scope)+" is required"))
+				return
+			}
+			problem.Write(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED,
+				"the API key has no scope "+string(scope)))
+			return
+		}
+
+		if !g.allow(w, r, principal) {
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), principalKey{}, principal)
+		h(w, r.WithContext(httpcache.WithPrivate(ctx)))
+	}
+}
+
+func (g *Guard) authenticate(r *http.Request) (Principal, *problem.Problem) {
+	plain := r.Header.Get(Header)
+	if plain == "" {
+		return Principal{
+			Scopes:  g.cfg.AnonymousScopes,
+			Limits:  g.cfg.AnonymousLimits,
+			Subject: "ip:" + g.clientIP(r),
+		}, nil
+	}
+
+	if g.cfg.AdminToken != "" && subtle.ConstantTimeCompare([]byte(plain), []byte(g.cfg.AdminToken)) == 1 {
+		return Principal{Scopes: apikey.AllScopes(), Subject: adminSubject}, nil
+	}
+
+	invalid := problem.New(commonv1.ErrorReason_ERROR_REASON_UNAUTHENTICATED, "the API key is invalid or revoked")
+	id, secret, ok := apikey.ParseKey(plain)
+	if !ok {
+		return Principal{}, invalid
+	}
+
+	key, err := g.store.GetKey(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, apikey.ErrKeyNotFound) {
+			return Principal{}, invalid
+		}
+		g.log.Error("get api key failed", zap.String("key_id", id), zap.Error(err))
+		return Principal{}, problem.NewWithStatus(http.StatusServiceUnavailable,
+			commonv1.ErrorReason_ERROR_REASON_INTERNAL, "API keys cannot be checked right now")
+	}
+	if key.Revoked() || !key.Verify(secret) {
+		return Principal{}, invalid
+	}
+
+	return Principal{
+		KeyID:   key.ID,
+		Scopes:  key.Scopes,
+		Limits:  key.Limits,
+		Subject: "key:" + key.ID,
+	}, nil
+}
+
+// allow charges the request to the caller and writes the limit headers. An
+// unavailable limiter lets the request through: the limits protect the
+// backends, an outage of Redis should not take the API down.
+func (g *Guard) allow(w http.ResponseWriter, r *http.Request, principal Principal) bool {
+	if principal.Limits.RatePerMinute <= 0 && principal.Limits.DailyQuota <= 0 {
+		return true
+	}
+
+	d, err := g.limiter.Allow(r.Context(), principal.Subject, principal.Limits, g.now())
+	if err != nil {
+		g.log.Warn("rate limiter unavailable, request let through",
+			zap.String("subject", principal.Subject), zap.Error(err))
+		return true
+	}
+
+	writeLimitHeaders(w.Header(), d)
+	switch {
+	case d.QuotaExceeded:
+		w.Header().Set("Retry-After", seconds(d.RetryAfter))
+		problem.Write(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_QUOTA_EXCEEDED,
+			"daily quota of "+strconv.Itoa(d.QuotaLimit)+" requests is used up"))
+		return false
+	case !d.Allowed:
+		w.Header().Set("Retry-After", seconds(d.RetryAfter))
+		problem.Write(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED,
+			"rate limit of "+strconv.Itoa(d.Limit)+" requests per minute exceeded"))
+		return false
+	}
+	return true
+}
+
+func writeLimitHeaders(h http.Header, d apikey.Decision) {
+	if d.Limit > 0 {
+		h.Set("X-RateLimit-Limit", strconv.Itoa(d.Limit))
+		h.Set("X-RateLimit-Remaining", strconv.Itoa(d.Remaining))
+		h.Set("X-RateLimit-Reset", seconds(d.Reset))
+	}
+	if d.QuotaLimit > 0 {
+		h.Set("X-Quota-Limit", strconv.Itoa(d.QuotaLimit))
+		h.Set("X-Quota-Remaining", strconv.Itoa(d.QuotaRemaining))
+		h.Set("X-Quota-Reset", seconds(d.QuotaReset))
+	}
+}
+
+// seconds rounds up so that a client waiting that long is let through.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	"go.uber.org/zap"
+)
+
+type fakeStore struct {
+	keys map[string]apikey.Key
+	err  error
+}
+
+func (s *fakeStore) GetKey(_ context.Context, id string) (apikey.Key, error) {
+	if s.err != nil {
+		return apikey.Key{}, s.err
+	}
+	k, ok := s.keys[id]
+	if !ok {
+		return apikey.Key{}, apikey.ErrKeyNotFound
+	}
+	return k, nil
+}
+
+func (s *fakeStore) SaveKey(context.Context, apikey.Key) error          { return nil }
+func (s *fakeStore) ListKeys(context.Context) ([]apikey.Key, error)     { return nil, nil }
+func (s *fakeStore) RevokeKey(context.Context, string, time.Time) error { return nil }
+
+type fakeLimiter struct {
+	decision apikey.Decision
+	err      error
+	subjects []string
+}
+
+func (l *fakeLimiter) Allow(_ context.Context, subject string, _ apikey.Limits, _ time.Time) (apikey.Decision, error) {
+	l.subjects = append(l.subjects, subject)
+	return l.decision, l.err
+}
+
+func newTestGuard(t *testing.T, limiter *fakeLimiter) (*Guard, string) {
+	t.Helper()
+
+	key, plain, err := apikey.NewKey("partner", []apikey.Scope{apikey.ScopeMatches},
+		apikey.Limits{RatePerMinute: 60, Burst: 10, DailyQuota: 1000}, time.Now())
+	if err != nil {
+		t.Fatalf("new key: %v", err)
+	}
+	revoked, _, _ := apikey.NewKey("old", []apikey.Scope{apikey.ScopeMatches}, apikey.Limits{}, time.Now())
+	revoked.RevokedAt = time.Now()
+
+	store := &fakeStore{keys: map[string]apikey.Key{key.ID: key, revoked.ID: revoked}}
+	cfg := Config{
+		AdminToken:      "admin-secret",
+		AnonymousScopes: []apikey.Scope{apikey.ScopeMatches},
+		AnonymousLimits: apikey.Limits{RatePerMinute: 10, Burst: 5},
+	}
+	g := New(zap.NewNop(), store, limiter, cfg, func(*http.Request) string { return "203.0.113.7" })
+	return g, plain
+}
+
+func serve(g *Guard, scope apikey.Scope, key string) *httptest.ResponseRecorder {
+	h := g.Require(scope, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/v1/matches", nil)
+	if key != "" {
+		req.Header.Set(Header, key)
+	}
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	return rec
+}
+
+func problemCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode problem %q: %v", rec.Body.String(), err)
+	}
+	return body.Code
+}
+
+func TestRequire_Callers(t *testing.T) {
+	limiter := &fakeLimiter{decision: apikey.Decision{Allowed: true}}
+	g, plain := newTestGuard(t, limiter)
+	revokedID := ""
+	for id, k := range g.store.(*fakeStore).keys {
+		if k.Revoked() {
+			revokedID = id
+		}
+	}
+
+	tests := []struct {
+		name   string
+		scope  apikey.Scope
+		key    string
+		status int
+		code   string
+	}{
+		{"anonymous in scope", apikey.ScopeMatches, "", http.StatusOK, ""},
+		{"anonymous out of scope", apikey.ScopeCatalog, "", http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{"valid key", apikey.ScopeMatches, plain, http.StatusOK, ""},
+		{"key without scope", apikey.ScopeAirfare, plain, http.StatusForbidden, "PERMISSION_DENIED"},
+		{"malformed key", apikey.ScopeMatches, "not-a-key", http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{"wrong secret", apikey.ScopeMatches, plain + "x", http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{"revoked key", apikey.ScopeMatches, "fa_" + revokedID + "_secret", http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{"admin token", apikey.ScopeAdmin, "admin-secret", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(g, tt.scope, tt.key)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.status, rec.Body.String())
+			}
+			if tt.code != "" && problemCode(t, rec) != tt.code {
+				t.Fatalf("code = %q, want %q", problemCode(t, rec), tt.code)
+			}
+		})
+	}
+}
+
+func TestRequire_SubjectsAndUnlimitedAdmin(t *testing.T) {
+	limiter := &fakeLimiter{decision: apikey.Decision{Allowed: true}}
+	g, plain := newTestGuard(t, limiter)
+
+	serve(g, apikey.ScopeMatches, "")
+	serve(g, apikey.ScopeMatches, plain)
+	serve(g, apikey.ScopeMatches, "admin-secret")
+
+	id, _, _ := apikey.ParseKey(plain)
+	want := []string{"ip:203.0.113.7", "key:" + id}
+	if len(limiter.subjects) != len(want) || limiter.subjects[0] != want[0] || limiter.subjects[1] != want[1] {
+		t.Fatalf("subjects = %v, want %v", limiter.subjects, want)
+	}
+}
+
+func TestRequire_RateLimited(t *testing.T) {
+	limiter := &fakeLimiter{decision: apikey.Decision{
+		Limit: 10, Remaining: 0, Reset: 30 * time.Second, RetryAfter: 5500 * time.Millisecond,
+	}}
+	g, _ := newTestGuard(t, limiter)
+
+	rec := serve(g, apikey.ScopeMatches, "")
+	if rec.Code != http.StatusTooManyRequests || problemCode(t, rec) != "RATE_LIMITED" {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+	for header, want := range map[string]string{
+		"Retry-After":           "6",
+		"X-RateLimit-Limit":     "10",
+		"X-RateLimit-Remaining": "0",
+		"X-RateLimit-Reset":     "30",
+	} {
+		if got := rec.Header().Get(header); got != want {
+			t.Fatalf("%s = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestRequire_QuotaExceeded(t *testing.T) {
+	limiter := &fakeLimiter{decision: apikey.Decision{
+		QuotaExceeded: true, QuotaLimit: 1000, QuotaReset: time.Hour, RetryAfter: time.Hour,
+	}}
+	g, plain := newTestGuard(t, limiter)
+
+	rec := serve(g, apikey.ScopeMatches, plain)
+	if rec.Code != http.StatusTooManyRequests || problemCode(t, rec) != "QUOTA_EXCEEDED" {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("X-Quota-Remaining") != "0" || rec.Header().Get("Retry-After") != "3600" {
+		t.Fatalf("unexpected headers: %v", rec.Header())
+	}
+}
+
+func TestRequire_LimiterDownLetsThrough(t *testing.T) {
+	g, _ := newTestGuard(t, &fakeLimiter{err: errors.New("redis down")})
+
+	if rec := serve(g, apikey.ScopeMatches, ""); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestRequire_StoreDownRejectsKeys(t *testing.T) {
+	g, plain := newTestGuard(t, &fakeLimiter{decision: apikey.Decision{Allowed: true}})
+	g.store.(*fakeStore).err = errors.New("redis down")
+
+	if rec := serve(g, apikey.ScopeMatches, plain); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rec.Code)
+	}
+}
+
+func TestRequire_NilGuardLetsThrough(t *testing.T) {
+	var g *Guard
+	if rec := serve(g, apikey.ScopeAdmin, ""); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestRequire_MarksResponsesPrivate(t *testing.T) {
+	guarded, _ := newTestGuard(t, &fakeLimiter{decision: apikey.Decision{Allowed: true}})
+	for _, tt := range []struct {
+		guard *Guard
+		want  string
+	}{
+		{guarded, "private, max-age=60"},
+		{nil, "public, max-age=60"},
+	} {
+		h := tt.guard.Require(apikey.ScopeMatches, func(w http.ResponseWriter, r *http.Request) {
+			httpcache.Write(w, r, "application/json", []byte("{}"), httpcache.ETag([]byte("{}")), time.Minute)
+		})
+		rec := httptest.NewRecorder()
+		h(rec, httptest.NewRequest(http.MethodGet, "/v1/matches", nil))
+		if got := rec.Header().Get("Cache-Control"); got != tt.want {
+			t.Fatalf("Cache-Control = %q, want %q", got, tt.want)
+		}
+	}
+}
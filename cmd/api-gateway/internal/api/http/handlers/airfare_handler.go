@@ -6,13 +6,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/airfare"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// slotLabels name the fare slots for people, in the language of the catalog.
+var slotLabels = map[airfarev1.FareSlotType]string{
+	airfarev1.FareSlotType_FARE_SLOT_OUT_D_MINUS_2:       "2 days before",
+	airfarev1.FareSlotType_FARE_SLOT_OUT_D_MINUS_1:       "1 day before",
+	airfarev1.FareSlotType_FARE_SLOT_OUT_D0_ARRIVE_BY:    "Match day, arriving before kickoff",
+	airfarev1.FareSlotType_FARE_SLOT_RET_D0_DEPART_AFTER: "Match day, departing after the match",
+	airfarev1.FareSlotType_FARE_SLOT_RET_D_PLUS_1:        "1 day after",
+	airfarev1.FareSlotType_FARE_SLOT_RET_D_PLUS_2:        "2 days after",
+}
+
 type AirfareHandler struct {
 	log               *zap.Logger
 	client            *airfare.Client
@@ -31,14 +44,9 @@ func NewAirfareHandler(log *zap.Logger, client *airfare.Client, timeout time.Dur
 }
 
 func (h *AirfareHandler) GetAirfareByMatch(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
-		return
-	}
-
-	matchID, ok := parseAirfareMatchIDFromPath(r.URL.Path)
+	matchID, ok := parseMatchID(r.PathValue("id"))
 	if !ok {
-		writeError(w, http.StatusBadRequest, "invalid path, expected /v1/matches/{id}/airfare")
+		writeParamError(w, r, invalidParam("match_id", "invalid match_id"))
 		return
 	}
 
@@ -47,79 +55,52 @@ func (h *AirfareHandler) GetAirfareByMatch(w http.ResponseWriter, r *http.Reques
 		originIATA = h.defaultOriginIATA
 	}
 	if originIATA == "" {
-		writeError(w, http.StatusBadRequest, "origin_iata is required")
+		writeParamError(w, r, invalidParam("origin_iata", "origin_iata is required"))
 		return
 	}
 	if !isValidIATA(originIATA) {
-		writeError(w, http.StatusBadRequest, "origin_iata must be 3 latin letters")
+		writeParamError(w, r, invalidParam("origin_iata", "origin_iata must be 3 latin letters"))
 		return
 	}
 
 	resp, err := h.client.GetAirfareByMatch(r.Context(), matchID, originIATA)
 	if err != nil {
-		writeError(w, mapHTTPStatus(err), mapGRPCError(err))
+		writeUpstreamError(w, r, err)
 		return
 	}
 
+	lang := i18n.FromContext(r.Context())
+	for _, slot := range resp.GetSlots() {
+		slot.Label = slotLabel(slot.GetSlot(), lang)
+	}
+
 	data, err := protojson.MarshalOptions{
 		EmitUnpopulated: true,
 	}.Marshal(resp)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to encode response")
+		writeProblem(w, r, problem.NewWithStatus(http.StatusInternalServerError, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "failed to encode response"))
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(data)
-}
-
-func parseAirfareMatchIDFromPath(path string) (int64, bool) {
-	const prefix = "/v1/matches/"
-	const suffix = "/airfare"
-	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
-		return 0, false
+	etag := httpcache.ETag(data)
+	var maxAge time.Duration
+	if fetchedAt := resp.GetFetchedAt(); fetchedAt != nil {
+		// the prices stay the same until airfare-provider loads them again
+		etag = httpcache.ETag([]byte(strconv.FormatInt(matchID, 10)), []byte(originIATA), []byte(fetchedAt.AsTime().Format(time.RFC3339Nano)), []byte(lang))
 	}
-
-	idPart := strings.TrimSuffix(strings.TrimPrefix(path, prefix), suffix)
-	idPart = strings.Trim(idPart, "/")
-	if idPart == "" {
-		return 0, false
-	}
-
-	id, err := strconv.ParseInt(idPart, 10, 64)
-	if err != nil || id <= 0 {
-		return 0, false
-	}
-	return id, true
-}
-
-func mapHTTPStatus(err error) int {
-	st, ok := status.FromError(err)
-	if !ok {
-		return http.StatusBadGateway
+	if expiresAt := resp.GetExpiresAt(); expiresAt != nil {
+		maxAge = time.Until(expiresAt.AsTime())
 	}
 
-	switch st.Code() {
-	case codes.InvalidArgument:
-		return http.StatusBadRequest
-	case codes.NotFound:
-		return http.StatusNotFound
-	case codes.Unavailable:
-		return http.StatusServiceUnavailable
-	case codes.DeadlineExceeded:
-		return http.StatusGatewayTimeout
-	default:
-		return http.StatusBadGateway
-	}
+	httpcache.Write(w, r, "application/json", data, etag, maxAge)
 }
 
-func mapGRPCError(err error) string {
-	st, ok := status.FromError(err)
+func slotLabel(slot airfarev1.FareSlotType, lang i18n.Lang) string {
+	label, ok := slotLabels[slot]
 	if !ok {
-		return "upstream error"
+		return ""
 	}
-	return st.Message()
+	return i18n.T(lang, label)
 }
 
 func isValidIATA(v string) bool {
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	maxKeyNameLength  = 100
+	maxIssueBodyBytes = 1 << 16
+)
+
+// APIKeyHandler serves the admin endpoints that issue and revoke API keys.
+type APIKeyHandler struct {
+	log      *zap.Logger
+	store    apikey.Store
+	defaults apikey.Limits
+	timeout  time.Duration
+}
+
+type issueKeyRequest struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	RatePerMinute *int     `json:"rate_per_minute"`
+	Burst         *int     `json:"burst"`
+	DailyQuota    *int     `json:"daily_quota"`
+}
+
+type apiKeyResponse struct {
+	ID            string    `json:"id"`
+	Key           string    `json:"key,omitempty"`
+	Name          string    `json:"name"`
+	Scopes        []string  `json:"scopes"`
+	RatePerMinute int       `json:"rate_per_minute"`
+	Burst         int       `json:"burst"`
+	DailyQuota    int       `json:"daily_quota"`
+	CreatedAt     time.Time `json:"created_at"`
+	RevokedAt     string    `json:"revoked_at,omitempty"`
+}
+
+func NewAPIKeyHandler(log *zap.Logger, store apikey.Store, defaults apikey.Limits, timeout time.Duration) *APIKeyHandler {
+	return &APIKeyHandler{
+		log:      log,
+		store:    store,
+		defaults: defaults,
+		timeout:  timeout,
+	}
+}
+
+// IssueKey serves POST /v1/admin/keys. The plain key is only in this response.
+func (h *APIKeyHandler) IssueKey(w http.ResponseWriter, r *http.Request) {
+	var req issueKeyRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxIssueBodyBytes)).Decode(&req); err != nil {
+		writeProblem(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "request body must be a JSON object"))
+		return
+	}
+
+	name, scopes, limits, perr := h.parseIssueRequest(req)
+	if perr != nil {
+		writeParamError(w, r, perr)
+		return
+	}
+
+	key, plain, err := apikey.NewKey(name, scopes, limits, time.Now())
+	if err != nil {
+		h.log.Error("generate api key failed", zap.Error(err))
+		writeProblem(w, r, problem.NewWithStatus(http.StatusInternalServerError, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "API key cannot be generated"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	if err := h.store.SaveKey(ctx, key); err != nil {
+		h.log.Error("save api key failed", zap.Error(err))
+		writeKeyStoreError(w, r)
+		return
+	}
+
+	h.log.Info("api key issued", zap.String("key_id", key.ID), zap.String("name", key.Name))
+	resp := mapAPIKeyResponse(key)
+	resp.Key = plain
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListKeys serves GET /v1/admin/keys, revoked keys included.
+func (h *APIKeyHandler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	keys, err := h.store.ListKeys(ctx)
+	if err != nil {
+		h.log.Error("list api keys failed", zap.Error(err))
+		writeKeyStoreError(w, r)
+		return
+	}
+
+	items := make([]apiKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, mapAPIKeyResponse(k))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": items,
+	})
+}
+
+// RevokeKey serves DELETE /v1/admin/keys/{id}.
+func (h *APIKeyHandler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSpace(r.PathValue("id"))
+	if id == "" {
+		writeParamError(w, r, invalidParam("id", "key id is required"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	if err := h.store.RevokeKey(ctx, id, time.Now()); err != nil {
+		if errors.Is(err, apikey.ErrKeyNotFound) {
+			writeProblem(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_API_KEY_NOT_FOUND, "API key "+id+" not found"))
+			return
+		}
+		h.log.Error("revoke api key failed", zap.String("key_id", id), zap.Error(err))
+		writeKeyStoreError(w, r)
+		return
+	}
+
+	h.log.Info("api key revoked", zap.String("key_id", id))
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (h *APIKeyHandler) parseIssueRequest(req issueKeyRequest) (string, []apikey.Scope, apikey.Limits, *paramError) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return "", nil, apikey.Limits{}, invalidParam("name", "name is required")
+	}
+	if len(name) > maxKeyNameLength {
+		return "", nil, apikey.Limits{}, invalidParam("name", "name is too long")
+	}
+
+	if len(req.Scopes) == 0 {
+		return "", nil, apikey.Limits{}, invalidParam("scopes", "at least one scope is required")
+	}
+	scopes := make([]apikey.Scope, 0, len(req.Scopes))
+	for _, raw := range req.Scopes {
+		scope, ok := apikey.ParseScope(strings.TrimSpace(raw))
+		if !ok {
+			return "", nil, apikey.Limits{}, invalidParam("scopes", "unknown scope "+raw)
+		}
+		scopes = append(scopes, scope)
+	}
+
+	limits := h.defaults
+	for _, l := range []struct {
+		field string
+		value *int
+		dst   *int
+	}{
+		{"rate_per_minute", req.RatePerMinute, &limits.RatePerMinute},
+		{"burst", req.Burst, &limits.Burst},
+		{"daily_quota", req.DailyQuota, &limits.DailyQuota},
+	} {
+		if l.value == nil {
+			continue
+		}
+		if *l.value < 0 {
+			return "", nil, apikey.Limits{}, invalidParam(l.field, "must not be negative")
+		}
+		*l.dst = *l.value
+	}
+
+	return name, scopes, limits, nil
+}
+
+func writeKeyStoreError(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, problem.NewWithStatus(http.StatusServiceUnavailable, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "API key storage is unavailable"))
+}
+
+func mapAPIKeyResponse(k apikey.Key) apiKeyResponse {
+	scopes := make([]string, 0, len(k.Scopes))
+	for _, s := range k.Scopes {
+		scopes = append(scopes, string(s))
+	}
+
+	resp := apiKeyResponse{
+		ID:            k.ID,
+		Name:          k.Name,
+		Scopes:        scopes,
+		RatePerMinute: k.Limits.RatePerMinute,
+		Burst:         k.Limits.Burst,
+		DailyQuota:    k.Limits.DailyQuota,
+		CreatedAt:     k.CreatedAt,
+	}
+	if k.Revoked() {
+		resp.RevokedAt = k.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/ics"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	calendarProdID        = "-//fan-avia//matches//RU"
+	calendarMatchDuration = 2 * time.Hour
+	calendarFeedLimit     = 100
+)
+
+type CalendarHandler struct {
+	log               *zap.Logger
+	client            *match.Client
+	timeout           time.Duration
+	siteBaseURL       string
+	defaultOriginIATA string
+}
+
+func NewCalendarHandler(log *zap.Logger, client *match.Client, timeout time.Duration, siteBaseURL string, defaultOriginIATA string) *CalendarHandler {
+	return &CalendarHandler{
+		log:               log,
+		client:            client,
+		timeout:           timeout,
+		siteBaseURL:       strings.TrimRight(strings.TrimSpace(siteBaseURL), "/"),
+		defaultOriginIATA: strings.ToUpper(strings.TrimSpace(defaultOriginIATA)),
+	}
+}
+
+// GetUpcomingCalendar serves /v1/matches/upcoming.ics.
+func (h *CalendarHandler) GetUpcomingCalendar(w http.ResponseWriter, r *http.Request) {
+	filter, paramErr := parseMatchFilter(r, calendarFeedLimit, calendarFeedLimit, calendarFeedLimit)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
+		return
+	}
+	filter.Cursor = ""
+
+	h.writeCalendar(w, r, filter, "", i18n.T(i18n.FromContext(r.Context()), "Matches"))
+}
+
+// GetClubCalendar serves /v1/clubs/{id}/calendar.ics.
+func (h *CalendarHandler) GetClubCalendar(w http.ResponseWriter, r *http.Request) {
+	clubID, ok := parseClubID(r.PathValue("id"))
+	if !ok {
+		writeParamError(w, r, invalidParam("club_id", "club id must be a positive integer"))
+		return
+	}
+
+	h.writeCalendar(w, r, match.MatchFilter{Limit: calendarFeedLimit, ClubIDs: []string{clubID}}, clubID, "")
+}
+
+func (h *CalendarHandler) writeCalendar(w http.ResponseWriter, r *http.Request, filter match.MatchFilter, clubID string, name string) {
+	originIATA := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("origin_iata")))
+	if originIATA == "" {
+		originIATA = h.defaultOriginIATA
+	}
+	if originIATA != "" && !isValidIATA(originIATA) {
+		writeParamError(w, r, invalidParam("origin_iata", "origin_iata must be 3 latin letters"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.client.GetUpcomingMatches(ctx, filter)
+	if err != nil {
+		h.log.Error("get upcoming matches for calendar failed", zap.Error(err), zap.String("club_id", clubID))
+		writeUpstreamError(w, r, err)
+		return
+	}
+
+	clubsResp, err := h.client.GetClubs(ctx)
+	if err != nil {
+		h.log.Error("get clubs failed", zap.Error(err))
+		writeUpstreamError(w, r, err)
+		return
+	}
+	lang := i18n.FromContext(r.Context())
+	clubIndex := buildClubIndex(clubsResp.GetClubs(), lang)
+
+	if clubID != "" {
+		club, ok := clubIndex[clubID]
+		if !ok {
+			writeProblem(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND, "club not found"))
+			return
+		}
+		name = club.Name
+	}
+
+	cal := ics.Calendar{
+		ProdID: calendarProdID,
+		Name:   name,
+		Events: make([]ics.Event, 0, len(resp.GetMatches())),
+	}
+	now := time.Now().UTC()
+	for _, m := range resp.GetMatches() {
+		if m == nil || m.GetKickoffUtc() == nil {
+			continue
+		}
+		cal.Events = append(cal.Events, h.buildEvent(m, clubIndex, originIATA, now, lang))
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := cal.WriteTo(w); err != nil {
+		h.log.Warn("failed to write calendar", zap.Error(err))
+	}
+}
+
+func (h *CalendarHandler) buildEvent(m *matchv1.Match, clubs map[string]*clubView, originIATA string, stamp time.Time, lang i18n.Lang) ics.Event {
+	kickoff := m.GetKickoffUtc().AsTime()
+	matchID := strconv.FormatInt(m.GetMatchId(), 10)
+	airfareURL := h.airfareURL(matchID, originIATA)
+
+	description := make([]string, 0, 3)
+	if m.GetKickoffTentative() {
+		description = append(description, i18n.T(lang, "Kickoff time to be confirmed"))
+	}
+	if link := strings.TrimSpace(m.GetTicketsLink()); link != "" {
+		description = append(description, i18n.T(lang, "Tickets: "+link))
+	}
+	if airfareURL != "" {
+		description = append(description, i18n.T(lang, "Airfare: "+airfareURL))
+	}
+
+	place := strings.TrimSpace(matchStadium(m, lang))
+	if city := strings.TrimSpace(i18n.Name(lang, m.GetCity(), m.GetCityEn())); city != "" {
+		if place != "" {
+			place += ", "
+		}
+		place += city
+	}
+
+	event := ics.Event{
+		UID:         fmt.Sprintf("match-%s@fan-avia", matchID),
+		Sequence:    int(m.GetKickoffRevision()),
+		Start:       kickoff,
+		End:         kickoff.Add(calendarMatchDuration),
+		Location:    matchLocation(m),
+		Summary:     fmt.Sprintf("%s — %s", clubName(clubs, m.GetClubHomeId()), clubName(clubs, m.GetClubAwayId())),
+		Description: strings.Join(description, "\n"),
+		Place:       place,
+		URL:         airfareURL,
+		Stamp:       stamp,
+	}
+	// a placeholder time in the calendar looks like a real one, so only the day is shown
+	if m.GetKickoffTentative() {
+		event.AllDay = true
+		event.Start = matchDate(m)
+	}
+
+	return event
+}
+
+func (h *CalendarHandler) airfareURL(matchID string, originIATA string) string {
+	if h.siteBaseURL == "" {
+		return ""
+	}
+
+	link := fmt.Sprintf("%s/matches/%s/airfare", h.siteBaseURL, matchID)
+	if originIATA != "" {
+		link += "?origin_iata=" + url.QueryEscape(originIATA)
+	}
+	return link
+}
+
+func clubName(clubs map[string]*clubView, clubID string) string {
+	if club, ok := clubs[clubID]; ok && club.Name != "" {
+		return club.Name
+	}
+	if clubID == "" {
+		return "TBD"
+	}
+	return clubID
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type calendarMatchClient struct {
+	matchv1.MatchAdapterServiceClient
+	matches []*matchv1.Match
+	clubs   []*matchv1.Club
+	filter  *matchv1.GetUpcomingMatchesRequest
+}
+
+func (c *calendarMatchClient) GetUpcomingMatches(_ context.Context, req *matchv1.GetUpcomingMatchesRequest, _ ...grpc.CallOption) (*matchv1.GetUpcomingMatchesResponse, error) {
+	c.filter = req
+	return &matchv1.GetUpcomingMatchesResponse{Matches: c.matches}, nil
+}
+
+func (c *calendarMatchClient) GetClubs(_ context.Context, _ *matchv1.GetClubsRequest, _ ...grpc.CallOption) (*matchv1.GetClubsResponse, error) {
+	return &matchv1.GetClubsResponse{Clubs: c.clubs}, nil
+}
+
+func TestCalendarHandler_GetClubCalendar(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Kaliningrad"); err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	kickoff := time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC)
+	client := &calendarMatchClient{
+		matches: []*matchv1.Match{
+			{
+				MatchId:                16114,
+				KickoffUtc:             timestamppb.New(kickoff),
+				KickoffRevision:        2,
+				City:                   "Калининград",
+				Stadium:                "Ростех Арена",
+				DestinationAirportIata: "KGD",
+				ClubHomeId:             "3",
+				ClubAwayId:             "4",
+				TicketsLink:            "https://tickets.example/16114",
+			},
+			{
+				MatchId:                16115,
+				KickoffUtc:             timestamppb.New(kickoff.AddDate(0, 0, 7)),
+				KickoffTentative:       true,
+				DestinationAirportIata: "LED",
+				ClubHomeId:             "4",
+				ClubAwayId:             "3",
+			},
+		},
+		clubs: []*matchv1.Club{
+			{ClubId: "3", NameRu: "Балтика", NameEn: "Baltika"},
+			{ClubId: "4", NameRu: "Зенит", NameEn: "Zenit"},
+		},
+	}
+	h := NewCalendarHandler(zap.NewNop(), match.NewClient(client, time.Second), time.Second, "https://fan-avia.example/", "mow")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/clubs/3/calendar.ics", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(i18n.WithContext(req.Context(), i18n.RU))
+	rec := httptest.NewRecorder()
+	h.GetClubCalendar(rec, req)
+
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/calendar") {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	if ids := client.filter.GetClubIds(); len(ids) != 1 || ids[0] != "3" {
+		t.Fatalf("expected the club filter, got %v", ids)
+	}
+
+	out := strings.ReplaceAll(rec.Body.String(), "\r\n ", "")
+	for _, want := range []string{
+		"X-WR-CALNAME:Балтика\r\n",
+		"UID:match-16114@fan-avia\r\n",
+		"SEQUENCE:2\r\n",
+		"DTSTART;TZID=Europe/Kaliningrad:20260301T180000\r\n",
+		"DTEND;TZID=Europe/Kaliningrad:20260301T200000\r\n",
+		"TZID:Europe/Kaliningrad\r\n",
+		"SUMMARY:Балтика — Зенит\r\n",
+		"LOCATION:Ростех Арена\\, Калининград\r\n",
+		"Билеты: https://tickets.example/16114",
+		"URL:https://fan-avia.example/matches/16114/airfare?origin_iata=MOW\r\n",
+		"UID:match-16115@fan-avia\r\n",
+		"SEQUENCE:0\r\n",
+		"DTSTART;VALUE=DATE:20260308\r\n",
+		"DESCRIPTION:Время начала уточняется\\nАвиабилеты: https://fan-avia.example/matches/16115/airfare?origin_iata=MOW\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("calendar is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCalendarHandler_GetClubCalendar_UnknownClub(t *testing.T) {
+	h := NewCalendarHandler(zap.NewNop(), match.NewClient(&calendarMatchClient{}, time.Second), time.Second, "", "")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/clubs/999/calendar.ics", nil)
+	req.SetPathValue("id", "999")
+	rec := httptest.NewRecorder()
+	h.GetClubCalendar(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
@@ -2,15 +2,21 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/airfare"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
 	airfarev1 "github.com/ozzus/fan-avia/protos/gen/go/airfare/v1"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 	"go.uber.org/zap"
 )
 
@@ -18,7 +24,6 @@ const (
 	defaultUpcomingWithAirfareLimit = 12
 	defaultClubUpcomingWithAirfare  = 100
 	maxUpcomingWithAirfareLimit     = 100
-	maxMatchAdapterUpcomingLimit    = 100
 	defaultUpcomingWithAirfareTO    = 20 * time.Second
 	maxConcurrentAirfareCalls       = 4
 )
@@ -29,22 +34,27 @@ type CatalogHandler struct {
 	airfareClient     *airfare.Client
 	timeout           time.Duration
 	defaultOriginIATA string
+	cache             *httpcache.Cache
+	cacheTTL          time.Duration
 }
 
 type upcomingWithAirfareItem struct {
 	Match              matchResponse `json:"match"`
 	MinPrice           *int64        `json:"min_price,omitempty"`
 	BestSlot           string        `json:"best_slot,omitempty"`
+	BestSlotLabel      string        `json:"best_slot_label,omitempty"`
 	BestDate           string        `json:"best_date,omitempty"`
 	BestOutboundPrice  *int64        `json:"best_outbound_price,omitempty"`
 	BestReturnPrice    *int64        `json:"best_return_price,omitempty"`
 	BestReturnDate     string        `json:"best_return_date,omitempty"`
 	BestRoundTripPrice *int64        `json:"best_round_trip_price,omitempty"`
 	AirfareError       string        `json:"airfare_error,omitempty"`
+	AirfareErrorCode   string        `json:"airfare_error_code,omitempty"`
 }
 
 type airfareLoadError struct {
 	MatchID string `json:"match_id"`
+	Code    string `json:"code"`
 	Error   string `json:"error"`
 }
 
@@ -52,9 +62,12 @@ type upcomingWithAirfareResponse struct {
 	OriginIATA string                    `json:"origin_iata"`
 	Items      []upcomingWithAirfareItem `json:"items"`
 	Errors     []airfareLoadError        `json:"errors"`
+	NextCursor string                    `json:"next_cursor"`
 }
 
-func NewCatalogHandler(log *zap.Logger, matchClient *match.Client, airfareClient *airfare.Client, timeout time.Duration, defaultOriginIATA string) *CatalogHandler {
+// NewCatalogHandler keeps responses in cache for cacheTTL; identical requests
+// meanwhile share one load.
+func NewCatalogHandler(log *zap.Logger, matchClient *match.Client, airfareClient *airfare.Client, timeout time.Duration, defaultOriginIATA string, cache *httpcache.Cache, cacheTTL time.Duration) *CatalogHandler {
 	if timeout <= 0 {
 		timeout = defaultUpcomingWithAirfareTO
 	}
@@ -65,36 +78,45 @@ func NewCatalogHandler(log *zap.Logger, matchClient *match.Client, airfareClient
 		airfareClient:     airfareClient,
 		timeout:           timeout,
 		defaultOriginIATA: strings.ToUpper(strings.TrimSpace(defaultOriginIATA)),
+		cache:             cache,
+		cacheTTL:          cacheTTL,
 	}
 }
 
 func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	filter, paramErr := parseMatchFilter(r, defaultUpcomingWithAirfareLimit, defaultClubUpcomingWithAirfare, maxUpcomingWithAirfareLimit)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
 		return
 	}
 
-	clubID, _, clubErr := parsePositiveIntQuery(r, "club_id")
-	if clubErr != "" {
-		writeError(w, http.StatusBadRequest, "club_id must be a positive integer")
+	h.upcomingWithAirfare(w, r, filter, "")
+}
+
+// GetClubUpcomingWithAirfare serves /v1/clubs/{id}/matches/upcoming-with-airfare.
+func (h *CatalogHandler) GetClubUpcomingWithAirfare(w http.ResponseWriter, r *http.Request) {
+	clubID, ok := parseClubID(r.PathValue("id"))
+	if !ok {
+		writeParamError(w, r, invalidParam("club_id", "club id must be a positive integer"))
 		return
 	}
 
-	limit := int32(defaultUpcomingWithAirfareLimit)
-	if clubID != "" {
-		limit = defaultClubUpcomingWithAirfare
+	filter, paramErr := parseMatchFilter(r, defaultClubUpcomingWithAirfare, defaultClubUpcomingWithAirfare, maxUpcomingWithAirfareLimit)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
+		return
 	}
+	filter.ClubIDs = []string{clubID}
 
-	if raw := strings.TrimSpace(r.URL.Query().Get("limit")); raw != "" {
-		parsed, err := strconv.ParseInt(raw, 10, 32)
-		if err != nil || parsed <= 0 {
-			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
-			return
-		}
-		if parsed > maxUpcomingWithAirfareLimit {
-			parsed = maxUpcomingWithAirfareLimit
-		}
-		limit = int32(parsed)
+	h.upcomingWithAirfare(w, r, filter, clubID)
+}
+
+// upcomingWithAirfare answers 404 for an unknown clubID when it is set.
+func (h *CatalogHandler) upcomingWithAirfare(w http.ResponseWriter, r *http.Request, filter match.MatchFilter, clubID string) {
+	q, paramErr := parseCatalogQuery(r)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
+		return
 	}
 
 	originIATA := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("origin_iata")))
@@ -102,41 +124,140 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 		originIATA = h.defaultOriginIATA
 	}
 	if originIATA == "" {
-		writeError(w, http.StatusBadRequest, "origin_iata is required")
+		writeParamError(w, r, invalidParam("origin_iata", "origin_iata is required"))
 		return
 	}
 	if !isValidIATA(originIATA) {
-		writeError(w, http.StatusBadRequest, "origin_iata must be 3 latin letters")
+		writeParamError(w, r, invalidParam("origin_iata", "origin_iata must be 3 latin letters"))
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
-	defer cancel()
+	filter.IncludeClubs = true
+	lang := i18n.FromContext(r.Context())
+	// sorting and price filters are applied per request, so that they share
+	// the load and the cache slot of the page
+	key := catalogCacheKey(filter, clubID, originIATA, lang)
+	entry, err := h.cache.Get(r.Context(), key, func(ctx context.Context) ([]byte, time.Duration, error) {
+		ctx, cancel := context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+
+		resp, err := h.loadUpcomingWithAirfare(ctx, filter, clubID, originIATA, lang)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ttl := h.cacheTTL
+		if !resp.settled() {
+			ttl = 0
+		}
 
-	fetchLimit := limit
-	if clubID != "" && fetchLimit < maxMatchAdapterUpcomingLimit {
-		fetchLimit = maxMatchAdapterUpcomingLimit
+		body, err := json.Marshal(resp)
+		if err != nil {
+			return nil, 0, err
+		}
+		return append(body, '\n'), ttl, nil
+	})
+	if err != nil {
+		var cp catalogProblem
+		if errors.As(err, &cp) {
+			writeProblem(w, r, cp.problem)
+			return
+		}
+		writeUpstreamError(w, r, err)
+		return
 	}
 
-	upcomingResp, err := h.matchClient.GetUpcomingMatches(ctx, fetchLimit, clubID)
-	if err != nil {
-		h.log.Error("get upcoming matches failed", zap.Error(err), zap.Int32("limit", limit))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+	maxAge := entry.MaxAge(time.Now())
+	if q.noop() {
+		httpcache.Write(w, r, "application/json", entry.Body, entry.ETag, maxAge)
 		return
 	}
 
-	clubsResp, err := h.matchClient.GetClubs(ctx)
-	if err != nil {
-		h.log.Error("get clubs failed", zap.Error(err))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+	// decoding gives the request its own copy of the cached page
+	var resp upcomingWithAirfareResponse
+	if err := json.Unmarshal(entry.Body, &resp); err != nil {
+		writeProblem(w, r, problem.NewWithStatus(http.StatusInternalServerError, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "failed to decode cached response"))
 		return
 	}
-	clubIndex := buildClubIndex(clubsResp.GetClubs())
+	writeCacheableJSON(w, r, q.apply(resp), maxAge)
+}
+
+// catalogProblem is a load failure answered as is rather than as a backend
+// error.
+type catalogProblem struct {
+	problem *problem.Problem
+}
+
+func (e catalogProblem) Error() string {
+	return e.problem.Code + ": " + e.problem.Detail
+}
+
+// settled reports whether the airfare of every item is final for the cache
+// lifetime: found, or missing for a reason a retry would not change.
+func (r upcomingWithAirfareResponse) settled() bool {
+	for _, e := range r.Errors {
+		switch e.Code {
+		case problem.Code(commonv1.ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND),
+			problem.Code(commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION),
+			problem.Code(commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// catalogCacheKey covers every input of a catalog page before catalogQuery
+// is applied.
+func catalogCacheKey(filter match.MatchFilter, clubID, originIATA string, lang i18n.Lang) string {
+	return strings.Join([]string{
+		string(lang),
+		clubID,
+		originIATA,
+		strconv.Itoa(int(filter.Limit)),
+		strings.Join(filter.ClubIDs, ","),
+		filter.ClubSide.String(),
+		filter.Competition,
+		filter.DestinationIATA,
+		filter.City,
+		formatFilterTime(filter.From),
+		formatFilterTime(filter.To),
+		filter.Order.String(),
+		filter.Cursor,
+	}, "|")
+}
+
+func formatFilterTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// loadUpcomingWithAirfare fails with catalogProblem for an unknown clubID
+// when it is set.
+func (h *CatalogHandler) loadUpcomingWithAirfare(ctx context.Context, filter match.MatchFilter, clubID, originIATA string, lang i18n.Lang) (upcomingWithAirfareResponse, error) {
+	if clubID != "" {
+		club, err := findClub(ctx, h.matchClient, clubID)
+		if err != nil {
+			h.log.Error("get clubs failed", zap.Error(err), zap.String("club_id", clubID))
+			return upcomingWithAirfareResponse{}, err
+		}
+		if club == nil {
+			return upcomingWithAirfareResponse{}, catalogProblem{problem.New(commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND, "club not found")}
+		}
+	}
 
-	matches := cutMatchesByLimit(filterMatchesByClubID(upcomingResp.GetMatches(), clubID), limit)
+	upcomingResp, err := h.matchClient.GetUpcomingMatches(ctx, filter)
+	if err != nil {
+		h.log.Error("get upcoming matches failed", zap.Error(err), zap.Int32("limit", filter.Limit))
+		return upcomingWithAirfareResponse{}, err
+	}
+
+	matches := upcomingResp.GetMatches()
 	items := make([]upcomingWithAirfareItem, 0, len(matches))
 	for _, m := range matches {
-		items = append(items, upcomingWithAirfareItem{Match: mapMatch(m, clubIndex)})
+		items = append(items, upcomingWithAirfareItem{Match: mapMatch(m, lang)})
 	}
 
 	type airfareResult struct {
@@ -148,6 +269,7 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 		bestReturnPrice    *int64
 		bestReturnDate     string
 		bestRoundTripPrice *int64
+		errCode            string
 		errMessage         string
 	}
 
@@ -159,6 +281,7 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 		if strings.EqualFold(strings.TrimSpace(items[i].Match.DestinationAirportIATA), originIATA) {
 			resultsCh <- airfareResult{
 				index:      i,
+				errCode:    problem.Code(commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION),
 				errMessage: "origin_iata and destination_iata must differ",
 			}
 			continue
@@ -172,9 +295,15 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 
 			airfareResp, err := h.airfareClient.GetAirfareByMatch(ctx, matchID, originIATA)
 			if err != nil {
+				p := problem.FromGRPC(err)
+				message := p.Detail
+				if message == "" {
+					message = p.Title
+				}
 				resultsCh <- airfareResult{
 					index:      idx,
-					errMessage: mapGRPCError(err),
+					errCode:    p.Code,
+					errMessage: message,
 				}
 				return
 			}
@@ -183,6 +312,7 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 			if minPrice == nil {
 				resultsCh <- airfareResult{
 					index:      idx,
+					errCode:    problem.Code(commonv1.ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND),
 					errMessage: "no airfare offers found",
 				}
 				return
@@ -208,13 +338,18 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 
 	resp := upcomingWithAirfareResponse{
 		OriginIATA: originIATA,
+		NextCursor: upcomingResp.GetNextCursor(),
 		Items:      items,
 		Errors:     make([]airfareLoadError, 0),
 	}
 
 	for r := range resultsCh {
+		r.errMessage = i18n.T(lang, r.errMessage)
 		resp.Items[r.index].MinPrice = r.minPrice
 		resp.Items[r.index].BestSlot = r.bestSlot
+		if r.bestSlot != "" {
+			resp.Items[r.index].BestSlotLabel = slotLabel(airfarev1.FareSlotType(airfarev1.FareSlotType_value[r.bestSlot]), lang)
+		}
 		resp.Items[r.index].BestDate = r.bestDate
 		resp.Items[r.index].BestOutboundPrice = r.bestOutboundPrice
 		resp.Items[r.index].BestReturnPrice = r.bestReturnPrice
@@ -222,14 +357,16 @@ func (h *CatalogHandler) GetUpcomingWithAirfare(w http.ResponseWriter, r *http.R
 		resp.Items[r.index].BestRoundTripPrice = r.bestRoundTripPrice
 		resp.Items[r.index].AirfareError = r.errMessage
 		if r.errMessage != "" {
+			resp.Items[r.index].AirfareErrorCode = r.errCode
 			resp.Errors = append(resp.Errors, airfareLoadError{
 				MatchID: resp.Items[r.index].Match.MatchID,
+				Code:    r.errCode,
 				Error:   r.errMessage,
 			})
 		}
 	}
 
-	writeJSON(w, http.StatusOK, resp)
+	return resp, nil
 }
 
 func findBestFare(slots []*airfarev1.FareSlot) (*int64, string, string, *int64, *int64, string, *int64) {
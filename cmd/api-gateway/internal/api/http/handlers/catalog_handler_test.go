@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+)
+
+func TestUpcomingWithAirfareResponseSettled(t *testing.T) {
+	loadError := func(reason commonv1.ErrorReason) airfareLoadError {
+		return airfareLoadError{MatchID: "1", Code: problem.Code(reason)}
+	}
+	tests := []struct {
+		name   string
+		errors []airfareLoadError
+		want   bool
+	}{
+		{name: "no errors", want: true},
+		{
+			name: "final errors",
+			errors: []airfareLoadError{
+				loadError(commonv1.ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND),
+				loadError(commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION),
+				loadError(commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN),
+			},
+			want: true,
+		},
+		{
+			name: "source outage",
+			errors: []airfareLoadError{
+				loadError(commonv1.ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND),
+				loadError(commonv1.ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := upcomingWithAirfareResponse{Errors: tt.errors}
+			if got := resp.settled(); got != tt.want {
+				t.Fatalf("settled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogCacheKey(t *testing.T) {
+	base := match.MatchFilter{Limit: 12, ClubIDs: []string{"1"}}
+	key := catalogCacheKey(base, "", "MOW", i18n.RU)
+
+	if got := catalogCacheKey(base, "", "MOW", i18n.RU); got != key {
+		t.Fatalf("same request, different keys: %q and %q", key, got)
+	}
+
+	variants := []match.MatchFilter{
+		{Limit: 6, ClubIDs: []string{"1"}},
+		{Limit: 12, ClubIDs: []string{"1", "3"}},
+		{Limit: 12, ClubIDs: []string{"1"}, ClubSide: matchv1.ClubSide_CLUB_SIDE_HOME},
+		{Limit: 12, ClubIDs: []string{"1"}, From: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Limit: 12, ClubIDs: []string{"1"}, Cursor: "next"},
+	}
+	for _, f := range variants {
+		if catalogCacheKey(f, "", "MOW", i18n.RU) == key {
+			t.Fatalf("filter %+v shares the key of %+v", f, base)
+		}
+	}
+	if catalogCacheKey(base, "", "LED", i18n.RU) == key || catalogCacheKey(base, "1", "MOW", i18n.RU) == key {
+		t.Fatal("origin or club path shares the key")
+	}
+	if catalogCacheKey(base, "", "MOW", i18n.EN) == key {
+		t.Fatal("languages share the key")
+	}
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type catalogSort string
+
+const (
+	catalogSortKickoff   catalogSort = "kickoff"
+	catalogSortPrice     catalogSort = "price"
+	catalogSortRoundTrip catalogSort = "round_trip"
+)
+
+// catalogQuery narrows and orders a catalog page once its airfare is known.
+// direction picks the price that sort=price, max_price and only_with_airfare
+// look at: the outbound or the return one instead of the cheapest of both.
+type catalogQuery struct {
+	sort              catalogSort
+	direction         string
+	maxPrice          int64
+	maxRoundTripPrice int64
+	onlyWithAirfare   bool
+}
+
+func parseCatalogQuery(r *http.Request) (catalogQuery, *paramError) {
+	query := r.URL.Query()
+	q := catalogQuery{sort: catalogSortKickoff}
+
+	switch raw := catalogSort(strings.ToLower(strings.TrimSpace(query.Get("sort")))); raw {
+	case "":
+	case catalogSortKickoff, catalogSortPrice, catalogSortRoundTrip:
+		q.sort = raw
+	default:
+		return catalogQuery{}, invalidParam("sort", "sort must be kickoff, price or round_trip")
+	}
+
+	switch raw := strings.ToLower(strings.TrimSpace(query.Get("direction"))); raw {
+	case "", "outbound", "return":
+		q.direction = raw
+	default:
+		return catalogQuery{}, invalidParam("direction", "direction must be outbound or return")
+	}
+
+	if raw := strings.TrimSpace(query.Get("only_with_airfare")); raw != "" {
+		only, err := strconv.ParseBool(raw)
+		if err != nil {
+			return catalogQuery{}, invalidParam("only_with_airfare", "only_with_airfare must be true or false")
+		}
+		q.onlyWithAirfare = only
+	}
+
+	var paramErr *paramError
+	if q.maxPrice, paramErr = parsePriceQuery(r, "max_price"); paramErr != nil {
+		return catalogQuery{}, paramErr
+	}
+	if q.maxRoundTripPrice, paramErr = parsePriceQuery(r, "max_round_trip_price"); paramErr != nil {
+		return catalogQuery{}, paramErr
+	}
+
+	return q, nil
+}
+
+// parsePriceQuery reads an optional price in roubles; 0 means no limit.
+func parsePriceQuery(r *http.Request, key string) (int64, *paramError) {
+	raw := strings.TrimSpace(r.URL.Query().Get(key))
+	if raw == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0, invalidParam(key, key+" must be a positive integer")
+	}
+	return parsed, nil
+}
+
+// noop reports whether q leaves the page as loaded.
+func (q catalogQuery) noop() bool {
+	return q.sort == catalogSortKickoff && q.maxPrice == 0 && q.maxRoundTripPrice == 0 && !q.onlyWithAirfare
+}
+
+func (q catalogQuery) price(item upcomingWithAirfareItem) *int64 {
+	switch q.direction {
+	case "outbound":
+		return item.BestOutboundPrice
+	case "return":
+		return item.BestReturnPrice
+	default:
+		return item.MinPrice
+	}
+}
+
+func (q catalogQuery) keep(item upcomingWithAirfareItem) bool {
+	price := q.price(item)
+	if q.onlyWithAirfare && price == nil {
+		return false
+	}
+	if q.maxPrice > 0 && (price == nil || *price > q.maxPrice) {
+		return false
+	}
+	if q.maxRoundTripPrice > 0 && (item.BestRoundTripPrice == nil || *item.BestRoundTripPrice > q.maxRoundTripPrice) {
+		return false
+	}
+	return true
+}
+
+// apply keeps the kickoff order of the page for equal prices and puts items
+// without the sorted price last. Errors of the dropped items go with them.
+func (q catalogQuery) apply(resp upcomingWithAirfareResponse) upcomingWithAirfareResponse {
+	items := make([]upcomingWithAirfareItem, 0, len(resp.Items))
+	kept := make(map[string]struct{}, len(resp.Items))
+	for _, item := range resp.Items {
+		if q.keep(item) {
+			items = append(items, item)
+			kept[item.Match.MatchID] = struct{}{}
+		}
+	}
+
+	var sortPrice func(upcomingWithAirfareItem) *int64
+	switch q.sort {
+	case catalogSortPrice:
+		sortPrice = q.price
+	case catalogSortRoundTrip:
+		sortPrice = func(item upcomingWithAirfareItem) *int64 { return item.BestRoundTripPrice }
+	}
+	if sortPrice != nil {
+		slices.SortStableFunc(items, func(a, b upcomingWithAirfareItem) int {
+			pa, pb := sortPrice(a), sortPrice(b)
+			switch {
+			case pa == nil && pb == nil:
+				return 0
+			case pa == nil:
+				return 1
+			case pb == nil:
+				return -1
+			}
+			return cmp.Compare(*pa, *pb)
+		})
+	}
+
+	errs := make([]airfareLoadError, 0, len(resp.Errors))
+	for _, e := range resp.Errors {
+		if _, ok := kept[e.MatchID]; ok {
+			errs = append(errs, e)
+		}
+	}
+
+	resp.Items = items
+	resp.Errors = errs
+	return resp
+}
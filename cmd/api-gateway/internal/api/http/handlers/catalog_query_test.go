@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseCatalogQuery(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/matches/upcoming-with-airfare?sort=Price&direction=return&max_price=8000&max_round_trip_price=15000&only_with_airfare=true", nil)
+	q, paramErr := parseCatalogQuery(req)
+	if paramErr != nil {
+		t.Fatalf("unexpected error: %+v", paramErr)
+	}
+	want := catalogQuery{sort: catalogSortPrice, direction: "return", maxPrice: 8000, maxRoundTripPrice: 15000, onlyWithAirfare: true}
+	if q != want {
+		t.Fatalf("expected %+v, got %+v", want, q)
+	}
+
+	q, paramErr = parseCatalogQuery(httptest.NewRequest("GET", "/v1/matches/upcoming-with-airfare", nil))
+	if paramErr != nil || q != (catalogQuery{sort: catalogSortKickoff}) {
+		t.Fatalf("unexpected defaults %+v, %+v", q, paramErr)
+	}
+}
+
+func TestParseCatalogQuery_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"sort=cheapest":               "sort",
+		"direction=both":              "direction",
+		"only_with_airfare=yes":       "only_with_airfare",
+		"max_price=0":                 "max_price",
+		"max_price=8k":                "max_price",
+		"max_round_trip_price=-15000": "max_round_trip_price",
+	}
+	for rawQuery, field := range tests {
+		_, paramErr := parseCatalogQuery(httptest.NewRequest("GET", "/v1/matches/upcoming-with-airfare?"+rawQuery, nil))
+		if paramErr == nil || paramErr.field != field {
+			t.Fatalf("%s: expected an error on %s, got %+v", rawQuery, field, paramErr)
+		}
+	}
+}
+
+func TestCatalogQueryApply(t *testing.T) {
+	item := func(matchID string, minPrice, outbound, back *int64) upcomingWithAirfareItem {
+		out := upcomingWithAirfareItem{
+			Match:             matchResponse{MatchID: matchID},
+			MinPrice:          minPrice,
+			BestOutboundPrice: outbound,
+			BestReturnPrice:   back,
+		}
+		if outbound != nil && back != nil {
+			out.BestRoundTripPrice = int64Ptr(*outbound + *back)
+		}
+		if minPrice == nil {
+			out.AirfareErrorCode = "AIRFARE_NOT_FOUND"
+		}
+		return out
+	}
+	resp := upcomingWithAirfareResponse{
+		Items: []upcomingWithAirfareItem{
+			item("1", int64Ptr(5000), int64Ptr(5000), int64Ptr(9000)),
+			item("2", nil, nil, nil),
+			item("3", int64Ptr(3000), int64Ptr(4000), int64Ptr(3000)),
+			item("4", int64Ptr(6000), int64Ptr(6000), nil),
+			item("5", int64Ptr(3000), int64Ptr(3000), int64Ptr(4500)),
+		},
+		Errors: []airfareLoadError{{MatchID: "2", Code: "AIRFARE_NOT_FOUND"}},
+	}
+
+	tests := []struct {
+		name       string
+		q          catalogQuery
+		wantIDs    string
+		wantErrors int
+	}{
+		{name: "kickoff order", q: catalogQuery{sort: catalogSortKickoff}, wantIDs: "1,2,3,4,5", wantErrors: 1},
+		{name: "cheapest first, ties keep kickoff order", q: catalogQuery{sort: catalogSortPrice}, wantIDs: "3,5,1,4,2", wantErrors: 1},
+		{name: "round trip, one-way fares last", q: catalogQuery{sort: catalogSortRoundTrip}, wantIDs: "3,5,1,2,4", wantErrors: 1},
+		{name: "return price", q: catalogQuery{sort: catalogSortPrice, direction: "return"}, wantIDs: "3,5,1,2,4", wantErrors: 1},
+		{name: "only with airfare", q: catalogQuery{sort: catalogSortKickoff, onlyWithAirfare: true}, wantIDs: "1,3,4,5"},
+		{name: "only with return airfare", q: catalogQuery{sort: catalogSortKickoff, direction: "return", onlyWithAirfare: true}, wantIDs: "1,3,5"},
+		{name: "max price", q: catalogQuery{sort: catalogSortPrice, maxPrice: 5000}, wantIDs: "3,5,1"},
+		{name: "max outbound price", q: catalogQuery{sort: catalogSortPrice, direction: "outbound", maxPrice: 4000}, wantIDs: "5,3"},
+		{name: "max round trip price", q: catalogQuery{sort: catalogSortKickoff, maxRoundTripPrice: 8000}, wantIDs: "3,5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.q.apply(resp)
+			ids := make([]string, 0, len(got.Items))
+			for _, it := range got.Items {
+				ids = append(ids, it.Match.MatchID)
+			}
+			if strings.Join(ids, ",") != tt.wantIDs {
+				t.Fatalf("expected items %s, got %s", tt.wantIDs, strings.Join(ids, ","))
+			}
+			if len(got.Errors) != tt.wantErrors {
+				t.Fatalf("expected %d errors, got %+v", tt.wantErrors, got.Errors)
+			}
+		})
+	}
+
+	if resp.Items[0].Match.MatchID != "1" || len(resp.Items) != 5 {
+		t.Fatal("apply changed the loaded response")
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 	"go.uber.org/zap"
 )
 
@@ -14,10 +18,12 @@ type ClubHandler struct {
 	log     *zap.Logger
 	client  *match.Client
 	timeout time.Duration
+	maxAge  time.Duration
 }
 
 type clubResponse struct {
 	ClubID      string `json:"club_id"`
+	Name        string `json:"name"`
 	NameRU      string `json:"name_ru"`
 	NameEN      string `json:"name_en,omitempty"`
 	Logo        string `json:"logo,omitempty"`
@@ -25,43 +31,70 @@ type clubResponse struct {
 	AirportIATA string `json:"airport_iata,omitempty"`
 }
 
-func NewClubHandler(log *zap.Logger, client *match.Client, timeout time.Duration) *ClubHandler {
+func NewClubHandler(log *zap.Logger, client *match.Client, timeout time.Duration, maxAge time.Duration) *ClubHandler {
 	return &ClubHandler{
 		log:     log,
 		client:  client,
 		timeout: timeout,
+		maxAge:  maxAge,
 	}
 }
 
 func (h *ClubHandler) GetClubs(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
-		return
-	}
-
 	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
 
 	resp, err := h.client.GetClubs(ctx)
 	if err != nil {
 		h.log.Error("get clubs failed", zap.Error(err))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+		writeUpstreamError(w, r, err)
 		return
 	}
 
 	clubs := make([]clubResponse, 0, len(resp.GetClubs()))
 	for _, c := range resp.GetClubs() {
-		clubs = append(clubs, clubResponse{
-			ClubID:      strings.TrimSpace(c.GetClubId()),
-			NameRU:      strings.TrimSpace(c.GetNameRu()),
-			NameEN:      strings.TrimSpace(c.GetNameEn()),
-			Logo:        strings.TrimSpace(c.GetLogo()),
-			City:        strings.TrimSpace(c.GetCity()),
-			AirportIATA: strings.TrimSpace(c.GetAirportIata()),
-		})
+		clubs = append(clubs, mapClubResponse(c, i18n.FromContext(r.Context())))
 	}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{
+	writeCacheableJSON(w, r, map[string]interface{}{
 		"clubs": clubs,
-	})
+	}, h.maxAge)
+}
+
+// GetClub serves /v1/clubs/{id}.
+func (h *ClubHandler) GetClub(w http.ResponseWriter, r *http.Request) {
+	clubID, ok := parseClubID(r.PathValue("id"))
+	if !ok {
+		writeParamError(w, r, invalidParam("club_id", "club id must be a positive integer"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	club, err := findClub(ctx, h.client, clubID)
+	if err != nil {
+		h.log.Error("get clubs failed", zap.Error(err), zap.String("club_id", clubID))
+		writeUpstreamError(w, r, err)
+		return
+	}
+	if club == nil {
+		writeProblem(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND, "club not found"))
+		return
+	}
+
+	writeCacheableJSON(w, r, mapClubResponse(club, i18n.FromContext(r.Context())), h.maxAge)
+}
+
+func mapClubResponse(c *matchv1.Club, lang i18n.Lang) clubResponse {
+	nameRU, nameEN := strings.TrimSpace(c.GetNameRu()), strings.TrimSpace(c.GetNameEn())
+	return clubResponse{
+		ClubID:      strings.TrimSpace(c.GetClubId()),
+		Name:        i18n.Name(lang, nameRU, nameEN),
+		NameRU:      nameRU,
+		NameEN:      nameEN,
+		Logo:        strings.TrimSpace(c.GetLogo()),
+		City:        i18n.Name(lang, strings.TrimSpace(c.GetCity()), strings.TrimSpace(c.GetCityEn())),
+		AirportIATA: strings.TrimSpace(c.GetAirportIata()),
+	}
 }
@@ -7,7 +7,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
 	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 	"go.uber.org/zap"
 )
 
@@ -15,193 +19,248 @@ const (
 	defaultUpcomingLimit     = 12
 	defaultClubUpcomingLimit = 100
 	maxUpcomingLimit         = 100
+	defaultListLimit         = 50
 )
 
 type MatchHandler struct {
 	log     *zap.Logger
 	client  *match.Client
 	timeout time.Duration
+	maxAge  time.Duration
 }
 
 type matchLoadError struct {
 	MatchID int64  `json:"match_id"`
+	Code    string `json:"code"`
 	Error   string `json:"error"`
 }
 
-func NewMatchHandler(log *zap.Logger, client *match.Client, timeout time.Duration) *MatchHandler {
-	return &MatchHandler{log: log, client: client, timeout: timeout}
+func NewMatchHandler(log *zap.Logger, client *match.Client, timeout time.Duration, maxAge time.Duration) *MatchHandler {
+	return &MatchHandler{log: log, client: client, timeout: timeout, maxAge: maxAge}
 }
 
 func (h *MatchHandler) GetMatch(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
-		return
-	}
-
-	matchID, parseErr := parseMatchIDFromPath(r.URL.Path)
-	if parseErr != "" {
-		writeError(w, http.StatusBadRequest, parseErr)
+	matchID, ok := parseMatchID(r.PathValue("id"))
+	if !ok {
+		writeParamError(w, r, invalidParam("match_id", "invalid match_id"))
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
 
-	resp, err := h.client.GetMatch(ctx, matchID)
+	resp, err := h.client.GetMatch(ctx, matchID, true)
 	if err != nil {
 		h.log.Error("get match failed",
 			zap.Error(err),
 			zap.Int64("match_id", matchID),
 		)
-		writeError(w, http.StatusBadGateway, "match adapter error")
+		writeUpstreamError(w, r, err)
 		return
 	}
 
-	clubsResp, err := h.client.GetClubs(ctx)
-	if err != nil {
-		h.log.Error("get clubs failed", zap.Error(err))
-		writeError(w, http.StatusBadGateway, "match adapter error")
-		return
-	}
-
-	writeJSON(w, http.StatusOK, mapMatch(resp.GetMatch(), buildClubIndex(clubsResp.GetClubs())))
+	writeCacheableJSON(w, r, mapMatch(resp.GetMatch(), i18n.FromContext(r.Context())), h.maxAge)
 }
 
 func (h *MatchHandler) GetMatches(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	query := r.URL.Query()
+	if !query.Has("ids") && (query.Has("from") || query.Has("to")) {
+		filter, paramErr := parseMatchFilter(r, defaultListLimit, defaultListLimit, maxUpcomingLimit)
+		if paramErr != nil {
+			writeParamError(w, r, paramErr)
+			return
+		}
+		h.listMatches(w, r, filter)
 		return
 	}
 
-	ids, ok := parseMatchIDs(r.URL.Query().Get("ids"))
+	ids, ok := parseMatchIDs(query.Get("ids"))
 	if !ok {
-		writeError(w, http.StatusBadRequest, "ids query is required, example: /v1/matches?ids=16114,16115")
+		writeParamError(w, r, invalidParam("ids", "ids or from/to query is required, example: /v1/matches?ids=16114,16115"))
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
 
-	clubsResp, err := h.client.GetClubs(ctx)
+	resp, err := h.client.GetMatches(ctx, ids, true)
 	if err != nil {
-		h.log.Error("get clubs failed", zap.Error(err))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+		h.log.Error("get matches failed", zap.Error(err), zap.Int("ids", len(ids)))
+		writeUpstreamError(w, r, err)
 		return
 	}
-	clubIndex := buildClubIndex(clubsResp.GetClubs())
-
-	matches := make([]matchResponse, 0, len(ids))
-	errors := make([]matchLoadError, 0)
-	for _, id := range ids {
-		resp, err := h.client.GetMatch(ctx, id)
-		if err != nil {
-			h.log.Error("get match failed in list",
-				zap.Error(err),
-				zap.Int64("match_id", id),
-			)
-			errors = append(errors, matchLoadError{
-				MatchID: id,
-				Error:   "match adapter error",
-			})
-			continue
-		}
-		matches = append(matches, mapMatch(resp.GetMatch(), clubIndex))
+
+	lang := i18n.FromContext(r.Context())
+	matches := make([]matchResponse, 0, len(resp.GetMatches()))
+	for _, m := range resp.GetMatches() {
+		matches = append(matches, mapMatch(m, lang))
+	}
+	errors := make([]matchLoadError, 0, len(resp.GetErrors()))
+	for _, e := range resp.GetErrors() {
+		errors = append(errors, matchLoadError{
+			MatchID: e.GetMatchId(),
+			Code:    problem.Code(matchErrorReason(e.GetReason())),
+			Error:   matchErrorMessage(e.GetReason()),
+		})
 	}
 
 	if len(matches) == 0 {
-		writeJSON(w, http.StatusBadGateway, map[string]interface{}{
-			"error":  "match adapter error",
-			"errors": errors,
-		})
+		// the reason of the first id stands for the request, e.g. 404 for a single missing match
+		reason := commonv1.ErrorReason_ERROR_REASON_INTERNAL
+		if len(resp.GetErrors()) > 0 {
+			reason = matchErrorReason(resp.GetErrors()[0].GetReason())
+		}
+		p := problem.New(reason, "none of the matches could be loaded")
+		p.Extensions = map[string]interface{}{"match_errors": errors}
+		writeProblem(w, r, p)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{
+	// ids that failed may load on the next try
+	maxAge := h.maxAge
+	if len(errors) > 0 {
+		maxAge = 0
+	}
+	writeCacheableJSON(w, r, map[string]interface{}{
 		"matches": matches,
 		"errors":  errors,
-	})
+	}, maxAge)
+}
+
+// listMatches serves /v1/matches?from=&to= for past and future ranges.
+func (h *MatchHandler) listMatches(w http.ResponseWriter, r *http.Request, filter match.MatchFilter) {
+	order, ok := parseSortOrder(r.URL.Query().Get("order"))
+	if !ok {
+		writeParamError(w, r, invalidParam("order", "order must be asc or desc"))
+		return
+	}
+	filter.Order = order
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	filter.IncludeClubs = true
+	resp, err := h.client.ListMatches(ctx, filter)
+	if err != nil {
+		h.log.Error("list matches failed", zap.Error(err), zap.Int32("limit", filter.Limit))
+		writeUpstreamError(w, r, err)
+		return
+	}
+
+	lang := i18n.FromContext(r.Context())
+	result := make([]matchResponse, 0, len(resp.GetMatches()))
+	for _, m := range resp.GetMatches() {
+		result = append(result, mapMatch(m, lang))
+	}
+
+	writeCacheableJSON(w, r, map[string]interface{}{
+		"matches":     result,
+		"errors":      []matchLoadError{},
+		"next_cursor": resp.GetNextCursor(),
+	}, h.maxAge)
 }
 
 func (h *MatchHandler) GetUpcomingMatches(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	filter, paramErr := parseMatchFilter(r, defaultUpcomingLimit, defaultClubUpcomingLimit, maxUpcomingLimit)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
 		return
 	}
 
-	clubID, _, clubErr := parsePositiveIntQuery(r, "club_id")
-	if clubErr != "" {
-		writeError(w, http.StatusBadRequest, "club_id must be a positive integer")
+	h.upcomingMatches(w, r, filter)
+}
+
+// GetClubMatches serves /v1/clubs/{id}/matches: upcoming matches of the club,
+// or the from/to range like /v1/matches does.
+func (h *MatchHandler) GetClubMatches(w http.ResponseWriter, r *http.Request) {
+	clubID, ok := parseClubID(r.PathValue("id"))
+	if !ok {
+		writeParamError(w, r, invalidParam("club_id", "club id must be a positive integer"))
 		return
 	}
 
-	limit := int32(defaultUpcomingLimit)
-	if clubID != "" {
-		limit = defaultClubUpcomingLimit
+	query := r.URL.Query()
+	ranged := query.Has("from") || query.Has("to")
+
+	defaultLimit := int32(defaultClubUpcomingLimit)
+	if ranged {
+		defaultLimit = defaultListLimit
 	}
-	if raw := strings.TrimSpace(r.URL.Query().Get("limit")); raw != "" {
-		parsed, err := strconv.ParseInt(raw, 10, 32)
-		if err != nil || parsed <= 0 {
-			writeError(w, http.StatusBadRequest, "limit must be a positive integer")
-			return
-		}
-		if parsed > maxUpcomingLimit {
-			parsed = maxUpcomingLimit
-		}
-		limit = int32(parsed)
+	filter, paramErr := parseMatchFilter(r, defaultLimit, defaultLimit, maxUpcomingLimit)
+	if paramErr != nil {
+		writeParamError(w, r, paramErr)
+		return
 	}
+	filter.ClubIDs = []string{clubID}
 
 	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	defer cancel()
 
-	fetchLimit := limit
-	if clubID != "" && fetchLimit < 100 {
-		fetchLimit = 100
+	club, err := findClub(ctx, h.client, clubID)
+	if err != nil {
+		h.log.Error("get clubs failed", zap.Error(err), zap.String("club_id", clubID))
+		writeUpstreamError(w, r, err)
+		return
+	}
+	if club == nil {
+		writeProblem(w, r, problem.New(commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND, "club not found"))
+		return
 	}
 
-	resp, err := h.client.GetUpcomingMatches(ctx, fetchLimit, clubID)
-	if err != nil {
-		h.log.Error("get upcoming matches failed", zap.Error(err), zap.Int32("limit", limit))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+	if ranged {
+		h.listMatches(w, r, filter)
 		return
 	}
+	h.upcomingMatches(w, r, filter)
+}
 
-	clubsResp, err := h.client.GetClubs(ctx)
+func (h *MatchHandler) upcomingMatches(w http.ResponseWriter, r *http.Request, filter match.MatchFilter) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	filter.IncludeClubs = true
+	resp, err := h.client.GetUpcomingMatches(ctx, filter)
 	if err != nil {
-		h.log.Error("get clubs failed", zap.Error(err))
-		writeError(w, http.StatusBadGateway, "match adapter error")
+		h.log.Error("get upcoming matches failed", zap.Error(err), zap.Int32("limit", filter.Limit))
+		writeUpstreamError(w, r, err)
 		return
 	}
-	clubIndex := buildClubIndex(clubsResp.GetClubs())
 
-	matches := cutMatchesByLimit(filterMatchesByClubID(resp.GetMatches(), clubID), limit)
-	result := make([]matchResponse, 0, len(matches))
-	for _, m := range matches {
-		result = append(result, mapMatch(m, clubIndex))
+	lang := i18n.FromContext(r.Context())
+	result := make([]matchResponse, 0, len(resp.GetMatches()))
+	for _, m := range resp.GetMatches() {
+		result = append(result, mapMatch(m, lang))
 	}
 
-	writeJSON(w, http.StatusOK, map[string]interface{}{
-		"matches": result,
-		"errors":  []matchLoadError{},
-	})
+	writeCacheableJSON(w, r, map[string]interface{}{
+		"matches":     result,
+		"errors":      []matchLoadError{},
+		"next_cursor": resp.GetNextCursor(),
+	}, h.maxAge)
 }
 
-func parseMatchIDFromPath(path string) (int64, string) {
-	const prefix = "/v1/matches/"
-	if !strings.HasPrefix(path, prefix) {
-		return 0, "invalid path, expected /v1/matches/{id}"
-	}
-
-	idPart := strings.TrimPrefix(path, prefix)
-	idPart = strings.Trim(idPart, "/")
-	if idPart == "" || strings.Contains(idPart, "/") {
-		return 0, "invalid path, expected /v1/matches/{id}"
+func matchErrorReason(reason matchv1.MatchErrorReason) commonv1.ErrorReason {
+	switch reason {
+	case matchv1.MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND:
+		return commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND
+	case matchv1.MatchErrorReason_MATCH_ERROR_REASON_SOURCE_UNAVAILABLE:
+		return commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE
+	case matchv1.MatchErrorReason_MATCH_ERROR_REASON_RATE_LIMITED:
+		return commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED
+	default:
+		return commonv1.ErrorReason_ERROR_REASON_INTERNAL
 	}
+}
 
-	id, err := strconv.ParseInt(idPart, 10, 64)
-	if err != nil || id <= 0 {
-		return 0, "invalid match_id"
+func matchErrorMessage(reason matchv1.MatchErrorReason) string {
+	switch reason {
+	case matchv1.MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND:
+		return "match not found"
+	case matchv1.MatchErrorReason_MATCH_ERROR_REASON_RATE_LIMITED:
+		return "too many requests"
+	default:
+		return "match adapter error"
 	}
-	return id, ""
 }
 
 func parseMatchIDs(value string) ([]int64, bool) {
@@ -4,13 +4,19 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
 	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 )
 
 var moscowLocation = mustLoadLocation("Europe/Moscow")
 
+const kickoffTBCLabel = "time TBC"
+
+// clubView has the name and city in the language of the request; name_ru and
+// name_en stay for clients that pick the name themselves.
 type clubView struct {
 	ClubID      string `json:"club_id"`
+	Name        string `json:"name"`
 	NameRU      string `json:"name_ru"`
 	NameEN      string `json:"name_en,omitempty"`
 	Logo        string `json:"logo,omitempty"`
@@ -18,18 +24,40 @@ type clubView struct {
 	AirportIATA string `json:"airport_iata,omitempty"`
 }
 
+type stadiumAirportView struct {
+	IATA            string `json:"iata"`
+	TransferMinutes int32  `json:"transfer_minutes"`
+}
+
+type stadiumView struct {
+	StadiumID string               `json:"stadium_id"`
+	Name      string               `json:"name"`
+	City      string               `json:"city,omitempty"`
+	Latitude  float64              `json:"latitude"`
+	Longitude float64              `json:"longitude"`
+	Timezone  string               `json:"timezone"`
+	Airports  []stadiumAirportView `json:"airports"`
+}
+
 type matchResponse struct {
-	MatchID                string    `json:"match_id"`
-	KickoffUTC             string    `json:"kickoff_utc,omitempty"`
-	KickoffLocal           string    `json:"kickoff_local,omitempty"`
-	City                   string    `json:"city,omitempty"`
-	Stadium                string    `json:"stadium,omitempty"`
-	DestinationAirportIATA string    `json:"destination_airport_iata,omitempty"`
-	ClubHomeID             string    `json:"club_home_id,omitempty"`
-	ClubAwayID             string    `json:"club_away_id,omitempty"`
-	HomeClub               *clubView `json:"home_club,omitempty"`
-	AwayClub               *clubView `json:"away_club,omitempty"`
-	TicketsLink            string    `json:"tickets_link,omitempty"`
+	MatchID                string       `json:"match_id"`
+	Competition            string       `json:"competition,omitempty"`
+	KickoffUTC             string       `json:"kickoff_utc,omitempty"`
+	KickoffLocal           string       `json:"kickoff_local,omitempty"`
+	KickoffConfirmed       bool         `json:"kickoff_confirmed"`
+	KickoffLabel           string       `json:"kickoff_label,omitempty"`
+	MatchDate              string       `json:"match_date,omitempty"`
+	City                   string       `json:"city,omitempty"`
+	Stadium                string       `json:"stadium,omitempty"`
+	DestinationAirportIATA string       `json:"destination_airport_iata,omitempty"`
+	ClubHomeID             string       `json:"club_home_id,omitempty"`
+	ClubAwayID             string       `json:"club_away_id,omitempty"`
+	HomeClub               *clubView    `json:"home_club,omitempty"`
+	AwayClub               *clubView    `json:"away_club,omitempty"`
+	TicketsLink            string       `json:"tickets_link,omitempty"`
+	HomeScore              *int32       `json:"home_score,omitempty"`
+	AwayScore              *int32       `json:"away_score,omitempty"`
+	Venue                  *stadiumView `json:"venue,omitempty"`
 }
 
 func mustLoadLocation(name string) *time.Location {
@@ -40,45 +68,105 @@ func mustLoadLocation(name string) *time.Location {
 	return loc
 }
 
-func buildClubIndex(clubs []*matchv1.Club) map[string]*clubView {
+func buildClubIndex(clubs []*matchv1.Club, lang i18n.Lang) map[string]*clubView {
 	index := make(map[string]*clubView, len(clubs))
 	for _, c := range clubs {
 		if c == nil {
 			continue
 		}
-		index[c.GetClubId()] = &clubView{
-			ClubID:      c.GetClubId(),
-			NameRU:      c.GetNameRu(),
-			NameEN:      c.GetNameEn(),
-			Logo:        c.GetLogo(),
-			City:        c.GetCity(),
-			AirportIATA: c.GetAirportIata(),
-		}
+		index[c.GetClubId()] = mapClub(c, lang)
 	}
 	return index
 }
 
-func mapMatch(in *matchv1.Match, clubs map[string]*clubView) matchResponse {
+func mapClub(c *matchv1.Club, lang i18n.Lang) *clubView {
+	if c == nil {
+		return nil
+	}
+	return &clubView{
+		ClubID:      c.GetClubId(),
+		Name:        i18n.Name(lang, c.GetNameRu(), c.GetNameEn()),
+		NameRU:      c.GetNameRu(),
+		NameEN:      c.GetNameEn(),
+		Logo:        c.GetLogo(),
+		City:        i18n.Name(lang, c.GetCity(), c.GetCityEn()),
+		AirportIATA: c.GetAirportIata(),
+	}
+}
+
+// mapMatch expects the match to be loaded with include_clubs.
+func mapMatch(in *matchv1.Match, lang i18n.Lang) matchResponse {
 	if in == nil {
 		return matchResponse{}
 	}
 
 	out := matchResponse{
 		MatchID:                strconv.FormatInt(in.GetMatchId(), 10),
-		City:                   in.GetCity(),
-		Stadium:                in.GetStadium(),
+		Competition:            in.GetCompetition(),
+		City:                   i18n.Name(lang, in.GetCity(), in.GetCityEn()),
+		Stadium:                matchStadium(in, lang),
 		DestinationAirportIATA: in.GetDestinationAirportIata(),
 		ClubHomeID:             in.GetClubHomeId(),
 		ClubAwayID:             in.GetClubAwayId(),
-		HomeClub:               clubs[in.GetClubHomeId()],
-		AwayClub:               clubs[in.GetClubAwayId()],
+		HomeClub:               mapClub(in.GetHomeClub(), lang),
+		AwayClub:               mapClub(in.GetAwayClub(), lang),
 		TicketsLink:            in.GetTicketsLink(),
+		HomeScore:              in.HomeScore,
+		AwayScore:              in.AwayScore,
+		Venue:                  mapStadium(in.GetVenue(), lang),
+		KickoffConfirmed:       !in.GetKickoffTentative(),
 	}
 	if in.GetKickoffUtc() != nil {
 		kickoff := in.GetKickoffUtc().AsTime()
 		out.KickoffUTC = kickoff.UTC().Format(time.RFC3339)
-		out.KickoffLocal = kickoff.In(moscowLocation).Format(time.RFC3339)
+		out.KickoffLocal = kickoff.In(matchLocation(in)).Format(time.RFC3339)
+		out.MatchDate = matchDate(in).Format(time.DateOnly)
+	}
+	if !out.KickoffConfirmed {
+		out.KickoffLabel = i18n.T(lang, kickoffTBCLabel)
 	}
 
 	return out
 }
+
+// matchDate is the local match day. A placeholder kickoff only carries the
+// date, which match-adapter publishes in Moscow time.
+func matchDate(m *matchv1.Match) time.Time {
+	kickoff := m.GetKickoffUtc().AsTime()
+	if m.GetKickoffTentative() {
+		return kickoff.In(moscowLocation)
+	}
+	return kickoff.In(matchLocation(m))
+}
+
+// matchStadium is the stadium as the source spells it, which is Russian. The
+// dictionary name is used for other languages when the stadium is mapped.
+func matchStadium(in *matchv1.Match, lang i18n.Lang) string {
+	if lang != i18n.RU && in.GetVenue().GetNameEn() != "" {
+		return in.GetVenue().GetNameEn()
+	}
+	return in.GetStadium()
+}
+
+func mapStadium(in *matchv1.Stadium, lang i18n.Lang) *stadiumView {
+	if in == nil {
+		return nil
+	}
+
+	out := &stadiumView{
+		StadiumID: in.GetStadiumId(),
+		Name:      i18n.Name(lang, in.GetName(), in.GetNameEn()),
+		City:      i18n.Name(lang, in.GetCity(), in.GetCityEn()),
+		Latitude:  in.GetLatitude(),
+		Longitude: in.GetLongitude(),
+		Timezone:  in.GetTimezone(),
+		Airports:  make([]stadiumAirportView, 0, len(in.GetAirports())),
+	}
+	for _, airport := range in.GetAirports() {
+		out.Airports = append(out.Airports, stadiumAirportView{
+			IATA:            airport.GetIata(),
+			TransferMinutes: airport.GetTransferMinutes(),
+		})
+	}
+	return out
+}
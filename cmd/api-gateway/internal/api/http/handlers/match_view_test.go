@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMapMatch_KickoffLocalUsesVenueTimezone(t *testing.T) {
+	kickoff := time.Date(2026, 3, 7, 16, 0, 0, 0, time.UTC)
+	in := &matchv1.Match{
+		MatchId:                16114,
+		KickoffUtc:             timestamppb.New(kickoff),
+		DestinationAirportIata: "MOW",
+		Venue:                  &matchv1.Stadium{StadiumId: "gazovik", Timezone: "Asia/Yekaterinburg"},
+	}
+
+	got := mapMatch(in, i18n.EN)
+	if got.KickoffLocal != "2026-03-07T21:00:00+05:00" {
+		t.Fatalf("unexpected kickoff_local: %s", got.KickoffLocal)
+	}
+	if got.Venue == nil || got.Venue.StadiumID != "gazovik" {
+		t.Fatalf("unexpected venue: %+v", got.Venue)
+	}
+
+	in.Venue = nil
+	in.DestinationAirportIata = "KGD"
+	if got := mapMatch(in, i18n.EN).KickoffLocal; got != "2026-03-07T18:00:00+02:00" {
+		t.Fatalf("unexpected kickoff_local without venue: %s", got)
+	}
+}
+
+func TestMapMatch_UnconfirmedKickoffIsLabelled(t *testing.T) {
+	// placeholder at 00:00 MSK, which is still March 6 in Kaliningrad
+	in := &matchv1.Match{
+		MatchId:                16300,
+		KickoffUtc:             timestamppb.New(time.Date(2026, 3, 6, 21, 0, 0, 0, time.UTC)),
+		DestinationAirportIata: "KGD",
+		KickoffTentative:       true,
+	}
+
+	got := mapMatch(in, i18n.EN)
+	if got.KickoffConfirmed || got.KickoffLabel != kickoffTBCLabel {
+		t.Fatalf("expected time TBC label, got confirmed=%v label=%q", got.KickoffConfirmed, got.KickoffLabel)
+	}
+	if got.MatchDate != "2026-03-07" {
+		t.Fatalf("unexpected match_date: %s", got.MatchDate)
+	}
+
+	in.KickoffTentative = false
+	got = mapMatch(in, i18n.EN)
+	if got.KickoffLabel != "" || got.MatchDate != "2026-03-06" {
+		t.Fatalf("unexpected confirmed view: label=%q match_date=%s", got.KickoffLabel, got.MatchDate)
+	}
+}
+
+func TestMapMatch_EmbeddedClubs(t *testing.T) {
+	in := &matchv1.Match{
+		MatchId:    16114,
+		ClubHomeId: "1",
+		ClubAwayId: "444",
+		HomeClub:   &matchv1.Club{ClubId: "1", NameRu: "Спартак Москва", AirportIata: "MOW"},
+	}
+
+	got := mapMatch(in, i18n.EN)
+	if got.HomeClub == nil || got.HomeClub.NameRU != "Спартак Москва" || got.HomeClub.AirportIATA != "MOW" {
+		t.Fatalf("unexpected home club: %+v", got.HomeClub)
+	}
+	if got.AwayClub != nil {
+		t.Fatalf("expected no away club for unknown club, got %+v", got.AwayClub)
+	}
+	if got.ClubAwayID != "444" {
+		t.Fatalf("expected away club id to be kept, got %q", got.ClubAwayID)
+	}
+}
+
+func TestMapMatch_Localized(t *testing.T) {
+	in := &matchv1.Match{
+		MatchId: 16114,
+		City:    "Москва",
+		CityEn:  "Moscow",
+		Stadium: "Лукойл Арена",
+		Venue: &matchv1.Stadium{
+			StadiumId: "lukoil-arena",
+			Name:      "Лукойл Арена",
+			NameEn:    "Lukoil Arena",
+			City:      "Москва",
+			CityEn:    "Moscow",
+		},
+		HomeClub: &matchv1.Club{ClubId: "1", NameRu: "Спартак Москва", NameEn: "Spartak Moscow", City: "Москва", CityEn: "Moscow"},
+		AwayClub: &matchv1.Club{ClubId: "2", NameRu: "Факел"},
+	}
+
+	got := mapMatch(in, i18n.EN)
+	if got.City != "Moscow" || got.Stadium != "Lukoil Arena" {
+		t.Fatalf("unexpected city/stadium in en: %q/%q", got.City, got.Stadium)
+	}
+	if got.Venue.Name != "Lukoil Arena" || got.Venue.City != "Moscow" {
+		t.Fatalf("unexpected venue in en: %+v", got.Venue)
+	}
+	if got.HomeClub.Name != "Spartak Moscow" || got.HomeClub.City != "Moscow" || got.HomeClub.NameRU != "Спартак Москва" {
+		t.Fatalf("unexpected home club in en: %+v", got.HomeClub)
+	}
+	// no English name in the dictionary yet
+	if got.AwayClub.Name != "Факел" {
+		t.Fatalf("expected russian fallback for away club, got %q", got.AwayClub.Name)
+	}
+
+	got = mapMatch(in, i18n.RU)
+	if got.City != "Москва" || got.Stadium != "Лукойл Арена" || got.HomeClub.Name != "Спартак Москва" {
+		t.Fatalf("unexpected ru view: %q/%q/%q", got.City, got.Stadium, got.HomeClub.Name)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+)
+
+// parseMatchID reads the {id} path value of the match routes.
+func parseMatchID(value string) (int64, bool) {
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// parseClubID reads the {id} path value of the club routes in the form the
+// match adapter stores it, so /v1/clubs/007 is club 7.
+func parseClubID(value string) (string, bool) {
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id <= 0 {
+		return "", false
+	}
+	return strconv.FormatInt(id, 10), true
+}
+
+// findClub returns nil without an error for an unknown club.
+func findClub(ctx context.Context, client *match.Client, clubID string) (*matchv1.Club, error) {
+	resp, err := client.GetClubs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range resp.GetClubs() {
+		if strings.TrimSpace(c.GetClubId()) == clubID {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
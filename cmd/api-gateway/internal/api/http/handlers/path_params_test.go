@@ -0,0 +1,49 @@
+package handlers
+
+import "testing"
+
+func TestParseClubID(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantID string
+		wantOK bool
+	}{
+		{value: "3", wantID: "3", wantOK: true},
+		{value: "007", wantID: "7", wantOK: true},
+		{value: "abc"},
+		{value: "0"},
+		{value: "-3"},
+		{value: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			gotID, gotOK := parseClubID(tc.value)
+			if gotID != tc.wantID || gotOK != tc.wantOK {
+				t.Fatalf("expected (%q, %v), got (%q, %v)", tc.wantID, tc.wantOK, gotID, gotOK)
+			}
+		})
+	}
+}
+
+func TestParseMatchID(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantID int64
+		wantOK bool
+	}{
+		{value: "16114", wantID: 16114, wantOK: true},
+		{value: "0"},
+		{value: "16114x"},
+		{value: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			gotID, gotOK := parseMatchID(tc.value)
+			if gotID != tc.wantID || gotOK != tc.wantOK {
+				t.Fatalf("expected (%d, %v), got (%d, %v)", tc.wantID, tc.wantOK, gotID, gotOK)
+			}
+		})
+	}
+}
@@ -4,26 +4,163 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/clients/match"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 )
 
-func parsePositiveIntQuery(r *http.Request, key string) (value string, present bool, errMsg string) {
-	values, exists := r.URL.Query()[key]
-	if !exists {
-		return "", false, ""
+const queryDateLayout = "2006-01-02"
+
+// paramError is a rejected query or path parameter.
+type paramError struct {
+	field   string
+	message string
+}
+
+func invalidParam(field, message string) *paramError {
+	return &paramError{field: field, message: message}
+}
+
+const competitionMessage = "competition must be a short latin code, e.g. rpl or cup"
+
+// parseCompetitionQuery reads an optional competition code such as "rpl" or "cup".
+func parseCompetitionQuery(r *http.Request) (string, *paramError) {
+	raw := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("competition")))
+	if raw == "" {
+		return "", nil
+	}
+	if len(raw) > 32 {
+		return "", invalidParam("competition", competitionMessage)
+	}
+	for _, ch := range raw {
+		if (ch < 'a' || ch > 'z') && (ch < '0' || ch > '9') && ch != '-' && ch != '_' {
+			return "", invalidParam("competition", competitionMessage)
+		}
 	}
-	if len(values) == 0 {
-		return "", true, "invalid"
+
+	return raw, nil
+}
+
+// parseClubIDsQuery accepts repeated club_id params and comma separated lists.
+func parseClubIDsQuery(r *http.Request) ([]string, *paramError) {
+	values := r.URL.Query()["club_id"]
+	ids := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		for _, raw := range strings.Split(value, ",") {
+			raw = strings.TrimSpace(raw)
+			parsed, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || parsed <= 0 {
+				return nil, invalidParam("club_id", "club_id must be a positive integer")
+			}
+			id := strconv.FormatInt(parsed, 10)
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
 	}
+	return ids, nil
+}
 
-	raw := strings.TrimSpace(values[0])
+// parseTimeQuery accepts RFC3339 timestamps and plain dates. A plain date means the
+// start of the day in Moscow, or its end when endOfDay is set.
+func parseTimeQuery(r *http.Request, key string, endOfDay bool) (time.Time, *paramError) {
+	raw := strings.TrimSpace(r.URL.Query().Get(key))
 	if raw == "" {
-		return "", true, "invalid"
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t.UTC(), nil
 	}
 
-	parsed, err := strconv.ParseInt(raw, 10, 64)
-	if err != nil || parsed <= 0 {
-		return "", true, "invalid"
+	day, err := time.ParseInLocation(queryDateLayout, raw, moscowLocation)
+	if err != nil {
+		return time.Time{}, invalidParam(key, key+" must be RFC3339 or YYYY-MM-DD")
+	}
+	if endOfDay {
+		day = day.AddDate(0, 0, 1).Add(-time.Nanosecond)
 	}
+	return day.UTC(), nil
+}
 
-	return strconv.FormatInt(parsed, 10), true, ""
+// parseMatchFilter reads filters shared by the match list endpoints.
+func parseMatchFilter(r *http.Request, defaultLimit, defaultClubLimit, maxLimit int32) (match.MatchFilter, *paramError) {
+	query := r.URL.Query()
+
+	clubIDs, paramErr := parseClubIDsQuery(r)
+	if paramErr != nil {
+		return match.MatchFilter{}, paramErr
+	}
+
+	filter := match.MatchFilter{
+		Limit:   defaultLimit,
+		ClubIDs: clubIDs,
+		City:    strings.TrimSpace(query.Get("city")),
+		Cursor:  strings.TrimSpace(query.Get("cursor")),
+	}
+	if len(clubIDs) > 0 {
+		filter.Limit = defaultClubLimit
+	}
+
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed <= 0 {
+			return match.MatchFilter{}, invalidParam("limit", "limit must be a positive integer")
+		}
+		if parsed > int64(maxLimit) {
+			parsed = int64(maxLimit)
+		}
+		filter.Limit = int32(parsed)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(query.Get("side"))) {
+	case "":
+		filter.ClubSide = matchv1.ClubSide_CLUB_SIDE_UNSPECIFIED
+	case "home":
+		filter.ClubSide = matchv1.ClubSide_CLUB_SIDE_HOME
+	case "away":
+		filter.ClubSide = matchv1.ClubSide_CLUB_SIDE_AWAY
+	default:
+		return match.MatchFilter{}, invalidParam("side", "side must be home or away")
+	}
+
+	competition, paramErr := parseCompetitionQuery(r)
+	if paramErr != nil {
+		return match.MatchFilter{}, paramErr
+	}
+	filter.Competition = competition
+
+	if iata := strings.ToUpper(strings.TrimSpace(query.Get("destination_iata"))); iata != "" {
+		if !isValidIATA(iata) {
+			return match.MatchFilter{}, invalidParam("destination_iata", "destination_iata must be 3 latin letters")
+		}
+		filter.DestinationIATA = iata
+	}
+
+	if filter.From, paramErr = parseTimeQuery(r, "from", false); paramErr != nil {
+		return match.MatchFilter{}, paramErr
+	}
+	if filter.To, paramErr = parseTimeQuery(r, "to", true); paramErr != nil {
+		return match.MatchFilter{}, paramErr
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return match.MatchFilter{}, invalidParam("to", "to must not be before from")
+	}
+
+	return filter, nil
+}
+
+func parseSortOrder(raw string) (matchv1.SortOrder, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "asc":
+		return matchv1.SortOrder_SORT_ORDER_ASC, true
+	case "desc":
+		return matchv1.SortOrder_SORT_ORDER_DESC, true
+	default:
+		return matchv1.SortOrder_SORT_ORDER_UNSPECIFIED, false
+	}
 }
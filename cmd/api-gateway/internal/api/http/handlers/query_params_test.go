@@ -2,49 +2,44 @@ package handlers
 
 import (
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 )
 
-func TestParsePositiveIntQuery(t *testing.T) {
+func TestParseClubIDsQuery(t *testing.T) {
 	tests := []struct {
 		name          string
 		rawURL        string
-		key           string
-		wantValue     string
-		wantPresent   bool
+		wantIDs       []string
 		wantErrFilled bool
 	}{
 		{
-			name:          "missing key",
-			rawURL:        "/v1/matches/upcoming-with-airfare?limit=12",
-			key:           "club_id",
-			wantValue:     "",
-			wantPresent:   false,
-			wantErrFilled: false,
+			name:    "missing key",
+			rawURL:  "/v1/matches/upcoming-with-airfare?limit=12",
+			wantIDs: []string{},
 		},
 		{
 			name:          "empty value",
 			rawURL:        "/v1/matches/upcoming-with-airfare?club_id=",
-			key:           "club_id",
-			wantValue:     "",
-			wantPresent:   true,
 			wantErrFilled: true,
 		},
 		{
 			name:          "invalid value",
 			rawURL:        "/v1/matches/upcoming-with-airfare?club_id=abc",
-			key:           "club_id",
-			wantValue:     "",
-			wantPresent:   true,
 			wantErrFilled: true,
 		},
 		{
-			name:          "valid positive value",
-			rawURL:        "/v1/matches/upcoming-with-airfare?club_id=001",
-			key:           "club_id",
-			wantValue:     "1",
-			wantPresent:   true,
-			wantErrFilled: false,
+			name:    "valid positive value",
+			rawURL:  "/v1/matches/upcoming-with-airfare?club_id=001",
+			wantIDs: []string{"1"},
+		},
+		{
+			name:    "repeated and comma separated",
+			rawURL:  "/v1/matches/upcoming?club_id=3,4&club_id=3&club_id=584",
+			wantIDs: []string{"3", "4", "584"},
 		},
 	}
 
@@ -52,16 +47,103 @@ func TestParsePositiveIntQuery(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			req := httptest.NewRequest("GET", tc.rawURL, nil)
 
-			gotValue, gotPresent, gotErr := parsePositiveIntQuery(req, tc.key)
+			gotIDs, gotErr := parseClubIDsQuery(req)
+			if (gotErr != nil) != tc.wantErrFilled {
+				t.Fatalf("expected err filled=%v, got %v", tc.wantErrFilled, gotErr)
+			}
+			if tc.wantErrFilled {
+				return
+			}
+			if strings.Join(gotIDs, ",") != strings.Join(tc.wantIDs, ",") {
+				t.Fatalf("expected ids %v, got %v", tc.wantIDs, gotIDs)
+			}
+		})
+	}
+}
+
+func TestParseMatchFilter(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/matches/upcoming?club_id=4&side=home&city=Kazan&destination_iata=kzn&from=2026-03-07&to=2026-03-08&cursor=abc&limit=500", nil)
+
+	filter, paramErr := parseMatchFilter(req, 12, 100, 100)
+	if paramErr != nil {
+		t.Fatalf("unexpected error: %s", paramErr.message)
+	}
+	if filter.Limit != 100 {
+		t.Fatalf("expected limit capped to 100, got %d", filter.Limit)
+	}
+	if filter.ClubSide != matchv1.ClubSide_CLUB_SIDE_HOME || filter.City != "Kazan" || filter.DestinationIATA != "KZN" || filter.Cursor != "abc" {
+		t.Fatalf("unexpected filter: %+v", filter)
+	}
+	wantFrom := time.Date(2026, 3, 6, 21, 0, 0, 0, time.UTC)
+	wantTo := time.Date(2026, 3, 8, 21, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	if !filter.From.Equal(wantFrom) || !filter.To.Equal(wantTo) {
+		t.Fatalf("unexpected range %s - %s", filter.From, filter.To)
+	}
+}
+
+func TestParseMatchFilter_Invalid(t *testing.T) {
+	for rawURL, field := range map[string]string{
+		"/v1/matches/upcoming?side=both":                     "side",
+		"/v1/matches/upcoming?destination_iata=KAZAN":        "destination_iata",
+		"/v1/matches/upcoming?from=yesterday":                "from",
+		"/v1/matches/upcoming?from=2026-03-08&to=2026-03-07": "to",
+		"/v1/matches/upcoming?limit=0":                       "limit",
+		"/v1/matches/upcoming?club_id=zenit":                 "club_id",
+	} {
+		t.Run(rawURL, func(t *testing.T) {
+			req := httptest.NewRequest("GET", rawURL, nil)
+			_, paramErr := parseMatchFilter(req, 12, 100, 100)
+			if paramErr == nil {
+				t.Fatal("expected error")
+			}
+			if paramErr.field != field {
+				t.Fatalf("expected field %s, got %s", field, paramErr.field)
+			}
+		})
+	}
+}
+
+func TestParseCompetitionQuery(t *testing.T) {
+	tests := []struct {
+		rawURL        string
+		wantValue     string
+		wantErrFilled bool
+	}{
+		{rawURL: "/v1/matches/upcoming", wantValue: ""},
+		{rawURL: "/v1/matches/upcoming?competition=CUP", wantValue: "cup"},
+		{rawURL: "/v1/matches/upcoming?competition=fnl-2", wantValue: "fnl-2"},
+		{rawURL: "/v1/matches/upcoming?competition=cup%20x", wantErrFilled: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rawURL, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.rawURL, nil)
+
+			gotValue, gotErr := parseCompetitionQuery(req)
 			if gotValue != tc.wantValue {
 				t.Fatalf("expected value %q, got %q", tc.wantValue, gotValue)
 			}
-			if gotPresent != tc.wantPresent {
-				t.Fatalf("expected present=%v, got %v", tc.wantPresent, gotPresent)
-			}
-			if (gotErr != "") != tc.wantErrFilled {
-				t.Fatalf("expected err filled=%v, got %q", tc.wantErrFilled, gotErr)
+			if (gotErr != nil) != tc.wantErrFilled {
+				t.Fatalf("expected err filled=%v, got %v", tc.wantErrFilled, gotErr)
 			}
 		})
 	}
 }
+
+func TestParseSortOrder(t *testing.T) {
+	cases := map[string]matchv1.SortOrder{
+		"":      matchv1.SortOrder_SORT_ORDER_ASC,
+		"asc":   matchv1.SortOrder_SORT_ORDER_ASC,
+		" DESC": matchv1.SortOrder_SORT_ORDER_DESC,
+	}
+	for raw, want := range cases {
+		got, ok := parseSortOrder(raw)
+		if !ok || got != want {
+			t.Fatalf("parseSortOrder(%q) = %v, %v; want %v", raw, got, ok, want)
+		}
+	}
+
+	if _, ok := parseSortOrder("newest"); ok {
+		t.Fatal("expected unknown order to be rejected")
+	}
+}
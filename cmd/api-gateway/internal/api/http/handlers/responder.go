@@ -3,6 +3,11 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/httpcache"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 )
 
 func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
@@ -16,6 +21,28 @@ func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
 	_ = json.NewEncoder(w).Encode(payload)
 }
 
-func writeError(w http.ResponseWriter, status int, message string) {
-	writeJSON(w, status, map[string]string{"error": message})
+// writeCacheableJSON sends payload with an ETag of its body and lets caches
+// keep it for maxAge; a client that has the body gets 304.
+func writeCacheableJSON(w http.ResponseWriter, r *http.Request, payload interface{}, maxAge time.Duration) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		writeProblem(w, r, problem.NewWithStatus(http.StatusInternalServerError, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "failed to encode response"))
+		return
+	}
+	body = append(body, '\n')
+
+	httpcache.Write(w, r, "application/json", body, httpcache.ETag(body), maxAge)
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, p *problem.Problem) {
+	problem.Write(w, r, p)
+}
+
+func writeParamError(w http.ResponseWriter, r *http.Request, err *paramError) {
+	problem.Write(w, r, problem.InvalidParam(err.field, err.message))
+}
+
+// writeUpstreamError answers with the reason the backend reported.
+func writeUpstreamError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Write(w, r, problem.FromGRPC(err))
 }
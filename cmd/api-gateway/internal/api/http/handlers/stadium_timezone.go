@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+)
+
+// timezoneByAirportIATA maps destination airports of match cities to their IANA zones.
+// Cities missing here are treated as Moscow time.
+var timezoneByAirportIATA = map[string]string{
+	"MOW": "Europe/Moscow",
+	"LED": "Europe/Moscow",
+	"KZN": "Europe/Moscow",
+	"ROV": "Europe/Moscow",
+	"MCX": "Europe/Moscow",
+	"AER": "Europe/Moscow",
+	"KRR": "Europe/Moscow",
+	"GRV": "Europe/Moscow",
+	"GOJ": "Europe/Moscow",
+	"VOZ": "Europe/Moscow",
+	"KGD": "Europe/Kaliningrad",
+	"KUF": "Europe/Samara",
+	"ULV": "Europe/Ulyanovsk",
+	"VOG": "Europe/Volgograd",
+	"ASF": "Europe/Astrakhan",
+	"REN": "Asia/Yekaterinburg",
+	"SVX": "Asia/Yekaterinburg",
+	"PEE": "Asia/Yekaterinburg",
+	"UFA": "Asia/Yekaterinburg",
+	"CEK": "Asia/Yekaterinburg",
+	"TJM": "Asia/Yekaterinburg",
+	"OMS": "Asia/Omsk",
+	"OVB": "Asia/Novosibirsk",
+	"TOF": "Asia/Tomsk",
+	"KJA": "Asia/Krasnoyarsk",
+	"IKT": "Asia/Irkutsk",
+	"KHV": "Asia/Vladivostok",
+	"VVO": "Asia/Vladivostok",
+}
+
+// matchLocation prefers the venue timezone from the stadium dictionary and
+// falls back to the destination airport for unmapped stadiums.
+func matchLocation(m *matchv1.Match) *time.Location {
+	if name := strings.TrimSpace(m.GetVenue().GetTimezone()); name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return stadiumLocation(m.GetDestinationAirportIata())
+}
+
+func stadiumLocation(airportIATA string) *time.Location {
+	name, ok := timezoneByAirportIATA[strings.ToUpper(strings.TrimSpace(airportIATA))]
+	if !ok {
+		return moscowLocation
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return moscowLocation
+	}
+	return loc
+}
@@ -0,0 +1,131 @@
+package httpcache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Entry is a cached response body.
+type Entry struct {
+	Body      []byte
+	ETag      string
+	ExpiresAt time.Time
+}
+
+// MaxAge is what is left of the entry's lifetime at now.
+func (e Entry) MaxAge(now time.Time) time.Duration {
+	if e.ExpiresAt.IsZero() {
+		return 0
+	}
+	return max(e.ExpiresAt.Sub(now), 0)
+}
+
+// LoadFunc builds the body of an entry. A zero ttl hands the body to the
+// callers waiting for it without keeping it.
+type LoadFunc func(ctx context.Context) (body []byte, ttl time.Duration, err error)
+
+// Cache keeps up to size entries in memory. Callers asking for a key that
+// is being loaded wait for that load instead of starting their own.
+type Cache struct {
+	size int
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]Entry
+	calls   map[string]*call
+}
+
+type call struct {
+	done  chan struct{}
+	entry Entry
+	err   error
+}
+
+func NewCache(size int) *Cache {
+	if size <= 0 {
+		size = 256
+	}
+
+	return &Cache{
+		size:    size,
+		now:     time.Now,
+		entries: make(map[string]Entry),
+		calls:   make(map[string]*call),
+	}
+}
+
+// Get returns the entry of key, loading it if it is missing or expired.
+// The load is shared with other callers, so it gets neither the caller's
+// cancellation nor its values (client address, request id, principal), only
+// its deadline; load should apply its own timeout.
+func (c *Cache) Get(ctx context.Context, key string, load LoadFunc) (Entry, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Before(e.ExpiresAt) {
+		c.mu.Unlock()
+		return e, nil
+	}
+	cl, loading := c.calls[key]
+	if !loading {
+		cl = &call{done: make(chan struct{})}
+		c.calls[key] = cl
+		loadCtx, cancel := detach(ctx)
+		go func() {
+			defer cancel()
+			c.load(loadCtx, key, cl, load)
+		}()
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-cl.done:
+		return cl.entry, cl.err
+	case <-ctx.Done():
+		return Entry{}, ctx.Err()
+	}
+}
+
+func detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(context.Background(), deadline)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func (c *Cache) load(ctx context.Context, key string, cl *call, load LoadFunc) {
+	body, ttl, err := load(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.calls, key)
+
+	cl.err = err
+	if err == nil {
+		cl.entry = Entry{Body: body, ETag: ETag(body)}
+		if ttl > 0 {
+			cl.entry.ExpiresAt = c.now().Add(ttl)
+			c.store(key, cl.entry)
+		}
+	}
+	close(cl.done)
+}
+
+// store makes room by dropping expired entries first and any entry after
+// that.
+func (c *Cache) store(key string, e Entry) {
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		now := c.now()
+		for k, old := range c.entries {
+			if !now.Before(old.ExpiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		for k := range c.entries {
+			if len(c.entries) < c.size {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = e
+}
@@ -0,0 +1,88 @@
+// Package httpcache sets validators and Cache-Control on gateway responses,
+// answers conditional requests and keeps an in-memory cache of expensive
+// responses.
+package httpcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ETag is a strong validator of the data, usually the response body.
+func ETag(data ...[]byte) string {
+	h := sha256.New()
+	for _, d := range data {
+		h.Write(d)
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// NotModified reports whether If-None-Match of r lists etag. The comparison
+// is weak as RFC 9110 requires for If-None-Match.
+func NotModified(r *http.Request, etag string) bool {
+	header := r.Header.Get("If-None-Match")
+	if header == "" || etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+type privateKey struct{}
+
+// WithPrivate marks responses to the request as depending on the caller, so
+// that shared caches don't keep them. The auth guard marks every request it
+// lets through: what a caller gets depends on its key and its limits.
+func WithPrivate(ctx context.Context) context.Context {
+	return context.WithValue(ctx, privateKey{}, true)
+}
+
+func isPrivate(ctx context.Context) bool {
+	private, _ := ctx.Value(privateKey{}).(bool)
+	return private
+}
+
+// CacheControl allows caches to keep a response for maxAge, only the client
+// cache when the response is private. A response that must not be kept gets
+// no-cache, so that clients revalidate it with its ETag.
+func CacheControl(maxAge time.Duration, private bool) string {
+	seconds := int(maxAge / time.Second)
+	if seconds <= 0 {
+		return "no-cache"
+	}
+	if private {
+		return "private, max-age=" + strconv.Itoa(seconds)
+	}
+	return "public, max-age=" + strconv.Itoa(seconds)
+}
+
+// Write sends a 200 response with etag and Cache-Control, or 304 without a
+// body when the client already has it.
+func Write(w http.ResponseWriter, r *http.Request, contentType string, body []byte, etag string, maxAge time.Duration) {
+	h := w.Header()
+	h.Set("ETag", etag)
+	h.Set("Cache-Control", CacheControl(maxAge, isPrivate(r.Context())))
+
+	if NotModified(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(body)
+	}
+}
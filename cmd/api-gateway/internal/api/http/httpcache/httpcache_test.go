@@ -0,0 +1,176 @@
+package httpcache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNotModified(t *testing.T) {
+	etag := ETag([]byte("body"))
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{"", false},
+		{etag, true},
+		{"W/" + etag, true},
+		{`"other", ` + etag, true},
+		{"*", true},
+		{`"other"`, false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.header != "" {
+			r.Header.Set("If-None-Match", tt.header)
+		}
+		if got := NotModified(r, etag); got != tt.want {
+			t.Fatalf("NotModified(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	body := []byte(`{"clubs":[]}`)
+	etag := ETag(body)
+
+	rec := httptest.NewRecorder()
+	Write(rec, httptest.NewRequest(http.MethodGet, "/v1/clubs", nil), "application/json", body, etag, time.Hour)
+	if rec.Code != http.StatusOK || rec.Body.String() != string(body) {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("ETag") != etag || rec.Header().Get("Cache-Control") != "public, max-age=3600" {
+		t.Fatalf("unexpected headers: %v", rec.Header())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/clubs", nil)
+	rec = httptest.NewRecorder()
+	Write(rec, req.WithContext(WithPrivate(req.Context())), "application/json", body, etag, time.Hour)
+	if rec.Header().Get("Cache-Control") != "private, max-age=3600" {
+		t.Fatalf("unexpected headers: %v", rec.Header())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/clubs", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	Write(rec, req, "application/json", body, etag, 0)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("ETag") != etag || rec.Header().Get("Cache-Control") != "no-cache" {
+		t.Fatalf("unexpected headers: %v", rec.Header())
+	}
+}
+
+func TestCache_CoalescesLoads(t *testing.T) {
+	c := NewCache(8)
+	var loads int32
+	release := make(chan struct{})
+	load := func(context.Context) ([]byte, time.Duration, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return []byte("body"), time.Minute, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e, err := c.Get(context.Background(), "k", load)
+			if err != nil || string(e.Body) != "body" {
+				t.Errorf("Get = %q, %v", e.Body, err)
+			}
+		}()
+	}
+	// let every caller find the running load
+	for {
+		c.mu.Lock()
+		_, loading := c.calls["k"]
+		c.mu.Unlock()
+		if loading {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if _, err := c.Get(context.Background(), "k", load); err != nil {
+		t.Fatalf("cached get: %v", err)
+	}
+	if loads != 1 {
+		t.Fatalf("loads = %d, want 1", loads)
+	}
+}
+
+func TestCache_LoadGetsOnlyDeadline(t *testing.T) {
+	type callerKey struct{}
+	deadline := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.WithValue(context.Background(), callerKey{}, "203.0.113.7"), deadline)
+	defer cancel()
+
+	c := NewCache(8)
+	_, err := c.Get(ctx, "k", func(ctx context.Context) ([]byte, time.Duration, error) {
+		if ctx.Value(callerKey{}) != nil {
+			return nil, 0, errors.New("load sees the caller's values")
+		}
+		if got, ok := ctx.Deadline(); !ok || !got.Equal(deadline) {
+			return nil, 0, errors.New("load lost the caller's deadline")
+		}
+		return []byte("body"), time.Minute, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCache_ExpiryAndUncachedLoads(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	c := NewCache(8)
+	c.now = func() time.Time { return now }
+
+	var loads int32
+	ttl := time.Minute
+	var loadErr error
+	load := func(context.Context) ([]byte, time.Duration, error) {
+		atomic.AddInt32(&loads, 1)
+		return []byte("body"), ttl, loadErr
+	}
+	get := func() (Entry, error) { return c.Get(context.Background(), "k", load) }
+
+	e, _ := get()
+	if e.MaxAge(now) != time.Minute {
+		t.Fatalf("max age = %s", e.MaxAge(now))
+	}
+	_, _ = get()
+	now = now.Add(time.Minute)
+	_, _ = get()
+	if loads != 2 {
+		t.Fatalf("loads = %d, want 2: one at start, one after expiry", loads)
+	}
+
+	now = now.Add(time.Minute)
+	ttl = 0
+	_, _ = get()
+	_, _ = get()
+	if loads != 4 {
+		t.Fatalf("loads = %d, want 4: zero ttl must not be kept", loads)
+	}
+
+	now = now.Add(time.Minute)
+	ttl, loadErr = time.Minute, errors.New("upstream down")
+	if _, err := get(); err == nil {
+		t.Fatal("expected load error")
+	}
+	loadErr = nil
+	if _, err := get(); err != nil || loads != 6 {
+		t.Fatalf("failed load kept: err = %v, loads = %d", err, loads)
+	}
+}
@@ -0,0 +1,222 @@
+// Package openapi checks gateway requests, and optionally responses, against
+// openapi.yaml. Requests that break the document are rejected with a problem
+// before they reach the handlers; responses that break it are only logged,
+// the client still gets them.
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	apirouter "github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/router"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"go.uber.org/zap"
+)
+
+func init() {
+	// calendars are checked by status and content type only
+	openapi3filter.RegisterBodyDecoder("text/calendar", openapi3filter.FileBodyDecoder)
+}
+
+type Validator struct {
+	log       *zap.Logger
+	router    routers.Router
+	requests  bool
+	responses bool
+}
+
+// Load reads the document and checks that it is a valid OpenAPI 3 one,
+// examples included.
+func Load(path string) (*openapi3.T, error) {
+	const op = "openapi.Load"
+
+	doc, err := openapi3.NewLoader().LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("%s: invalid document: %w", op, err)
+	}
+	return doc, nil
+}
+
+func New(log *zap.Logger, doc *openapi3.T, requests, responses bool) (*Validator, error) {
+	const op = "openapi.New"
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &Validator{log: log, router: router, requests: requests, responses: responses}, nil
+}
+
+// Middleware checks the traffic of the routes the document describes; other
+// routes (health, metrics, unknown paths) pass through.
+func (v *Validator) Middleware() apirouter.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			input, ok := v.input(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if v.requests {
+				if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+					p := problem.New(commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "request does not match the API schema")
+					p.Errors = fieldErrors(err)
+					problem.Write(w, r, p)
+					return
+				}
+			}
+			if !v.responses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if rec.flushed {
+				return
+			}
+			// a 304 has no body and repeats the headers of a 200 the client has
+			if rec.status == http.StatusNotModified {
+				w.WriteHeader(rec.status)
+				return
+			}
+			if err := v.validateResponse(input, rec.status, w.Header(), rec.body.Bytes()); err != nil {
+				v.log.Warn("response does not match openapi",
+					zap.String("method", r.Method),
+					zap.String("path", input.Route.Path),
+					zap.Int("status", rec.status),
+					zap.Error(err),
+				)
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// ValidateResponse checks a response to r against the document. A route the
+// document does not describe is an error.
+func (v *Validator) ValidateResponse(r *http.Request, status int, header http.Header, body []byte) error {
+	input, ok := v.input(r)
+	if !ok {
+		return fmt.Errorf("%s %s is not in the document", r.Method, r.URL.Path)
+	}
+	return v.validateResponse(input, status, header, body)
+}
+
+func (v *Validator) input(r *http.Request) (*openapi3filter.RequestValidationInput, bool) {
+	route, pathParams, err := v.router.FindRoute(r)
+	if err != nil {
+		return nil, false
+	}
+	return &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			MultiError: true,
+			// scopes and keys are checked by the auth guard
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}, true
+}
+
+func (v *Validator) validateResponse(input *openapi3filter.RequestValidationInput, status int, header http.Header, body []byte) error {
+	out := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 status,
+		Header:                 header,
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+			SchemaValidationOptions: []openapi3.SchemaValidationOption{
+				openapi3.EnableFormatValidation(),
+			},
+		},
+	}
+	return openapi3filter.ValidateResponse(input.Request.Context(), out.SetBodyBytes(body))
+}
+
+// fieldErrors lists the parameters and the body that broke the document.
+func fieldErrors(err error) []problem.FieldError {
+	// not errors.As: a RequestError wraps a MultiError of its schema errors
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var fields []problem.FieldError
+		for _, e := range multi {
+			fields = append(fields, fieldErrors(e)...)
+		}
+		return fields
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return nil
+	}
+	field := "body"
+	if reqErr.Parameter != nil {
+		field = reqErr.Parameter.Name
+	}
+	return []problem.FieldError{{Field: field, Message: reason(reqErr)}}
+}
+
+func reason(err *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) {
+		return schemaErr.Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+	return err.Reason
+}
+
+// recorder holds the response back until it is checked. Headers go to the
+// client's writer directly.
+type recorder struct {
+	http.ResponseWriter
+	status  int
+	body    bytes.Buffer
+	flushed bool
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.flushed {
+		return
+	}
+	r.status = status
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.flushed {
+		return r.ResponseWriter.Write(b)
+	}
+	return r.body.Write(b)
+}
+
+// Flush sends what is held back and lets the rest of the response through
+// unchecked: a handler that flushes streams its response.
+func (r *recorder) Flush() {
+	if !r.flushed {
+		r.flushed = true
+		r.ResponseWriter.WriteHeader(r.status)
+		_, _ = r.ResponseWriter.Write(r.body.Bytes())
+		r.body.Reset()
+	}
+	_ = http.NewResponseController(r.ResponseWriter).Flush()
+}
+
+func (r *recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
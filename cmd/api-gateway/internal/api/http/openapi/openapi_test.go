@@ -0,0 +1,165 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: "1"
+paths:
+  /v1/matches/{match_id}:
+    get:
+      parameters:
+        - in: path
+          name: match_id
+          required: true
+          schema:
+            type: integer
+        - in: query
+          name: limit
+          schema:
+            type: integer
+            minimum: 1
+            maximum: 100
+      responses:
+        "200":
+          description: Match
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [match_id]
+                properties:
+                  match_id:
+                    type: string
+`
+
+func newTestValidator(t *testing.T, requests, responses bool) (*Validator, *observer.ObservedLogs) {
+	t.Helper()
+
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	core, logs := observer.New(zap.WarnLevel)
+	v, err := New(zap.New(core), doc, requests, responses)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	return v, logs
+}
+
+func serve(v *Validator, target string, body string) (*httptest.ResponseRecorder, bool) {
+	called := false
+	h := v.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec, called
+}
+
+func TestMiddleware_RejectsInvalidRequest(t *testing.T) {
+	v, _ := newTestValidator(t, true, false)
+
+	for target, field := range map[string]string{
+		"/v1/matches/abc":             "match_id",
+		"/v1/matches/16114?limit=500": "limit",
+	} {
+		rec, called := serve(v, target, `{"match_id":"1"}`)
+		if called || rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != problem.ContentType {
+			t.Fatalf("%s: expected a 400 problem, got %d %q called=%v", target, rec.Code, rec.Header().Get("Content-Type"), called)
+		}
+
+		var p problem.Problem
+		if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if p.Code != "INVALID_ARGUMENT" || len(p.Errors) != 1 || p.Errors[0].Field != field || p.Errors[0].Message == "" {
+			t.Fatalf("%s: unexpected problem: %+v", target, p)
+		}
+	}
+}
+
+func TestMiddleware_PassesValidAndUnknownRoutes(t *testing.T) {
+	v, _ := newTestValidator(t, true, false)
+
+	for _, target := range []string{"/v1/matches/16114?limit=5", "/healthz"} {
+		if rec, called := serve(v, target, `{"match_id":"16114"}`); !called || rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected the handler to answer, got %d called=%v", target, rec.Code, called)
+		}
+	}
+}
+
+func TestMiddleware_LogsResponseMismatch(t *testing.T) {
+	v, logs := newTestValidator(t, false, true)
+
+	rec, _ := serve(v, "/v1/matches/16114", `{"match_id":16114}`)
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"match_id":16114}` {
+		t.Fatalf("response must reach the client as is, got %d %s", rec.Code, rec.Body.String())
+	}
+	if logs.FilterMessage("response does not match openapi").Len() != 1 {
+		t.Fatalf("expected one mismatch warning, got %v", logs.All())
+	}
+
+	serve(v, "/v1/matches/16114", `{"match_id":"16114"}`)
+	if logs.Len() != 1 {
+		t.Fatalf("valid response logged: %v", logs.All())
+	}
+}
+
+func TestMiddleware_SkipsNotModified(t *testing.T) {
+	v, logs := newTestValidator(t, false, true)
+
+	h := v.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/matches/16114", nil))
+
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 || rec.Header().Get("ETag") != `"abc"` {
+		t.Fatalf("unexpected response %d %v: %s", rec.Code, rec.Header(), rec.Body.String())
+	}
+	if logs.Len() != 0 {
+		t.Fatalf("304 logged as a mismatch: %v", logs.All())
+	}
+}
+
+func TestMiddleware_StreamsFlushedResponses(t *testing.T) {
+	v, logs := newTestValidator(t, false, true)
+
+	h := v.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("the middleware writer must be a Flusher")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"match_id":`))
+		flusher.Flush()
+		_, _ = w.Write([]byte(`16114}`))
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/matches/16114", nil))
+
+	if !rec.Flushed || rec.Code != http.StatusOK || rec.Body.String() != `{"match_id":16114}` {
+		t.Fatalf("unexpected response %d flushed=%v: %s", rec.Code, rec.Flushed, rec.Body.String())
+	}
+	if logs.Len() != 0 {
+		t.Fatalf("streamed response validated: %v", logs.All())
+	}
+}
@@ -0,0 +1,199 @@
+// Package problem writes gateway errors as RFC 7807 application/problem+json.
+// Every problem carries a stable code from common.v1.ErrorReason, which the
+// backends also send in google.rpc.ErrorInfo.
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/requestid"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ContentType = "application/problem+json"
+
+	// errorDomain is the ErrorInfo domain of the fan-avia backends.
+	errorDomain = "fan-avia"
+	typePrefix  = "urn:fan-avia:problem:"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	// Extensions are additional members of a specific endpoint.
+	Extensions map[string]interface{} `json:"-"`
+	// RetryAfter is sent as the Retry-After header when set.
+	RetryAfter time.Duration `json:"-"`
+}
+
+// New builds a problem with the status of the reason, see Status.
+func New(reason commonv1.ErrorReason, detail string) *Problem {
+	return NewWithStatus(Status(reason), reason, detail)
+}
+
+func NewWithStatus(status int, reason commonv1.ErrorReason, detail string) *Problem {
+	code := Code(reason)
+	return &Problem{
+		Type:   typePrefix + strings.ToLower(strings.ReplaceAll(code, "_", "-")),
+		Title:  Title(reason),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// InvalidParam reports one rejected query or path parameter.
+func InvalidParam(field, message string) *Problem {
+	p := New(commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, message)
+	p.Errors = []FieldError{{Field: field, Message: message}}
+	return p
+}
+
+// FromGRPC converts an error of a backend call. Reasons and field violations
+// of the backends are kept; messages of errors without a reason (transport
+// failures, other services) are not shown to clients.
+func FromGRPC(err error) *Problem {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return New(commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT, "")
+	case errors.Is(err, context.Canceled):
+		return New(commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED, "")
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return New(commonv1.ErrorReason_ERROR_REASON_UPSTREAM_UNAVAILABLE, "")
+	}
+
+	reason, fields, retryAfter := details(st)
+	if reason == commonv1.ErrorReason_ERROR_REASON_UNSPECIFIED {
+		p := New(reasonFromCode(st.Code()), "")
+		p.RetryAfter = retryAfter
+		return p
+	}
+
+	p := New(reason, st.Message())
+	p.Errors = fields
+	p.RetryAfter = retryAfter
+	return p
+}
+
+func details(st *status.Status) (commonv1.ErrorReason, []FieldError, time.Duration) {
+	reason := commonv1.ErrorReason_ERROR_REASON_UNSPECIFIED
+	var fields []FieldError
+	var retryAfter time.Duration
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetDomain() != errorDomain {
+				continue
+			}
+			if v, ok := reasonFromName(detail.GetReason()); ok {
+				reason = v
+			}
+		case *errdetails.BadRequest:
+			for _, v := range detail.GetFieldViolations() {
+				fields = append(fields, FieldError{Field: v.GetField(), Message: v.GetDescription()})
+			}
+		case *errdetails.RetryInfo:
+			retryAfter = detail.GetRetryDelay().AsDuration()
+		}
+	}
+	return reason, fields, retryAfter
+}
+
+func reasonFromCode(code codes.Code) commonv1.ErrorReason {
+	switch code {
+	case codes.InvalidArgument:
+		return commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT
+	case codes.ResourceExhausted:
+		return commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED
+	case codes.Unavailable:
+		return commonv1.ErrorReason_ERROR_REASON_UPSTREAM_UNAVAILABLE
+	case codes.DeadlineExceeded:
+		return commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT
+	case codes.Canceled:
+		return commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED
+	default:
+		return commonv1.ErrorReason_ERROR_REASON_INTERNAL
+	}
+}
+
+// Write sends the problem in the language of the request, filling the
+// instance and the request id from r. p itself is left as is.
+func Write(w http.ResponseWriter, r *http.Request, p *Problem) {
+	p = p.localized(i18n.FromContext(r.Context()))
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	if p.RequestID == "" {
+		p.RequestID = requestid.FromContext(r.Context())
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	if p.RetryAfter > 0 {
+		// whole seconds, rounded up
+		w.Header().Set("Retry-After", strconv.Itoa(int((p.RetryAfter+time.Second-1)/time.Second)))
+	}
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+func (p *Problem) localized(lang i18n.Lang) *Problem {
+	out := *p
+	out.Title = i18n.T(lang, p.Title)
+	out.Detail = i18n.T(lang, p.Detail)
+	if len(p.Errors) > 0 {
+		out.Errors = make([]FieldError, 0, len(p.Errors))
+		for _, e := range p.Errors {
+			out.Errors = append(out.Errors, FieldError{Field: e.Field, Message: i18n.T(lang, e.Message)})
+		}
+	}
+	return &out
+}
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	type plain Problem
+	data, err := json.Marshal((*plain)(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return data, err
+	}
+
+	members := make(map[string]json.RawMessage, len(p.Extensions)+8)
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	for key, value := range p.Extensions {
+		if _, ok := members[key]; ok {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		members[key] = raw
+	}
+	return json.Marshal(members)
+}
@@ -0,0 +1,172 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/i18n"
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/requestid"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestFromGRPC_KeepsBackendReasonAndFields(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "origin_iata is invalid").WithDetails(
+		// the short form backends sent before the enum got its prefix
+		&errdetails.ErrorInfo{Reason: "INVALID_ARGUMENT", Domain: errorDomain},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "origin_iata", Description: "origin_iata is invalid"},
+		}},
+	)
+	if err != nil {
+		t.Fatalf("with details: %v", err)
+	}
+
+	p := FromGRPC(st.Err())
+	if p.Status != http.StatusBadRequest || p.Code != "INVALID_ARGUMENT" || p.Detail != "origin_iata is invalid" {
+		t.Fatalf("unexpected problem: %+v", p)
+	}
+	if len(p.Errors) != 1 || p.Errors[0].Field != "origin_iata" {
+		t.Fatalf("unexpected field errors: %+v", p.Errors)
+	}
+}
+
+func TestCode_DropsEnumPrefix(t *testing.T) {
+	for v, name := range commonv1.ErrorReason_name {
+		reason := commonv1.ErrorReason(v)
+		if reason == commonv1.ErrorReason_ERROR_REASON_UNSPECIFIED {
+			continue
+		}
+		if got := Code(reason); "ERROR_REASON_"+got != name {
+			t.Fatalf("Code(%s) = %q", name, got)
+		}
+	}
+}
+
+func TestFromGRPC_Reasons(t *testing.T) {
+	tests := []struct {
+		reason commonv1.ErrorReason
+		code   codes.Code
+		status int
+	}{
+		{reason: commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND, code: codes.NotFound, status: http.StatusNotFound},
+		{reason: commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION, code: codes.InvalidArgument, status: http.StatusBadRequest},
+		{reason: commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN, code: codes.FailedPrecondition, status: http.StatusUnprocessableEntity},
+		{reason: commonv1.ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE, code: codes.Unavailable, status: http.StatusServiceUnavailable},
+		{reason: commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED, code: codes.ResourceExhausted, status: http.StatusTooManyRequests},
+		{reason: commonv1.ErrorReason_ERROR_REASON_INTERNAL, code: codes.Internal, status: http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason.String(), func(t *testing.T) {
+			st, _ := status.New(tt.code, "message").WithDetails(&errdetails.ErrorInfo{Reason: tt.reason.String(), Domain: errorDomain})
+			p := FromGRPC(st.Err())
+			if p.Code != Code(tt.reason) || p.Status != tt.status {
+				t.Fatalf("expected %s/%d, got %s/%d", tt.reason, tt.status, p.Code, p.Status)
+			}
+		})
+	}
+}
+
+func TestFromGRPC_HidesMessagesWithoutReason(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   string
+		status int
+	}{
+		{err: status.Error(codes.Unavailable, "connection error: desc = dial tcp 10.0.0.5:44045"), code: "UPSTREAM_UNAVAILABLE", status: http.StatusBadGateway},
+		{err: status.Error(codes.Internal, "pq: relation does not exist"), code: "INTERNAL", status: http.StatusBadGateway},
+		{err: context.DeadlineExceeded, code: "UPSTREAM_TIMEOUT", status: http.StatusGatewayTimeout},
+		{err: errors.New("boom"), code: "UPSTREAM_UNAVAILABLE", status: http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		p := FromGRPC(tt.err)
+		if p.Code != tt.code || p.Status != tt.status || p.Detail != "" {
+			t.Fatalf("%v: unexpected problem %+v", tt.err, p)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/clubs/999", nil)
+	req = req.WithContext(requestid.WithContext(req.Context(), "req-1"))
+	rec := httptest.NewRecorder()
+
+	p := New(commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND, "club not found")
+	p.Extensions = map[string]interface{}{"club_id": "999", "code": "ignored"}
+	Write(rec, req, p)
+
+	if rec.Code != http.StatusNotFound || rec.Header().Get("Content-Type") != ContentType {
+		t.Fatalf("unexpected response: %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	want := map[string]interface{}{
+		"type":       "urn:fan-avia:problem:club-not-found",
+		"title":      "Club not found",
+		"status":     float64(http.StatusNotFound),
+		"detail":     "club not found",
+		"instance":   "/v1/clubs/999",
+		"code":       "CLUB_NOT_FOUND",
+		"request_id": "req-1",
+		"club_id":    "999",
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Fatalf("%s: expected %v, got %v", key, value, body[key])
+		}
+	}
+}
+
+func TestWrite_Localized(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/matches/upcoming", nil)
+	req = req.WithContext(i18n.WithContext(req.Context(), i18n.RU))
+	rec := httptest.NewRecorder()
+
+	p := InvalidParam("from", "from must be RFC3339 or YYYY-MM-DD")
+	Write(rec, req, p)
+
+	var body Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if body.Title != "Неверные параметры запроса" || body.Detail != "from должен быть в формате RFC3339 или YYYY-MM-DD" {
+		t.Fatalf("unexpected title/detail: %q/%q", body.Title, body.Detail)
+	}
+	if len(body.Errors) != 1 || body.Errors[0].Field != "from" || body.Errors[0].Message != body.Detail {
+		t.Fatalf("unexpected field errors: %+v", body.Errors)
+	}
+	// the problem may be written again in another language
+	if p.Title != "Invalid request parameters" || p.Errors[0].Message != "from must be RFC3339 or YYYY-MM-DD" || p.Instance != "" {
+		t.Fatalf("problem was changed: %+v", p)
+	}
+}
+
+func TestFromGRPC_CircuitOpenSetsRetryAfter(t *testing.T) {
+	st, err := status.New(codes.Unavailable, "match-adapter circuit is open").WithDetails(
+		&errdetails.ErrorInfo{Reason: "UPSTREAM_CIRCUIT_OPEN", Domain: errorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(2500 * time.Millisecond)},
+	)
+	if err != nil {
+		t.Fatalf("with details: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	Write(rec, httptest.NewRequest(http.MethodGet, "/v1/clubs", nil), FromGRPC(st.Err()))
+
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") != "3" {
+		t.Fatalf("unexpected response: %d, Retry-After %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+}
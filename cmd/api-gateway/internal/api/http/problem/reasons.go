@@ -0,0 +1,79 @@
+package problem
+
+import (
+	"net/http"
+	"strings"
+
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+)
+
+const reasonPrefix = "ERROR_REASON_"
+
+// statusClientClosedRequest is answered when the client went away, nobody reads it.
+const statusClientClosedRequest = 499
+
+// reasons keeps the short code clients see for each reason: the enum names
+// carry the ERROR_REASON_ prefix only to keep them unique in common.v1.
+var reasons = map[commonv1.ErrorReason]struct {
+	code   string
+	status int
+	title  string
+}{
+	commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT:           {"INVALID_ARGUMENT", http.StatusBadRequest, "Invalid request parameters"},
+	commonv1.ErrorReason_ERROR_REASON_NOT_FOUND:                  {"NOT_FOUND", http.StatusNotFound, "Resource not found"},
+	commonv1.ErrorReason_ERROR_REASON_METHOD_NOT_ALLOWED:         {"METHOD_NOT_ALLOWED", http.StatusMethodNotAllowed, "Method not allowed"},
+	commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND:            {"MATCH_NOT_FOUND", http.StatusNotFound, "Match not found"},
+	commonv1.ErrorReason_ERROR_REASON_CLUB_NOT_FOUND:             {"CLUB_NOT_FOUND", http.StatusNotFound, "Club not found"},
+	commonv1.ErrorReason_ERROR_REASON_STADIUM_NOT_FOUND:          {"STADIUM_NOT_FOUND", http.StatusNotFound, "Stadium not found"},
+	commonv1.ErrorReason_ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND:   {"MATCH_OVERRIDE_NOT_FOUND", http.StatusNotFound, "Match override not found"},
+	commonv1.ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN:  {"MATCH_DESTINATION_UNKNOWN", http.StatusUnprocessableEntity, "Match destination airport is unknown"},
+	commonv1.ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION:  {"ORIGIN_EQUALS_DESTINATION", http.StatusBadRequest, "Origin and destination must differ"},
+	commonv1.ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND:          {"AIRFARE_NOT_FOUND", http.StatusNotFound, "No airfare offers found"},
+	commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE:   {"MATCH_SOURCE_UNAVAILABLE", http.StatusServiceUnavailable, "Match source unavailable"},
+	commonv1.ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE: {"AIRFARE_SOURCE_UNAVAILABLE", http.StatusServiceUnavailable, "Airfare source unavailable"},
+	commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED:               {"RATE_LIMITED", http.StatusTooManyRequests, "Too many requests"},
+	commonv1.ErrorReason_ERROR_REASON_UPSTREAM_UNAVAILABLE:       {"UPSTREAM_UNAVAILABLE", http.StatusBadGateway, "Upstream service unavailable"},
+	commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT:           {"UPSTREAM_TIMEOUT", http.StatusGatewayTimeout, "Upstream service timed out"},
+	commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED:           {"REQUEST_CANCELED", statusClientClosedRequest, "Request canceled"},
+	commonv1.ErrorReason_ERROR_REASON_INTERNAL:                   {"INTERNAL", http.StatusBadGateway, "Upstream service error"},
+	commonv1.ErrorReason_ERROR_REASON_UNAUTHENTICATED:            {"UNAUTHENTICATED", http.StatusUnauthorized, "Invalid API key"},
+	commonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED:          {"PERMISSION_DENIED", http.StatusForbidden, "API key scope required"},
+	commonv1.ErrorReason_ERROR_REASON_QUOTA_EXCEEDED:             {"QUOTA_EXCEEDED", http.StatusTooManyRequests, "Daily quota exceeded"},
+	commonv1.ErrorReason_ERROR_REASON_API_KEY_NOT_FOUND:          {"API_KEY_NOT_FOUND", http.StatusNotFound, "API key not found"},
+	commonv1.ErrorReason_ERROR_REASON_UPSTREAM_CIRCUIT_OPEN:      {"UPSTREAM_CIRCUIT_OPEN", http.StatusServiceUnavailable, "Upstream service temporarily disabled"},
+}
+
+// Status is the HTTP status of a reason. INTERNAL maps to 502 because it
+// comes from a backend; errors of the gateway itself use NewWithStatus.
+func Status(reason commonv1.ErrorReason) int {
+	if r, ok := reasons[reason]; ok {
+		return r.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Code is the problem+json code of a reason, e.g. MATCH_NOT_FOUND.
+func Code(reason commonv1.ErrorReason) string {
+	if r, ok := reasons[reason]; ok {
+		return r.code
+	}
+	return strings.TrimPrefix(reason.String(), reasonPrefix)
+}
+
+// reasonFromName reads the reason a backend put into ErrorInfo.reason. The
+// short form is accepted as well, it is what backends sent before the enum
+// values got their prefix.
+func reasonFromName(name string) (commonv1.ErrorReason, bool) {
+	if !strings.HasPrefix(name, reasonPrefix) {
+		name = reasonPrefix + name
+	}
+	v, ok := commonv1.ErrorReason_value[name]
+	return commonv1.ErrorReason(v), ok
+}
+
+func Title(reason commonv1.ErrorReason) string {
+	if r, ok := reasons[reason]; ok {
+		return r.title
+	}
+	return http.StatusText(http.StatusInternalServerError)
+}
@@ -0,0 +1,105 @@
+// Package router routes gateway requests by method and path pattern on top of
+// http.ServeMux. Path parameters are read with r.PathValue, unknown paths and
+// methods get problem+json errors like the handlers.
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+)
+
+type Middleware func(http.Handler) http.Handler
+
+type Router struct {
+	mux        *http.ServeMux
+	middleware []Middleware
+	handler    http.Handler
+}
+
+func New() *Router {
+	r := &Router{mux: http.NewServeMux()}
+	r.handler = http.HandlerFunc(r.serveMux)
+	return r
+}
+
+// Use appends middleware. The first one added runs first.
+func (r *Router) Use(middleware ...Middleware) {
+	r.middleware = append(r.middleware, middleware...)
+
+	var h http.Handler = http.HandlerFunc(r.serveMux)
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		h = r.middleware[i](h)
+	}
+	r.handler = h
+}
+
+// Handle registers h for method and a ServeMux pattern such as
+// /v1/matches/{id}. An empty method matches every method. A GET route
+// also answers HEAD.
+func (r *Router) Handle(method, pattern string, h http.HandlerFunc) {
+	if method != "" {
+		pattern = method + " " + pattern
+	}
+	r.mux.HandleFunc(pattern, h)
+}
+
+func (r *Router) Get(pattern string, h http.HandlerFunc) {
+	r.Handle(http.MethodGet, pattern, h)
+}
+
+// Route returns the pattern registered for req without the method, such as
+// /v1/matches/{id}, or an empty string when no route matches.
+func (r *Router) Route(req *http.Request) string {
+	_, pattern := r.mux.Handler(req)
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		return path
+	}
+	return pattern
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.handler.ServeHTTP(w, req)
+}
+
+func (r *Router) serveMux(w http.ResponseWriter, req *http.Request) {
+	h, pattern := r.mux.Handler(req)
+	if pattern != "" {
+		r.mux.ServeHTTP(w, req)
+		return
+	}
+
+	// ServeMux answers unknown routes in plain text; it sets Allow for 405
+	// before writing the status.
+	h.ServeHTTP(&errorWriter{ResponseWriter: w, req: req}, req)
+}
+
+// errorWriter replaces the plain text body of ServeMux errors with a problem.
+type errorWriter struct {
+	http.ResponseWriter
+	req         *http.Request
+	wroteHeader bool
+}
+
+func (w *errorWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	p := problem.New(commonv1.ErrorReason_ERROR_REASON_NOT_FOUND, "no route for "+w.req.URL.Path)
+	if status == http.StatusMethodNotAllowed {
+		p = problem.New(commonv1.ErrorReason_ERROR_REASON_METHOD_NOT_ALLOWED, w.req.Method+" is not supported, see the Allow header")
+	}
+	problem.Write(w.ResponseWriter, w.req, p)
+}
+
+func (w *errorWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusNotFound)
+	}
+	// the plain text body of ServeMux is dropped
+	return len(b), nil
+}
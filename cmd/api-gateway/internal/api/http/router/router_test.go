@@ -0,0 +1,124 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/api/http/problem"
+)
+
+func TestRouter_PathValuesAndSpecificRoutes(t *testing.T) {
+	r := New()
+	r.Get("/v1/matches/{id}", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("match " + req.PathValue("id")))
+	})
+	r.Get("/v1/matches/upcoming", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("upcoming"))
+	})
+	r.Get("/v1/matches/{id}/airfare", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("airfare " + req.PathValue("id")))
+	})
+
+	for path, want := range map[string]string{
+		"/v1/matches/16114":         "match 16114",
+		"/v1/matches/upcoming":      "upcoming",
+		"/v1/matches/16114/airfare": "airfare 16114",
+	} {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK || rec.Body.String() != want {
+			t.Fatalf("%s: expected 200 %q, got %d %q", path, want, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestRouter_MethodNotAllowed(t *testing.T) {
+	r := New()
+	r.Get("/v1/clubs", func(w http.ResponseWriter, _ *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/clubs", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); !strings.Contains(allow, http.MethodGet) || !strings.Contains(allow, http.MethodHead) {
+		t.Fatalf("expected Allow with GET and HEAD, got %q", allow)
+	}
+	assertProblem(t, rec, "METHOD_NOT_ALLOWED")
+}
+
+func TestRouter_NotFound(t *testing.T) {
+	r := New()
+	r.Get("/v1/clubs", func(w http.ResponseWriter, _ *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/teams", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+	assertProblem(t, rec, "NOT_FOUND")
+}
+
+func TestRouter_MiddlewareOrder(t *testing.T) {
+	var calls []string
+	mark := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, req)
+			})
+		}
+	}
+
+	r := New()
+	r.Use(mark("first"), mark("second"))
+	r.Get("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		calls = append(calls, "handler")
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	want := "first,second,handler,first,second"
+	if got := strings.Join(calls, ","); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func assertProblem(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+
+	if ct := rec.Header().Get("Content-Type"); ct != problem.ContentType {
+		t.Fatalf("expected problem content type, got %q", ct)
+	}
+	var body problem.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode body %q: %v", rec.Body.String(), err)
+	}
+	if body.Code != code || body.Status != rec.Code {
+		t.Fatalf("expected %s with status %d, got %+v", code, rec.Code, body)
+	}
+}
+
+func TestRouter_Route(t *testing.T) {
+	r := New()
+	r.Get("/v1/matches/{id}", func(http.ResponseWriter, *http.Request) {})
+	r.Handle("", "/v1/stub", func(http.ResponseWriter, *http.Request) {})
+
+	for req, want := range map[*http.Request]string{
+		httptest.NewRequest(http.MethodGet, "/v1/matches/16114", nil):  "/v1/matches/{id}",
+		httptest.NewRequest(http.MethodHead, "/v1/matches/16114", nil): "/v1/matches/{id}",
+		httptest.NewRequest(http.MethodPost, "/v1/stub", nil):          "/v1/stub",
+		httptest.NewRequest(http.MethodPost, "/v1/matches/16114", nil): "",
+		httptest.NewRequest(http.MethodGet, "/v1/unknown", nil):        "",
+	} {
+		if got := r.Route(req); got != want {
+			t.Fatalf("%s %s: expected route %q, got %q", req.Method, req.URL.Path, want, got)
+		}
+	}
+}
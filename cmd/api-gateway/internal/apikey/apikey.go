@@ -0,0 +1,158 @@
+// Package apikey describes the API keys of gateway clients: their scopes,
+// limits and the stores that keep them.
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrKeyNotFound = errors.New("api key not found")
+)
+
+type Scope string
+
+const (
+	ScopeMatches Scope = "matches" // clubs, matches and calendars
+	ScopeAirfare Scope = "airfare" // airfare of one match
+	ScopeCatalog Scope = "catalog" // upcoming matches with airfare
+	ScopeAdmin   Scope = "admin"   // issuing and revoking keys
+)
+
+var scopes = []Scope{ScopeMatches, ScopeAirfare, ScopeCatalog, ScopeAdmin}
+
+func AllScopes() []Scope {
+	return append([]Scope(nil), scopes...)
+}
+
+func ParseScope(value string) (Scope, bool) {
+	for _, s := range scopes {
+		if string(s) == value {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// Limits of one caller. Zero RatePerMinute or DailyQuota means no limit.
+type Limits struct {
+	RatePerMinute int `json:"rate_per_minute"`
+	Burst         int `json:"burst"`
+	DailyQuota    int `json:"daily_quota"`
+}
+
+type Key struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Scopes       []Scope   `json:"scopes"`
+	Limits       Limits    `json:"limits"`
+	SecretSHA256 string    `json:"secret_sha256"`
+	CreatedAt    time.Time `json:"created_at"`
+	RevokedAt    time.Time `json:"revoked_at,omitzero"`
+}
+
+func (k Key) HasScope(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Key) Revoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// Verify compares the secret with the stored hash in constant time.
+func (k Key) Verify(secret string) bool {
+	sum := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(k.SecretSHA256)) == 1
+}
+
+const keyPrefix = "fa_"
+
+// NewKey makes a key and returns it with its plain text form
+// fa_<id>_<secret>. Only the hash of the secret is kept, so the plain text
+// can be shown once.
+func NewKey(name string, scopes []Scope, limits Limits, now time.Time) (Key, string, error) {
+	const op = "apikey.NewKey"
+
+	id, err := randomHex(8)
+	if err != nil {
+		return Key{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+	secret, err := randomHex(20)
+	if err != nil {
+		return Key{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	sum := sha256.Sum256([]byte(secret))
+	key := Key{
+		ID:           id,
+		Name:         name,
+		Scopes:       scopes,
+		Limits:       limits,
+		SecretSHA256: hex.EncodeToString(sum[:]),
+		CreatedAt:    now.UTC(),
+	}
+	return key, keyPrefix + id + "_" + secret, nil
+}
+
+// ParseKey splits the plain text form of a key into its id and secret.
+func ParseKey(plain string) (id, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(plain, keyPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+type Store interface {
+	GetKey(ctx context.Context, id string) (Key, error)
+	SaveKey(ctx context.Context, key Key) error
+	ListKeys(ctx context.Context) ([]Key, error)
+	// RevokeKey returns ErrKeyNotFound for an unknown id.
+	RevokeKey(ctx context.Context, id string, at time.Time) error
+}
+
+// Decision is the answer of a Limiter for one request.
+type Decision struct {
+	Allowed       bool
+	QuotaExceeded bool
+
+	Limit      int // requests per minute
+	Remaining  int
+	Reset      time.Duration // until the bucket is full again
+	RetryAfter time.Duration
+
+	QuotaLimit     int
+	QuotaRemaining int
+	QuotaReset     time.Duration // until the quota day ends
+}
+
+// Limiter takes one request from the token bucket and the daily quota of a
+// subject. The bucket is not charged when the quota is used up.
+type Limiter interface {
+	Allow(ctx context.Context, subject string, limits Limits, now time.Time) (Decision, error)
+}
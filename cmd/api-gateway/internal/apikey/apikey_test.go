@@ -0,0 +1,36 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewKey_ParseAndVerify(t *testing.T) {
+	key, plain, err := NewKey("partner", []Scope{ScopeMatches}, Limits{}, time.Now())
+	if err != nil {
+		t.Fatalf("new key: %v", err)
+	}
+	if strings.Contains(key.SecretSHA256, plain) {
+		t.Fatalf("plain key stored: %+v", key)
+	}
+
+	id, secret, ok := ParseKey(plain)
+	if !ok || id != key.ID {
+		t.Fatalf("ParseKey(%q) = %q, %v", plain, id, ok)
+	}
+	if !key.Verify(secret) {
+		t.Fatal("secret not verified")
+	}
+	if key.Verify(secret + "0") {
+		t.Fatal("wrong secret verified")
+	}
+}
+
+func TestParseKey_Rejects(t *testing.T) {
+	for _, plain := range []string{"", "fa_", "fa_abc", "fa__secret", "fa_abc_", "xx_abc_secret"} {
+		if _, _, ok := ParseKey(plain); ok {
+			t.Fatalf("ParseKey(%q) accepted", plain)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Client struct {
@@ -24,21 +25,84 @@ func NewClient(client matchv1.MatchAdapterServiceClient, timeout time.Duration)
 	}
 }
 
-func (c *Client) GetMatch(ctx context.Context, matchID int64) (*matchv1.GetMatchResponse, error) {
+func (c *Client) GetMatch(ctx context.Context, matchID int64, includeClubs bool) (*matchv1.GetMatchResponse, error) {
 	reqCtx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	return c.client.GetMatch(reqCtx, &matchv1.GetMatchRequest{MatchId: matchID})
+	return c.client.GetMatch(reqCtx, &matchv1.GetMatchRequest{MatchId: matchID, IncludeClubs: includeClubs})
 }
 
-func (c *Client) GetUpcomingMatches(ctx context.Context, limit int32, clubID string) (*matchv1.GetUpcomingMatchesResponse, error) {
+// GetMatches loads up to 100 matches in one call; ids that failed come back
+// in the response errors.
+func (c *Client) GetMatches(ctx context.Context, matchIDs []int64, includeClubs bool) (*matchv1.GetMatchesResponse, error) {
 	reqCtx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	return c.client.GetUpcomingMatches(reqCtx, &matchv1.GetUpcomingMatchesRequest{
-		Limit:  limit,
-		ClubId: strings.TrimSpace(clubID),
-	})
+	return c.client.GetMatches(reqCtx, &matchv1.GetMatchesRequest{MatchIds: matchIDs, IncludeClubs: includeClubs})
+}
+
+// MatchFilter mirrors GetUpcomingMatchesRequest and ListMatchesRequest; zero values mean "not set".
+type MatchFilter struct {
+	Limit           int32
+	ClubIDs         []string
+	ClubSide        matchv1.ClubSide
+	Competition     string
+	DestinationIATA string
+	City            string
+	From            time.Time
+	To              time.Time
+	Order           matchv1.SortOrder
+	Cursor          string
+	IncludeClubs    bool
+}
+
+func (c *Client) GetUpcomingMatches(ctx context.Context, filter MatchFilter) (*matchv1.GetUpcomingMatchesResponse, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &matchv1.GetUpcomingMatchesRequest{
+		Limit:           filter.Limit,
+		ClubIds:         filter.ClubIDs,
+		ClubSide:        filter.ClubSide,
+		Competition:     strings.TrimSpace(filter.Competition),
+		DestinationIata: strings.TrimSpace(filter.DestinationIATA),
+		City:            strings.TrimSpace(filter.City),
+		Cursor:          strings.TrimSpace(filter.Cursor),
+		IncludeClubs:    filter.IncludeClubs,
+	}
+	if !filter.From.IsZero() {
+		req.FromUtc = timestamppb.New(filter.From)
+	}
+	if !filter.To.IsZero() {
+		req.ToUtc = timestamppb.New(filter.To)
+	}
+
+	return c.client.GetUpcomingMatches(reqCtx, req)
+}
+
+func (c *Client) ListMatches(ctx context.Context, filter MatchFilter) (*matchv1.ListMatchesResponse, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &matchv1.ListMatchesRequest{
+		Limit:           filter.Limit,
+		ClubIds:         filter.ClubIDs,
+		ClubSide:        filter.ClubSide,
+		Competition:     strings.TrimSpace(filter.Competition),
+		DestinationIata: strings.TrimSpace(filter.DestinationIATA),
+		City:            strings.TrimSpace(filter.City),
+		Order:           filter.Order,
+		Cursor:          strings.TrimSpace(filter.Cursor),
+		IncludeClubs:    filter.IncludeClubs,
+	}
+	if !filter.From.IsZero() {
+		req.FromUtc = timestamppb.New(filter.From)
+	}
+	if !filter.To.IsZero() {
+		req.ToUtc = timestamppb.New(filter.To)
+	}
+
+	return c.client.ListMatches(reqCtx, req)
 }
 
 func (c *Client) GetClubs(ctx context.Context) (*matchv1.GetClubsResponse, error) {
@@ -0,0 +1,112 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	"github.com/redis/go-redis/v9"
+)
+
+const keySetName = "apikeys"
+
+// KeyStore keeps every API key as JSON under apikey:{id} and the ids in the
+// apikeys set. Revoked keys stay for the listing.
+type KeyStore struct {
+	redis *redis.Client
+}
+
+func NewKeyStore(redis *redis.Client) *KeyStore {
+	return &KeyStore{redis: redis}
+}
+
+func (s *KeyStore) GetKey(ctx context.Context, id string) (apikey.Key, error) {
+	data, err := s.redis.Get(ctx, keyName(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return apikey.Key{}, apikey.ErrKeyNotFound
+		}
+		return apikey.Key{}, fmt.Errorf("redis get api key: %w", err)
+	}
+
+	var key apikey.Key
+	if err := json.Unmarshal(data, &key); err != nil {
+		return apikey.Key{}, fmt.Errorf("unmarshal api key: %w", err)
+	}
+	return key, nil
+}
+
+func (s *KeyStore) SaveKey(ctx context.Context, key apikey.Key) error {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("marshal api key: %w", err)
+	}
+
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, keyName(key.ID), data, 0)
+		pipe.SAdd(ctx, keySetName, key.ID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis save api key: %w", err)
+	}
+	return nil
+}
+
+func (s *KeyStore) ListKeys(ctx context.Context) ([]apikey.Key, error) {
+	ids, err := s.redis.SMembers(ctx, keySetName).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis list api key ids: %w", err)
+	}
+	if len(ids) == 0 {
+		return []apikey.Key{}, nil
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, keyName(id))
+	}
+	values, err := s.redis.MGet(ctx, names...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get api keys: %w", err)
+	}
+
+	keys := make([]apikey.Key, 0, len(values))
+	for _, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var key apikey.Key
+		if err := json.Unmarshal([]byte(data), &key); err != nil {
+			return nil, fmt.Errorf("unmarshal api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+func (s *KeyStore) RevokeKey(ctx context.Context, id string, at time.Time) error {
+	key, err := s.GetKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key.Revoked() {
+		return nil
+	}
+
+	key.RevokedAt = at.UTC()
+	return s.SaveKey(ctx, key)
+}
+
+func keyName(id string) string {
+	return fmt.Sprintf("apikey:%s", id)
+}
@@ -0,0 +1,139 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/apikey"
+	"github.com/redis/go-redis/v9"
+)
+
+// allowScript checks the daily quota first, then takes a token from the
+// bucket and counts the request against the quota. Everything runs in one
+// script, so replicas of the gateway share the limits without races.
+//
+// KEYS: bucket, quota counter
+// ARGV: tokens per second, burst, now in ms, daily quota, quota ttl in s
+// Returns {status, tokens, used}: status 0 allowed, 1 rate limited,
+// 2 quota exceeded.
+var allowScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local quota = tonumber(ARGV[4])
+local quota_ttl = tonumber(ARGV[5])
+
+local tokens = burst
+if rate > 0 then
+  local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+  tokens = tonumber(state[1]) or burst
+  local last = tonumber(state[2]) or now
+  if now > last then
+    tokens = math.min(burst, tokens + (now - last) / 1000 * rate)
+  end
+end
+
+local used = 0
+if quota > 0 then
+  used = tonumber(redis.call('GET', KEYS[2]) or '0')
+  if used >= quota then
+    return {2, tostring(tokens), used}
+  end
+end
+
+if rate > 0 then
+  local status = 1
+  if tokens >= 1 then
+    tokens = tokens - 1
+    status = 0
+  end
+  redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+  redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+  if status == 1 then
+    return {1, tostring(tokens), used}
+  end
+end
+
+if quota > 0 then
+  used = redis.call('INCR', KEYS[2])
+  if used == 1 then
+    redis.call('EXPIRE', KEYS[2], quota_ttl)
+  end
+end
+return {0, tostring(tokens), used}
+`)
+
+// Limiter keeps a token bucket under ratelimit:{subject} and a counter per
+// UTC day under quota:{subject}:{yyyymmdd}.
+type Limiter struct {
+	redis *redis.Client
+}
+
+func NewLimiter(redis *redis.Client) *Limiter {
+	return &Limiter{redis: redis}
+}
+
+func (l *Limiter) Allow(ctx context.Context, subject string, limits apikey.Limits, now time.Time) (apikey.Decision, error) {
+	now = now.UTC()
+	rate := float64(limits.RatePerMinute) / 60
+	burst := limits.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+	dayEnd := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	quotaReset := dayEnd.Sub(now)
+
+	keys := []string{
+		fmt.Sprintf("ratelimit:%s", subject),
+		fmt.Sprintf("quota:%s:%s", subject, now.Format("20060102")),
+	}
+	res, err := allowScript.Run(ctx, l.redis, keys,
+		rate, burst, now.UnixMilli(), limits.DailyQuota, int(quotaReset.Seconds())+3600,
+	).Slice()
+	if err != nil {
+		return apikey.Decision{}, fmt.Errorf("redis allow request: %w", err)
+	}
+	if len(res) != 3 {
+		return apikey.Decision{}, fmt.Errorf("redis allow request: unexpected reply %v", res)
+	}
+
+	status, _ := res[0].(int64)
+	tokensText, _ := res[1].(string)
+	used, _ := res[2].(int64)
+	tokens, err := strconv.ParseFloat(tokensText, 64)
+	if err != nil {
+		return apikey.Decision{}, fmt.Errorf("redis allow request: parse tokens: %w", err)
+	}
+
+	d := apikey.Decision{
+		Allowed:       status == 0,
+		QuotaExceeded: status == 2,
+	}
+	if limits.RatePerMinute > 0 {
+		d.Limit = limits.RatePerMinute
+		d.Remaining = int(math.Floor(tokens))
+		d.Reset = secondsToDuration((float64(burst) - tokens) / rate)
+		if status == 1 {
+			d.RetryAfter = secondsToDuration((1 - tokens) / rate)
+		}
+	}
+	if limits.DailyQuota > 0 {
+		d.QuotaLimit = limits.DailyQuota
+		d.QuotaRemaining = max(limits.DailyQuota-int(used), 0)
+		d.QuotaReset = quotaReset
+		if status == 2 {
+			d.RetryAfter = quotaReset
+		}
+	}
+	return d, nil
+}
+
+func secondsToDuration(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(s * float64(time.Second))
+}
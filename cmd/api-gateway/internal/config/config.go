@@ -12,9 +12,15 @@ type Config struct {
 	Env      string         `yaml:"env" env:"ENV" env-default:"local"`
 	Log      LogConfig      `yaml:"log"`
 	HTTP     HTTPConfig     `yaml:"http"`
+	Metrics  MetricsConfig  `yaml:"metrics"`
 	Clients  ClientsConfig  `yaml:"clients"`
 	Defaults DefaultsConfig `yaml:"defaults"`
+	Site     SiteConfig     `yaml:"site"`
 	Jaeger   JaegerConfig   `yaml:"jaeger"`
+	Redis    RedisConfig    `yaml:"redis"`
+	Auth     AuthConfig     `yaml:"auth"`
+	Cache    CacheConfig    `yaml:"cache"`
+	OpenAPI  OpenAPIConfig  `yaml:"openapi"`
 }
 
 type LogConfig struct {
@@ -27,31 +33,117 @@ type HTTPConfig struct {
 	ReadTimeout     time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" env-default:"5s"`
 	WriteTimeout    time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" env-default:"5s"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" env-default:"5s"`
+	// TrustForwardedFor takes the client address from the last X-Forwarded-For
+	// entry. Enable it only behind a proxy that sets the header.
+	TrustForwardedFor bool `yaml:"trust_forwarded_for" env:"HTTP_TRUST_FORWARDED_FOR" env-default:"false"`
+}
+
+// MetricsConfig serves Prometheus metrics on /metrics, apart from the public
+// HTTP port.
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled" env:"METRICS_ENABLED" env-default:"true"`
+	Host    string `yaml:"host" env:"METRICS_HOST" env-default:"0.0.0.0"`
+	Port    int    `yaml:"port" env:"METRICS_PORT" env-default:"9093"`
 }
 
 type ClientsConfig struct {
 	Airfare AirfareClientConfig `yaml:"airfare"`
 	Match   MatchClientConfig   `yaml:"match"`
+	// Keepalive pings find dead connections to backends between calls.
+	KeepaliveTime    time.Duration `yaml:"keepalive_time" env:"GRPC_KEEPALIVE_TIME" env-default:"30s"`
+	KeepaliveTimeout time.Duration `yaml:"keepalive_timeout" env:"GRPC_KEEPALIVE_TIMEOUT" env-default:"10s"`
 }
 
 type AirfareClientConfig struct {
 	Address      string        `yaml:"address" env:"AIRFARE_ADDRESS"`
 	Timeout      time.Duration `yaml:"timeout" env:"AIRFARE_TIMEOUT" env-default:"5s"`
 	RetriesCount int           `yaml:"retriesCount" env:"AIRFARE_RETRIES_COUNT" env-default:"3"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"AIRFARE_RETRY_BACKOFF" env-default:"50ms"`
+	// HedgeDelay sends a second attempt of a call still running after it; 0 disables hedging.
+	HedgeDelay time.Duration `yaml:"hedge_delay" env:"AIRFARE_HEDGE_DELAY" env-default:"0s"`
+	// The breaker opens after BreakerFailures calls in a row found the backend
+	// down or too slow and stays open for BreakerOpenTimeout.
+	BreakerFailures    int           `yaml:"breaker_failures" env:"AIRFARE_BREAKER_FAILURES" env-default:"5"`
+	BreakerOpenTimeout time.Duration `yaml:"breaker_open_timeout" env:"AIRFARE_BREAKER_OPEN_TIMEOUT" env-default:"10s"`
 }
 
 type MatchClientConfig struct {
 	Address      string        `yaml:"address" env:"MATCH_ADDRESS"`
 	Timeout      time.Duration `yaml:"timeout" env:"MATCH_TIMEOUT" env-default:"5s"`
 	RetriesCount int           `yaml:"retriesCount" env:"MATCH_RETRIES_COUNT" env-default:"3"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"MATCH_RETRY_BACKOFF" env-default:"50ms"`
+	// HedgeDelay sends a second attempt of a call still running after it; 0 disables hedging.
+	HedgeDelay time.Duration `yaml:"hedge_delay" env:"MATCH_HEDGE_DELAY" env-default:"0s"`
+	// The breaker opens after BreakerFailures calls in a row found the backend
+	// down or too slow and stays open for BreakerOpenTimeout.
+	BreakerFailures    int           `yaml:"breaker_failures" env:"MATCH_BREAKER_FAILURES" env-default:"5"`
+	BreakerOpenTimeout time.Duration `yaml:"breaker_open_timeout" env:"MATCH_BREAKER_OPEN_TIMEOUT" env-default:"10s"`
 }
 
 type JaegerConfig struct {
 	Address string `yaml:"address" env:"JAEGER_ADDRESS"`
 }
 
+type RedisConfig struct {
+	Addr     string `yaml:"addr" env:"REDIS_ADDR" env-default:"localhost:6379"`
+	Password string `yaml:"password" env:"REDIS_PASSWORD"`
+	Username string `yaml:"username" env:"REDIS_USERNAME"`
+	DB       int    `yaml:"db" env:"REDIS_DB" env-default:"0"`
+}
+
+// AuthConfig turns on API keys. Keys and limits live in Redis, so every
+// replica of the gateway shares them.
+type AuthConfig struct {
+	Enabled bool `yaml:"enabled" env:"AUTH_ENABLED" env-default:"false"`
+	// AdminToken is accepted as a key with every scope and no limits. It is
+	// meant for issuing the first keys; empty disables it.
+	AdminToken  string              `yaml:"admin_token" env:"AUTH_ADMIN_TOKEN"`
+	Anonymous   AnonymousAuthConfig `yaml:"anonymous"`
+	KeyDefaults KeyDefaultsConfig   `yaml:"key_defaults"`
+}
+
+// AnonymousAuthConfig limits callers without a key by their address.
+type AnonymousAuthConfig struct {
+	Scopes        []string `yaml:"scopes" env:"AUTH_ANONYMOUS_SCOPES" env-default:"matches,airfare,catalog" env-separator:","`
+	RatePerMinute int      `yaml:"rate_per_minute" env:"AUTH_ANONYMOUS_RATE_PER_MINUTE" env-default:"30"`
+	Burst         int      `yaml:"burst" env:"AUTH_ANONYMOUS_BURST" env-default:"10"`
+	DailyQuota    int      `yaml:"daily_quota" env:"AUTH_ANONYMOUS_DAILY_QUOTA" env-default:"2000"`
+}
+
+// KeyDefaultsConfig holds the limits of issued keys that do not set their own.
+type KeyDefaultsConfig struct {
+	RatePerMinute int `yaml:"rate_per_minute" env:"AUTH_KEY_RATE_PER_MINUTE" env-default:"300"`
+	Burst         int `yaml:"burst" env:"AUTH_KEY_BURST" env-default:"60"`
+	DailyQuota    int `yaml:"daily_quota" env:"AUTH_KEY_DAILY_QUOTA" env-default:"100000"`
+}
+
+// CacheConfig sets the Cache-Control max-age of responses; the defaults
+// follow the match-adapter list cache. Airfare takes the TTL of the
+// airfare-provider cache from each response.
+type CacheConfig struct {
+	ClubsMaxAge   time.Duration `yaml:"clubs_max_age" env:"CACHE_CLUBS_MAX_AGE" env-default:"6h"`
+	MatchesMaxAge time.Duration `yaml:"matches_max_age" env:"CACHE_MATCHES_MAX_AGE" env-default:"30s"`
+	// CatalogTTL keeps upcoming-with-airfare responses in the gateway.
+	CatalogTTL  time.Duration `yaml:"catalog_ttl" env:"CACHE_CATALOG_TTL" env-default:"1m"`
+	CatalogSize int           `yaml:"catalog_size" env:"CACHE_CATALOG_SIZE" env-default:"256"`
+}
+
+// OpenAPIConfig checks the traffic against openapi.yaml. The document is
+// loaded only when one of the checks is on.
+type OpenAPIConfig struct {
+	SpecPath          string `yaml:"spec_path" env:"OPENAPI_SPEC_PATH" env-default:"../../openapi.yaml"`
+	ValidateRequests  bool   `yaml:"validate_requests" env:"OPENAPI_VALIDATE_REQUESTS" env-default:"false"`
+	ValidateResponses bool   `yaml:"validate_responses" env:"OPENAPI_VALIDATE_RESPONSES" env-default:"false"`
+}
+
 type DefaultsConfig struct {
 	OriginIATA string `yaml:"origin_iata" env:"DEFAULT_ORIGIN_IATA" env-default:"MOW"`
+	// Language answers requests without a supported Accept-Language.
+	Language string `yaml:"language" env:"DEFAULT_LANGUAGE" env-default:"ru"`
+}
+
+type SiteConfig struct {
+	BaseURL string `yaml:"base_url" env:"SITE_BASE_URL" env-default:"http://localhost:8080"`
 }
 
 func MustLoad() *Config {
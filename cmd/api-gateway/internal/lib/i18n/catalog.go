@@ -0,0 +1,143 @@
+package i18n
+
+import (
+	"slices"
+	"strings"
+)
+
+// ru translates problem titles, error details of the gateway and of the
+// backends, and the labels of responses and calendars.
+var ru = map[string]string{
+	// problem titles
+	"Invalid request parameters":            "Неверные параметры запроса",
+	"Resource not found":                    "Ресурс не найден",
+	"Method not allowed":                    "Метод не поддерживается",
+	"Match not found":                       "Матч не найден",
+	"Club not found":                        "Клуб не найден",
+	"Stadium not found":                     "Стадион не найден",
+	"Match override not found":              "Исправление матча не найдено",
+	"Match destination airport is unknown":  "Аэропорт города матча неизвестен",
+	"Origin and destination must differ":    "Город вылета совпадает с городом матча",
+	"No airfare offers found":               "Авиабилеты не найдены",
+	"Match source unavailable":              "Источник матчей недоступен",
+	"Airfare source unavailable":            "Источник цен на авиабилеты недоступен",
+	"Too many requests":                     "Слишком много запросов",
+	"Upstream service unavailable":          "Сервис недоступен",
+	"Upstream service timed out":            "Сервис не ответил вовремя",
+	"Request canceled":                      "Запрос отменен",
+	"Upstream service error":                "Ошибка сервиса",
+	"Invalid API key":                       "Неверный API-ключ",
+	"API key scope required":                "У API-ключа нет нужного скоупа",
+	"Daily quota exceeded":                  "Дневная квота исчерпана",
+	"API key not found":                     "API-ключ не найден",
+	"Upstream service temporarily disabled": "Сервис временно отключен",
+	"Internal Server Error":                 "Внутренняя ошибка",
+
+	// details of the gateway
+	"%s is not supported, see the Allow header":       "метод %s не поддерживается, см. заголовок Allow",
+	"an API key with scope %s is required":            "нужен API-ключ со скоупом %s",
+	"the API key has no scope %s":                     "у API-ключа нет скоупа %s",
+	"the API key is invalid or revoked":               "API-ключ неверный или отозван",
+	"API keys cannot be checked right now":            "API-ключи сейчас нельзя проверить",
+	"daily quota of %s requests is used up":           "дневная квота в %s запросов исчерпана",
+	"rate limit of %s requests per minute exceeded":   "превышен лимит в %s запросов в минуту",
+	"API key %s not found":                            "API-ключ %s не найден",
+	"API key cannot be generated":                     "не удалось выпустить API-ключ",
+	"API key storage is unavailable":                  "хранилище API-ключей недоступно",
+	"request body must be a JSON object":              "тело запроса должно быть JSON-объектом",
+	"request does not match the API schema":           "запрос не соответствует схеме API",
+	"no route for %s":                                 "ручка %s не найдена",
+	"key id is required":                              "нужен id ключа",
+	"name is required":                                "нужно имя ключа",
+	"name is too long":                                "имя ключа слишком длинное",
+	"at least one scope is required":                  "нужен хотя бы один скоуп",
+	"unknown scope %s":                                "неизвестный скоуп %s",
+	"must not be negative":                            "не может быть отрицательным",
+	"failed to encode response":                       "не удалось сформировать ответ",
+	"club not found":                                  "клуб не найден",
+	"none of the matches could be loaded":             "не удалось загрузить ни один матч",
+	"invalid match_id":                                "неверный match_id",
+	"club id must be a positive integer":              "id клуба должен быть положительным целым числом",
+	"club_id must be a positive integer":              "club_id должен быть положительным целым числом",
+	"limit must be a positive integer":                "limit должен быть положительным целым числом",
+	"origin_iata is required":                         "нужен origin_iata",
+	"origin_iata must be 3 latin letters":             "origin_iata должен состоять из 3 латинских букв",
+	"destination_iata must be 3 latin letters":        "destination_iata должен состоять из 3 латинских букв",
+	"order must be asc or desc":                       "order может быть asc или desc",
+	"side must be home or away":                       "side может быть home или away",
+	"to must not be before from":                      "to не может быть раньше from",
+	"sort must be kickoff, price or round_trip":       "sort может быть kickoff, price или round_trip",
+	"direction must be outbound or return":            "direction может быть outbound или return",
+	"only_with_airfare must be true or false":         "only_with_airfare может быть true или false",
+	"max_price must be a positive integer":            "max_price должен быть положительным целым числом",
+	"max_round_trip_price must be a positive integer": "max_round_trip_price должен быть положительным целым числом",
+	"%s must be RFC3339 or YYYY-MM-DD":                "%s должен быть в формате RFC3339 или YYYY-MM-DD",
+
+	"competition must be a short latin code, e.g. rpl or cup":                "competition должен быть коротким латинским кодом, например rpl или cup",
+	"ids or from/to query is required, example: /v1/matches?ids=16114,16115": "нужен параметр ids или from/to, например /v1/matches?ids=16114,16115",
+
+	// details of the backends
+	"match not found":                              "матч не найден",
+	"stadium not found":                            "стадион не найден",
+	"match override not found":                     "исправление матча не найдено",
+	"match destination airport is unknown":         "аэропорт города матча неизвестен",
+	"origin_iata and destination_iata must differ": "origin_iata и destination_iata должны различаться",
+	"too many lookups of unknown matches":          "слишком много запросов неизвестных матчей",
+	"match source unavailable":                     "источник матчей недоступен",
+	"source temporarily unavailable":               "источник временно недоступен",
+	"no airfare offers found":                      "авиабилеты не найдены",
+
+	// labels
+	"time TBC":                             "время уточняется",
+	"2 days before":                        "За 2 дня до матча",
+	"1 day before":                         "За день до матча",
+	"Match day, arriving before kickoff":   "В день матча, прилет до начала",
+	"Match day, departing after the match": "В день матча, вылет после игры",
+	"1 day after":                          "На следующий день после матча",
+	"2 days after":                         "Через 2 дня после матча",
+	"Matches":                              "Матчи",
+	"Kickoff time to be confirmed":         "Время начала уточняется",
+	"Tickets: %s":                          "Билеты: %s",
+	"Airfare: %s":                          "Авиабилеты: %s",
+}
+
+var catalogs = map[Lang]catalog{
+	RU: newCatalog(ru),
+}
+
+type catalog struct {
+	messages map[string]string
+	patterns []pattern
+}
+
+// pattern is a catalog entry with one variable part.
+type pattern struct {
+	prefix     string
+	suffix     string
+	translated string
+}
+
+func newCatalog(messages map[string]string) catalog {
+	c := catalog{messages: messages}
+	for msg, translated := range messages {
+		prefix, suffix, ok := strings.Cut(msg, "%s")
+		if ok {
+			c.patterns = append(c.patterns, pattern{prefix: prefix, suffix: suffix, translated: translated})
+		}
+	}
+	// the longest fixed part wins when several patterns match
+	slices.SortFunc(c.patterns, func(a, b pattern) int {
+		if d := len(b.prefix) + len(b.suffix) - len(a.prefix) - len(a.suffix); d != 0 {
+			return d
+		}
+		return strings.Compare(a.prefix, b.prefix)
+	})
+	return c
+}
+
+func (p pattern) match(msg string) (string, bool) {
+	if len(msg) <= len(p.prefix)+len(p.suffix) || !strings.HasPrefix(msg, p.prefix) || !strings.HasSuffix(msg, p.suffix) {
+		return "", false
+	}
+	return msg[len(p.prefix) : len(msg)-len(p.suffix)], true
+}
@@ -0,0 +1,101 @@
+// Package i18n picks the language of a gateway response from Accept-Language
+// and translates the messages of the gateway into it. Messages are written in
+// English and double as keys of the catalog.
+package i18n
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+type Lang string
+
+const (
+	RU Lang = "ru"
+	EN Lang = "en"
+)
+
+// Source is the language messages are written in.
+const Source = EN
+
+var (
+	supported = []Lang{RU, EN}
+	matcher   = language.NewMatcher([]language.Tag{language.Russian, language.English})
+)
+
+// Parse accepts a supported language code such as "ru" or "en-GB".
+func Parse(s string) (Lang, bool) {
+	tag, err := language.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return "", false
+	}
+	base, _ := tag.Base()
+	for _, lang := range supported {
+		if base.String() == string(lang) {
+			return lang, true
+		}
+	}
+	return "", false
+}
+
+// Negotiate picks the supported language the Accept-Language header prefers,
+// or fallback when it names none of them.
+func Negotiate(acceptLanguage string, fallback Lang) Lang {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return fallback
+	}
+
+	tag, _, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return fallback
+	}
+	base, _ := tag.Base()
+	return Lang(base.String())
+}
+
+type contextKey struct{}
+
+func WithContext(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext returns the language of the request, Source when none was set.
+func FromContext(ctx context.Context) Lang {
+	if lang, ok := ctx.Value(contextKey{}).(Lang); ok {
+		return lang
+	}
+	return Source
+}
+
+// T translates an English message. A message with one variable part matches
+// a catalog entry with %s in its place. Unknown messages stay in English.
+func T(lang Lang, msg string) string {
+	if lang == Source || msg == "" {
+		return msg
+	}
+	catalog := catalogs[lang]
+	if translated, ok := catalog.messages[msg]; ok {
+		return translated
+	}
+	for _, p := range catalog.patterns {
+		if arg, ok := p.match(msg); ok {
+			return strings.Replace(p.translated, "%s", arg, 1)
+		}
+	}
+	return msg
+}
+
+// Name picks the name in lang out of the Russian and English ones of a
+// dictionary entry, falling back to the other one when it is empty.
+func Name(lang Lang, ru, en string) string {
+	if lang == EN && en != "" {
+		return en
+	}
+	if ru != "" {
+		return ru
+	}
+	return en
+}
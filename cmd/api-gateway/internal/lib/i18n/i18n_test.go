@@ -0,0 +1,73 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := map[string]Lang{
+		"":                          RU,
+		"en":                        EN,
+		"en-GB,en;q=0.9":            EN,
+		"ru-RU,ru;q=0.9,en;q=0.8":   RU,
+		"de;q=1.0,en;q=0.5":         EN,
+		"fr,de":                     RU,
+		"*":                         RU,
+		"not a language header;q=x": RU,
+	}
+	for header, want := range tests {
+		if got := Negotiate(header, RU); got != want {
+			t.Fatalf("Negotiate(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	if lang, ok := Parse(" en-US "); !ok || lang != EN {
+		t.Fatalf("Parse(en-US) = %q %v", lang, ok)
+	}
+	if _, ok := Parse("de"); ok {
+		t.Fatal("de must not be supported")
+	}
+}
+
+func TestT(t *testing.T) {
+	tests := []struct {
+		lang Lang
+		msg  string
+		want string
+	}{
+		{lang: RU, msg: "Match not found", want: "Матч не найден"},
+		{lang: RU, msg: "unknown scope admin", want: "неизвестный скоуп admin"},
+		{lang: RU, msg: "to must be RFC3339 or YYYY-MM-DD", want: "to должен быть в формате RFC3339 или YYYY-MM-DD"},
+		{lang: RU, msg: "a message nobody translated", want: "a message nobody translated"},
+		{lang: EN, msg: "Match not found", want: "Match not found"},
+	}
+	for _, tt := range tests {
+		if got := T(tt.lang, tt.msg); got != tt.want {
+			t.Fatalf("T(%s, %q) = %q, want %q", tt.lang, tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestName(t *testing.T) {
+	if got := Name(EN, "Москва", "Moscow"); got != "Moscow" {
+		t.Fatalf("unexpected en name %q", got)
+	}
+	if got := Name(EN, "Химки", ""); got != "Химки" {
+		t.Fatalf("expected ru fallback, got %q", got)
+	}
+	if got := Name(RU, "", "Moscow"); got != "Moscow" {
+		t.Fatalf("expected en fallback, got %q", got)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(context.Background()); got != Source {
+		t.Fatalf("expected %q without a language, got %q", Source, got)
+	}
+	if got := FromContext(WithContext(context.Background(), RU)); got != RU {
+		t.Fatalf("unexpected language %q", got)
+	}
+}
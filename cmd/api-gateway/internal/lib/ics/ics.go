@@ -0,0 +1,191 @@
+// Package ics renders minimal RFC 5545 calendars for match feeds.
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout  = "20060102"
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+	maxLineLen  = 75
+)
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+type Event struct {
+	UID         string
+	Sequence    int
+	Start       time.Time
+	End         time.Time
+	Location    *time.Location
+	Summary     string
+	Description string
+	Place       string
+	URL         string
+	Stamp       time.Time
+	// AllDay events span the calendar date of Start and ignore End and Location.
+	AllDay bool
+}
+
+func (c Calendar) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+escapeText(c.ProdID))
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+
+	for _, loc := range collectLocations(c.Events) {
+		writeTimezone(&buf, loc)
+	}
+
+	for _, e := range c.Events {
+		writeEvent(&buf, e)
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+func writeEvent(buf *bytes.Buffer, e Event) {
+	loc := e.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	writeLine(buf, "BEGIN:VEVENT")
+	writeLine(buf, "UID:"+escapeText(e.UID))
+	writeLine(buf, fmt.Sprintf("SEQUENCE:%d", e.Sequence))
+	writeLine(buf, "DTSTAMP:"+stamp.UTC().Format(utcLayout))
+	switch {
+	case e.AllDay:
+		day := time.Date(e.Start.Year(), e.Start.Month(), e.Start.Day(), 0, 0, 0, 0, time.UTC)
+		writeLine(buf, "DTSTART;VALUE=DATE:"+day.Format(dateLayout))
+		writeLine(buf, "DTEND;VALUE=DATE:"+day.AddDate(0, 0, 1).Format(dateLayout))
+	default:
+		writeLine(buf, formatDateTime("DTSTART", e.Start, loc))
+		if !e.End.IsZero() {
+			writeLine(buf, formatDateTime("DTEND", e.End, loc))
+		}
+	}
+	writeLine(buf, "SUMMARY:"+escapeText(e.Summary))
+	if e.Place != "" {
+		writeLine(buf, "LOCATION:"+escapeText(e.Place))
+	}
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.URL != "" {
+		writeLine(buf, "URL:"+e.URL)
+	}
+	writeLine(buf, "END:VEVENT")
+}
+
+func formatDateTime(name string, t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return name + ":" + t.UTC().Format(utcLayout)
+	}
+	return fmt.Sprintf("%s;TZID=%s:%s", name, loc.String(), t.In(loc).Format(localLayout))
+}
+
+func collectLocations(events []Event) []*time.Location {
+	seen := make(map[string]*time.Location)
+	for _, e := range events {
+		if e.AllDay || e.Location == nil || e.Location == time.UTC {
+			continue
+		}
+		seen[e.Location.String()] = e.Location
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	locs := make([]*time.Location, 0, len(names))
+	for _, name := range names {
+		locs = append(locs, seen[name])
+	}
+	return locs
+}
+
+// writeTimezone emits a single STANDARD component with the current offset.
+// All Russian zones dropped DST in 2014, so one fixed rule is enough.
+func writeTimezone(buf *bytes.Buffer, loc *time.Location) {
+	abbr, offset := time.Now().In(loc).Zone()
+	formatted := formatOffset(offset)
+
+	writeLine(buf, "BEGIN:VTIMEZONE")
+	writeLine(buf, "TZID:"+loc.String())
+	writeLine(buf, "BEGIN:STANDARD")
+	writeLine(buf, "DTSTART:19700101T000000")
+	writeLine(buf, "TZOFFSETFROM:"+formatted)
+	writeLine(buf, "TZOFFSETTO:"+formatted)
+	writeLine(buf, "TZNAME:"+escapeText(abbr))
+	writeLine(buf, "END:STANDARD")
+	writeLine(buf, "END:VTIMEZONE")
+}
+
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, (seconds%3600)/60)
+}
+
+func escapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return r.Replace(s)
+}
+
+// writeLine folds content lines longer than 75 octets without splitting UTF-8 runes.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := maxLineLen
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space that counts towards the limit
+		limit = maxLineLen - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
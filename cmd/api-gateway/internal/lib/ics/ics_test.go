@@ -0,0 +1,109 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarWriteTo(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Kaliningrad")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	kickoff := time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC)
+	cal := Calendar{
+		ProdID: "-//fan-avia//matches//RU",
+		Name:   "Балтика",
+		Events: []Event{{
+			UID:         "match-16114@fan-avia",
+			Sequence:    2,
+			Start:       kickoff,
+			End:         kickoff.Add(2 * time.Hour),
+			Location:    loc,
+			Summary:     "Балтика — Зенит",
+			Description: "Билеты: https://example.com/tickets; перелёт, отель",
+			Stamp:       kickoff,
+		}},
+	}
+
+	var buf bytes.Buffer
+	if _, err := cal.WriteTo(&buf); err != nil {
+		t.Fatalf("write calendar: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"TZID:Europe/Kaliningrad\r\n",
+		"TZOFFSETTO:+0200\r\n",
+		"UID:match-16114@fan-avia\r\n",
+		"SEQUENCE:2\r\n",
+		"DTSTART;TZID=Europe/Kaliningrad:20260301T180000\r\n",
+		"DTEND;TZID=Europe/Kaliningrad:20260301T200000\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	if !strings.Contains(unfolded, `tickets\; перелёт\, отель`) {
+		t.Fatalf("expected escaped description, got:\n%s", out)
+	}
+}
+
+func TestCalendarWriteTo_AllDayEvent(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	cal := Calendar{
+		ProdID: "-//fan-avia//matches//RU",
+		Events: []Event{{
+			UID:      "match-16300@fan-avia",
+			Start:    time.Date(2026, 3, 7, 0, 0, 0, 0, loc),
+			End:      time.Date(2026, 3, 7, 2, 0, 0, 0, loc),
+			Location: loc,
+			Summary:  "Спартак — Зенит",
+			Stamp:    time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			AllDay:   true,
+		}},
+	}
+
+	var buf bytes.Buffer
+	if _, err := cal.WriteTo(&buf); err != nil {
+		t.Fatalf("write calendar: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"DTSTART;VALUE=DATE:20260307\r\n",
+		"DTEND;VALUE=DATE:20260308\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "VTIMEZONE") {
+		t.Fatalf("expected no timezone for all-day events, got:\n%s", out)
+	}
+}
+
+func TestWriteLine_FoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	writeLine(&buf, "DESCRIPTION:"+strings.Repeat("ж", 100))
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineLen {
+			t.Fatalf("line longer than %d octets: %q", maxLineLen, line)
+		}
+		if !strings.HasPrefix(line, "DESCRIPTION:") && !strings.HasPrefix(line, " ") {
+			t.Fatalf("continuation line must start with space: %q", line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if unfolded != "DESCRIPTION:"+strings.Repeat("ж", 100)+"\r\n" {
+		t.Fatalf("unexpected unfolded line: %q", unfolded)
+	}
+}
@@ -0,0 +1,82 @@
+// Package metrics holds the Prometheus collectors of api-gateway. They live
+// in the default registry, which Handler serves.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests by method, route template and status code.",
+	}, []string{"method", "route", "code"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests by method, route template and status code.",
+		Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 20},
+	}, []string{"method", "route", "code"})
+)
+
+// unmatchedRoute labels requests no route was found for, so that scanned
+// paths do not become label values.
+const unmatchedRoute = "unmatched"
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware counts requests by the route template that route returns, such
+// as /v1/matches/{id}.
+func Middleware(route func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r)
+
+			pattern := route(r)
+			if pattern == "" {
+				pattern = unmatchedRoute
+			}
+			labels := []string{method(r.Method), pattern, strconv.Itoa(sw.status)}
+			httpRequests.WithLabelValues(labels...).Inc()
+			httpDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		})
+	}
+}
+
+func method(m string) string {
+	switch m {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodOptions:
+		return m
+	default:
+		return "OTHER"
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
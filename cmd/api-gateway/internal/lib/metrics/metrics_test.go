@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddleware_LabelsByRouteTemplate(t *testing.T) {
+	routes := map[string]string{"/v1/matches/16114": "/v1/matches/{id}"}
+	handler := Middleware(func(r *http.Request) string { return routes[r.URL.Path] })(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/matches/16114" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}),
+	)
+
+	matched := httpRequests.WithLabelValues(http.MethodGet, "/v1/matches/{id}", "200")
+	unmatched := httpRequests.WithLabelValues("OTHER", unmatchedRoute, "404")
+	matchedBefore, unmatchedBefore := testutil.ToFloat64(matched), testutil.ToFloat64(unmatched)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/matches/16114", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("PROPFIND", "/wp-admin/setup.php", nil))
+
+	if got := testutil.ToFloat64(matched) - matchedBefore; got != 1 {
+		t.Fatalf("expected 1 request on the route template, got %v", got)
+	}
+	if got := testutil.ToFloat64(unmatched) - unmatchedBefore; got != 1 {
+		t.Fatalf("expected 1 unmatched request, got %v", got)
+	}
+}
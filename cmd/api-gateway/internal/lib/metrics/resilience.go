@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	grpcClientRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_retries_total",
+		Help: "Repeated attempts of outgoing gRPC calls by method.",
+	}, []string{"method"})
+	grpcClientHedges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_hedged_requests_total",
+		Help: "Hedged attempts of outgoing gRPC calls by method.",
+	}, []string{"method"})
+	circuitState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "upstream_circuit_state",
+		Help: "Circuit breaker state by upstream: 0 closed, 1 half-open, 2 open.",
+	}, []string{"upstream"})
+)
+
+func ObserveRetry(method string) {
+	grpcClientRetries.WithLabelValues(method).Inc()
+}
+
+func ObserveHedge(method string) {
+	grpcClientHedges.WithLabelValues(method).Inc()
+}
+
+func SetCircuitState(upstream string, state int) {
+	circuitState.WithLabelValues(upstream).Set(float64(state))
+}
@@ -0,0 +1,54 @@
+// Package requestid carries the id of an HTTP request through the gateway and
+// on to the backends.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	Header = "X-Request-ID"
+	// MetadataKey is the gRPC metadata key the id is sent to the backends under.
+	MetadataKey = "x-request-id"
+
+	maxLength = 128
+)
+
+type contextKey struct{}
+
+// New returns a random 32 character hex id.
+func New() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Valid reports whether an id sent by the client can be reused. Only short
+// ids of letters, digits, dots, dashes and underscores are accepted so that
+// they are safe to log and echo back.
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func WithContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,39 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	tests := map[string]bool{
+		"":                               false,
+		"3f2a9c1e-7b4d-4e0a-9f7e-2c1d":   true,
+		"req_1.2":                        true,
+		"with space":                     false,
+		"line\nbreak":                    false,
+		strings.Repeat("a", maxLength+1): false,
+	}
+	for id, want := range tests {
+		if got := Valid(id); got != want {
+			t.Fatalf("Valid(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	a, b := New(), New()
+	if len(a) != 32 || !Valid(a) || a == b {
+		t.Fatalf("unexpected ids %q %q", a, b)
+	}
+}
+
+func TestContext(t *testing.T) {
+	if id := FromContext(context.Background()); id != "" {
+		t.Fatalf("expected empty id, got %q", id)
+	}
+	if id := FromContext(WithContext(context.Background(), "abc")); id != "abc" {
+		t.Fatalf("expected abc, got %q", id)
+	}
+}
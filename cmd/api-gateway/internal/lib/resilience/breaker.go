@@ -0,0 +1,179 @@
+// Package resilience holds the gRPC client interceptors that keep the gateway
+// answering while a backend is slow or down: retries, hedged requests and a
+// circuit breaker per upstream.
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/metrics"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// errorDomain is the ErrorInfo domain of the fan-avia backends.
+const errorDomain = "fan-avia"
+
+type state int
+
+const (
+	stateClosed state = iota
+	stateHalfOpen
+	stateOpen
+)
+
+// Breaker opens after a number of consecutive upstream faults and rejects
+// calls while open. After openTimeout one probe call goes out and closes the
+// breaker again if it succeeds.
+type Breaker struct {
+	upstream    string
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    state
+	faults   int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewBreaker(upstream string, threshold int, openTimeout time.Duration) *Breaker {
+	if threshold <= 0 {
+		threshold = 5
+	}
+	if openTimeout <= 0 {
+		openTimeout = 10 * time.Second
+	}
+
+	metrics.SetCircuitState(upstream, int(stateClosed))
+	return &Breaker{
+		upstream:    upstream,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+	}
+}
+
+// UnaryClientInterceptor answers Unavailable with reason
+// UPSTREAM_CIRCUIT_OPEN and a RetryInfo while the breaker is open. It goes
+// outside the retries, so one call counts once however often it was tried.
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ok, wait := b.allow()
+		if !ok {
+			return b.openError(wait)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
+
+// allow reports whether a call may go out and, if not, how long until the
+// next probe.
+func (b *Breaker) allow() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		wait := b.openTimeout - b.now().Sub(b.openedAt)
+		if wait > 0 {
+			return false, wait
+		}
+		b.setState(stateHalfOpen)
+		b.probing = true
+		return true, 0
+	case stateHalfOpen:
+		if b.probing {
+			return false, time.Second
+		}
+		b.probing = true
+		return true, 0
+	default:
+		return true, 0
+	}
+}
+
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fault := upstreamFault(err)
+	if b.state == stateHalfOpen {
+		b.probing = false
+		switch {
+		case fault:
+			b.open()
+		case status.Code(err) != codes.Canceled:
+			b.faults = 0
+			b.setState(stateClosed)
+		}
+		return
+	}
+
+	if !fault {
+		if status.Code(err) != codes.Canceled {
+			b.faults = 0
+		}
+		return
+	}
+	b.faults++
+	if b.state == stateClosed && b.faults >= b.threshold {
+		b.open()
+	}
+}
+
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.setState(stateOpen)
+}
+
+func (b *Breaker) setState(s state) {
+	b.state = s
+	metrics.SetCircuitState(b.upstream, int(s))
+}
+
+func (b *Breaker) openError(wait time.Duration) error {
+	st, err := status.New(codes.Unavailable, b.upstream+" circuit is open").WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: commonv1.ErrorReason_ERROR_REASON_UPSTREAM_CIRCUIT_OPEN.String(),
+			Domain: errorDomain,
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)},
+	)
+	if err != nil {
+		return status.Error(codes.Unavailable, b.upstream+" circuit is open")
+	}
+	return st.Err()
+}
+
+// upstreamFault tells a backend that cannot be reached or does not answer in
+// time from one that answered with an error. Backends send their own
+// failures, such as an unavailable match source, with an ErrorInfo.
+func upstreamFault(err error) bool {
+	if err == nil {
+		return false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	if st.Code() != codes.Unavailable && st.Code() != codes.DeadlineExceeded {
+		return false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == errorDomain {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package resilience
+
+import (
+	"context"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// HedgeInterceptor sends a second attempt of a call that has not answered
+// within delay and keeps the first success. With round robin balancing the
+// second attempt goes to another replica, which cuts the tail latency of a
+// stuck one. Zero delay turns hedging off.
+func HedgeInterceptor(delay time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		msg, ok := reply.(proto.Message)
+		if delay <= 0 || !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		// the attempt that loses is canceled when the call returns
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			reply proto.Message
+			err   error
+		}
+		results := make(chan result, 2)
+		attempt := func() {
+			r := msg.ProtoReflect().New().Interface()
+			err := invoker(ctx, method, req, r, cc, opts...)
+			results <- result{reply: r, err: err}
+		}
+		go attempt()
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		pending, hedged := 1, false
+		for {
+			select {
+			case <-timer.C:
+				if !hedged {
+					hedged = true
+					pending++
+					metrics.ObserveHedge(method)
+					go attempt()
+				}
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					proto.Reset(msg)
+					proto.Merge(msg, res.reply)
+					return nil
+				}
+				// a failed attempt is not hedged, the retries handle it
+				if pending == 0 {
+					return res.err
+				}
+			}
+		}
+	}
+}
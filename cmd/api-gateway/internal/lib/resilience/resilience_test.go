@@ -0,0 +1,181 @@
+package resilience
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	errUnreachable = status.Error(codes.Unavailable, "connection refused")
+	errTimeout     = status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+)
+
+func backendError(t *testing.T) error {
+	t.Helper()
+	st, err := status.New(codes.Unavailable, "match source unavailable").WithDetails(&errdetails.ErrorInfo{
+		Reason: commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE.String(),
+		Domain: errorDomain,
+	})
+	if err != nil {
+		t.Fatalf("with details: %v", err)
+	}
+	return st.Err()
+}
+
+// invokerOf answers with the errors in order and then with nil.
+func invokerOf(calls *int32, errs ...error) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		n := atomic.AddInt32(calls, 1)
+		if int(n) <= len(errs) {
+			return errs[n-1]
+		}
+		return nil
+	}
+}
+
+func TestBreaker_OpensAndProbes(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	b := NewBreaker("match-adapter", 2, 10*time.Second)
+	b.now = func() time.Time { return now }
+	intercept := b.UnaryClientInterceptor()
+
+	var calls int32
+	invoker := invokerOf(&calls, errUnreachable, errTimeout, errUnreachable)
+	call := func() error {
+		return intercept(context.Background(), "/m", nil, nil, nil, invoker)
+	}
+
+	_ = call()
+	_ = call()
+	err := call()
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2: the open breaker must not call", calls)
+	}
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("code = %s, want Unavailable", st.Code())
+	}
+	var reason string
+	var retry time.Duration
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			reason = d.GetReason()
+		case *errdetails.RetryInfo:
+			retry = d.GetRetryDelay().AsDuration()
+		}
+	}
+	if reason != commonv1.ErrorReason_ERROR_REASON_UPSTREAM_CIRCUIT_OPEN.String() || retry != 10*time.Second {
+		t.Fatalf("reason = %q, retry = %s", reason, retry)
+	}
+
+	// the failed probe opens the breaker again
+	now = now.Add(10 * time.Second)
+	if err := call(); status.Code(err) != codes.Unavailable || calls != 3 {
+		t.Fatalf("probe: err = %v, calls = %d", err, calls)
+	}
+	if err := call(); calls != 3 || err == nil {
+		t.Fatalf("breaker closed after a failed probe: err = %v, calls = %d", err, calls)
+	}
+
+	now = now.Add(10 * time.Second)
+	if err := call(); err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	if err := call(); err != nil || calls != 5 {
+		t.Fatalf("breaker not closed after a good probe: err = %v, calls = %d", err, calls)
+	}
+}
+
+func TestBreaker_IgnoresBackendErrors(t *testing.T) {
+	b := NewBreaker("match-adapter", 1, time.Minute)
+	intercept := b.UnaryClientInterceptor()
+
+	var calls int32
+	invoker := invokerOf(&calls, backendError(t), status.Error(codes.NotFound, "match not found"))
+	for i := 0; i < 3; i++ {
+		_ = intercept(context.Background(), "/m", nil, nil, nil, invoker)
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3", calls)
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int32
+		wantCode  codes.Code
+	}{
+		{"recovers", []error{errUnreachable, errUnreachable}, 3, codes.OK},
+		{"gives up", []error{errUnreachable, errUnreachable, errUnreachable, errUnreachable}, 3, codes.Unavailable},
+		{"no retry of a timeout", []error{errTimeout}, 1, codes.DeadlineExceeded},
+		{"no retry of a backend error", []error{backendError(t)}, 1, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			intercept := RetryInterceptor(2, time.Millisecond)
+			err := intercept(context.Background(), "/m", nil, nil, nil, invokerOf(&calls, tt.errs...))
+			if calls != tt.wantCalls || status.Code(err) != tt.wantCode {
+				t.Fatalf("calls = %d, err = %v", calls, err)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor_StopsBeforeDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var calls int32
+	intercept := RetryInterceptor(5, 100*time.Millisecond)
+	start := time.Now()
+	err := intercept(ctx, "/m", nil, nil, nil, invokerOf(&calls, errUnreachable))
+	if calls != 1 || status.Code(err) != codes.Unavailable {
+		t.Fatalf("calls = %d, err = %v", calls, err)
+	}
+	if elapsed := time.Since(start); elapsed > 15*time.Millisecond {
+		t.Fatalf("waited %s for a retry that could not finish", elapsed)
+	}
+}
+
+func TestHedgeInterceptor_TakesFasterAttempt(t *testing.T) {
+	var calls int32
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			<-ctx.Done() // the stuck replica
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		reply.(*wrapperspb.StringValue).Value = "second"
+		return nil
+	}
+
+	reply := &wrapperspb.StringValue{}
+	err := HedgeInterceptor(5*time.Millisecond)(context.Background(), "/m", nil, reply, nil, invoker)
+	if err != nil || reply.GetValue() != "second" {
+		t.Fatalf("err = %v, reply = %q", err, reply.GetValue())
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+}
+
+func TestHedgeInterceptor_FastCallNotHedged(t *testing.T) {
+	var calls int32
+	reply := &wrapperspb.StringValue{}
+	err := HedgeInterceptor(time.Second)(context.Background(), "/m", nil, reply, nil, invokerOf(&calls))
+	if err != nil || calls != 1 {
+		t.Fatalf("err = %v, calls = %d", err, calls)
+	}
+}
@@ -0,0 +1,61 @@
+package resilience
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/api-gateway/internal/lib/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBackoff = time.Second
+
+// RetryInterceptor repeats a call up to retries times while the backend is
+// unreachable. The backoff doubles from baseBackoff with jitter, and no retry
+// starts that would end after the deadline of the call. Every method of the
+// gateway clients is a read, so repeating one is safe.
+func RetryInterceptor(retries int, baseBackoff time.Duration) grpc.UnaryClientInterceptor {
+	if baseBackoff <= 0 {
+		baseBackoff = 50 * time.Millisecond
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		for attempt := 1; attempt <= retries && retryable(err); attempt++ {
+			wait := backoff(baseBackoff, attempt)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+				return err
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+
+			metrics.ObserveRetry(method)
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// retryable holds for a backend that could not be reached. A timeout is not
+// retried: the deadline it hit is the deadline of the whole call.
+func retryable(err error) bool {
+	return upstreamFault(err) && status.Code(err) == codes.Unavailable
+}
+
+// backoff is a random wait between half and all of base doubled per attempt.
+func backoff(base time.Duration, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	if d > maxBackoff || d <= 0 {
+		d = maxBackoff
+	}
+	return d/2 + rand.N(d/2+1)
+}
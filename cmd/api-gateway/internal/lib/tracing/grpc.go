@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryClientInterceptor starts a client span for an outgoing call and sends
+// its trace context in the request metadata.
+func UnaryClientInterceptor(tracerName string) grpc.UnaryClientInterceptor {
+	tracer := otel.Tracer(tracerName)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(rpcAttributes(method)...),
+		)
+		defer span.End()
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+// TraceID returns the id of the trace in ctx or an empty string.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "grpc")}
+	// full method is /package.Service/Method
+	if service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/"); ok {
+		attrs = append(attrs,
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		)
+	}
+	return attrs
+}
+
+func endRPCSpan(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(code)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, code.String())
+	}
+}
+
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
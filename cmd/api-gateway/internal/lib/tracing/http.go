@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIDHeader returns the trace id to the client, so that a slow or failed
+// request can be found in Jaeger.
+const TraceIDHeader = "X-Trace-ID"
+
+// Middleware starts a server span per request and continues a W3C trace
+// context sent by the client. route returns the matched route template, such
+// as /v1/matches/{id}, which names the span; unknown routes are named by the
+// method only.
+func Middleware(tracerName string, route func(*http.Request) string) func(http.Handler) http.Handler {
+	tracer := otel.Tracer(tracerName)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			name := r.Method
+			attrs := []attribute.KeyValue{
+				attribute.String("http.request.method", r.Method),
+				attribute.String("url.path", r.URL.Path),
+			}
+			if pattern := route(r); pattern != "" {
+				name += " " + pattern
+				attrs = append(attrs, attribute.String("http.route", pattern))
+			}
+
+			ctx, span := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+
+			if sc := span.SpanContext(); sc.HasTraceID() {
+				w.Header().Set(TraceIDHeader, sc.TraceID().String())
+			}
+
+			sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(sw, r.WithContext(ctx))
+
+			span.SetAttributes(attribute.Int("http.response.status_code", sw.status))
+			if sw.status >= http.StatusInternalServerError {
+				span.SetStatus(otelcodes.Error, http.StatusText(sw.status))
+			}
+		})
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,113 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestMiddleware_ContinuesTraceAndPropagatesToGRPC(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	const parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	var sent metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	interceptor := UnaryClientInterceptor("test/client")
+	handler := Middleware("test/http", func(*http.Request) string { return "/v1/matches/{id}" })(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := metadata.AppendToOutgoingContext(r.Context(), "x-request-id", "req-1")
+			if err := interceptor(ctx, "/match.v1.MatchAdapterService/GetMatch", nil, nil, nil, invoker); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			w.WriteHeader(http.StatusBadGateway)
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/matches/16114", nil)
+	req.Header.Set("traceparent", "00-"+parentTraceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(TraceIDHeader); got != parentTraceID {
+		t.Fatalf("expected %s %s, got %q", TraceIDHeader, parentTraceID, got)
+	}
+	if got := sent.Get("x-request-id"); len(got) != 1 || got[0] != "req-1" {
+		t.Fatalf("expected outgoing metadata to be kept, got %v", sent)
+	}
+	if got := sent.Get("traceparent"); len(got) != 1 || got[0][3:35] != parentTraceID {
+		t.Fatalf("expected traceparent of the request trace, got %v", got)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	client, server := spans[0], spans[1]
+	if server.Name() != "GET /v1/matches/{id}" {
+		t.Fatalf("unexpected server span name %q", server.Name())
+	}
+	if client.Name() != "/match.v1.MatchAdapterService/GetMatch" || client.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Fatalf("expected client span %q to be a child of the server span", client.Name())
+	}
+	if server.Status().Code != otelcodes.Error {
+		t.Fatalf("expected 502 to mark the server span as failed, got %v", server.Status())
+	}
+	if !hasAttribute(server.Attributes(), attribute.Int("http.response.status_code", http.StatusBadGateway)) {
+		t.Fatalf("expected status code attribute, got %v", server.Attributes())
+	}
+}
+
+func TestMiddleware_UnknownRouteNamedByMethod(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	handler := Middleware("test/http", func(*http.Request) string { return "" })(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNotFound) }),
+	)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/unknown/16114", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Name() != "GET" {
+		t.Fatalf("expected one span named GET, got %v", spans)
+	}
+	if spans[0].Status().Code == otelcodes.Error {
+		t.Fatalf("expected 404 not to mark the span as failed")
+	}
+}
+
+func hasAttribute(attrs []attribute.KeyValue, want attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		if attr == want {
+			return true
+		}
+	}
+	return false
+}
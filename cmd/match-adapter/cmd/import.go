@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/application/service"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/filesource"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/sources"
+	"go.uber.org/zap"
+)
+
+// importWindow keeps every sync batch below the service upcoming limit.
+const importWindow = 30 * 24 * time.Hour
+
+var (
+	importPath        = flag.String("import", "", "import a schedule file (.json or .csv) and exit")
+	importCompetition = flag.String("import-competition", "rpl", "competition of the imported schedule")
+	importIDOffset    = flag.Int64("import-id-offset", 0, "id offset of the imported schedule")
+)
+
+// runImport loads a schedule file and saves it through the regular sync path,
+// so destination resolution and diff logging behave as for the live source.
+func runImport(ctx context.Context, log *zap.Logger, build func(source *sources.Registry) *service.MatchService) error {
+	schedule, err := filesource.Load(*importPath)
+	if err != nil {
+		return err
+	}
+
+	registry, err := sources.NewRegistry(log, sources.Entry{
+		Name:        "import",
+		Competition: *importCompetition,
+		IDOffset:    *importIDOffset,
+		Source:      schedule,
+	})
+	if err != nil {
+		return err
+	}
+	matchService := build(registry)
+
+	first, last := schedule.Range()
+	log.Info(
+		"schedule import started",
+		zap.String("path", *importPath),
+		zap.Int("matches", schedule.Len()),
+		zap.Time("first_kickoff_utc", first),
+		zap.Time("last_kickoff_utc", last),
+	)
+
+	var saved int
+	for from := first; !from.After(last); from = from.Add(importWindow) {
+		// the window ends a nanosecond before the next one starts: the source
+		// bounds are inclusive
+		n, err := matchService.SyncUpcomingMatches(ctx, from, from.Add(importWindow-time.Nanosecond), schedule.Len())
+		saved += n
+		if err != nil {
+			return fmt.Errorf("import window from %s: %w", from.Format(time.DateOnly), err)
+		}
+	}
+
+	log.Info("schedule import completed", zap.Int("saved", saved), zap.Int("matches", schedule.Len()))
+	return nil
+}
@@ -15,11 +15,20 @@ import (
 	"github.com/ozzus/fan-avia/cmd/match-adapter/grpcapp"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/application/service"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/config"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/archive"
+	cachedrepo "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/cached"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/memory"
 	matchdb "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/postgres/repo"
 	matchredis "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/redis"
 	matchtracing "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/tracing"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/filesource"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga"
 	plclient "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga/http/client"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/sources"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/metrics"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/ratelimit"
 	grpcapi "github.com/ozzus/fan-avia/cmd/match-adapter/internal/transport/grpc"
 	diaghandler "github.com/ozzus/fan-avia/cmd/match-adapter/internal/transport/handler"
 	"github.com/redis/go-redis/v9"
@@ -54,20 +63,27 @@ func main() {
 
 	log.Info("match-adapter starting", zap.String("grpc_addr", fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)))
 
-	repo, err := matchdb.New(ctx, cfg.DB.DatabaseURL())
+	repo, err := openMatchStore(ctx, log, cfg.DB)
 	if err != nil {
-		log.Fatal("failed to connect postgres", zap.Error(err))
+		log.Fatal("failed to open match storage", zap.Error(err), zap.String("driver", cfg.DB.Driver))
 	}
 	defer repo.Close()
+	if pgRepo, ok := repo.(*matchdb.Repository); ok {
+		metrics.RegisterPostgresPool(pgRepo.Stat)
+	}
+	if snapshotter, ok := repo.(*memory.Repository); ok && cfg.DB.SnapshotPath != "" {
+		go runSnapshotSave(ctx, log, snapshotter, cfg.DB.SnapshotInterval)
+	}
 
-	httpClient := &http.Client{Timeout: cfg.Premierliga.Timeout}
-	plAPIClient := plclient.NewClient(
-		cfg.Premierliga.BaseURL,
-		httpClient,
-		cfg.Premierliga.RetryMaxAttempts,
-		cfg.Premierliga.RetryBaseInterval,
-	)
-	matchSource := premierliga.NewSource(plAPIClient)
+	sourceArchive, err := buildSourceArchive(cfg, repo)
+	if err != nil {
+		log.Fatal("failed to configure source archive", zap.Error(err))
+	}
+
+	matchSource, plAPIClient, err := buildMatchSources(log, cfg, sourceArchive)
+	if err != nil {
+		log.Fatal("failed to configure match sources", zap.Error(err))
+	}
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     cfg.Redis.Addr,
 		Password: cfg.Redis.Password,
@@ -80,14 +96,28 @@ func main() {
 		}
 	}()
 
+	metrics.RegisterRedisPool(redisClient)
+
 	matchCache := matchredis.NewMatchCache(redisClient)
-	matchService := service.NewMatchService(log, matchSource, repo, repo, matchCache, cfg.MatchCacheTTL)
+	matchRepo := buildMatchRepository(ctx, log, cfg.ListCache, repo, redisClient)
+	cityResolver := service.NewCityResolver(log, repo, cfg.CityAliasesTTL)
+	if *importPath != "" {
+		err := runImport(ctx, log, func(source *sources.Registry) *service.MatchService {
+			return service.NewMatchService(log, source, cityResolver, repo, repo, matchRepo, matchCache, cfg.MatchCacheTTL, service.SourceFallbackPolicy{})
+		})
+		if err != nil {
+			log.Fatal("schedule import failed", zap.Error(err))
+		}
+		return
+	}
+
+	matchService := service.NewMatchService(log, matchSource, cityResolver, repo, repo, matchRepo, matchCache, cfg.MatchCacheTTL, sourceFallbackPolicy(cfg.SourceFallback, matchSource))
 
 	var diagnosticSrv *http.Server
-	diagnosticErrCh := make(chan error, 1)
+	httpErrCh := make(chan error, 2)
 	if cfg.DebugHTTP.Enabled {
 		diagnosticAddr := fmt.Sprintf("%s:%d", cfg.DebugHTTP.Host, cfg.DebugHTTP.Port)
-		diagnosticHandler := diaghandler.NewDiagnosticHandler(log, repo, plAPIClient, cfg.DebugHTTP.Timeout)
+		diagnosticHandler := diaghandler.NewDiagnosticHandler(log, repo, plAPIClient, matchSource, matchService, repo, cityResolver, cfg.DebugHTTP.Timeout)
 		diagnosticSrv = &http.Server{
 			Addr:    diagnosticAddr,
 			Handler: diagnosticHandler,
@@ -96,13 +126,39 @@ func main() {
 		log.Info("diagnostic http starting", zap.String("http_addr", diagnosticAddr))
 		go func() {
 			if err := diagnosticSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-				diagnosticErrCh <- err
+				httpErrCh <- err
 			}
 		}()
 	}
 
+	var metricsSrv *http.Server
+	if cfg.Metrics.Enabled {
+		metricsAddr := fmt.Sprintf("%s:%d", cfg.Metrics.Host, cfg.Metrics.Port)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsSrv = &http.Server{
+			Addr:    metricsAddr,
+			Handler: mux,
+		}
+
+		log.Info("metrics http starting", zap.String("http_addr", metricsAddr))
+		go func() {
+			if err := metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				httpErrCh <- err
+			}
+		}()
+	}
+
+	trustedPeers, err := grpcapi.ParseTrustedPeers(cfg.GRPC.TrustedPeers)
+	if err != nil {
+		log.Fatal("invalid grpc trusted peers", zap.Error(err))
+	}
+
 	grpcApp := grpcapp.New(log, cfg.GRPC.Host, cfg.GRPC.Port, func(s *grpc.Server) {
-		grpcapi.Register(s, log, matchService)
+		grpcapi.Register(s, log, matchService, trustedPeers)
+		if cfg.GRPC.AdminEnabled {
+			grpcapi.RegisterAdmin(s, log, matchService)
+		}
 	})
 
 	errCh := make(chan error, 1)
@@ -110,6 +166,11 @@ func main() {
 		errCh <- grpcApp.Run()
 	}()
 
+	if sourceArchive != nil && cfg.SourceArchive.Retention > 0 {
+		go runArchivePurge(ctx, log, sourceArchive, cfg.SourceArchive.Retention, cfg.SourceArchive.PurgeInterval)
+	}
+	go runOverrideExpiry(ctx, log, matchService, cfg.Overrides.ExpiryInterval)
+
 	if cfg.MatchSync.Enabled {
 		interval := cfg.MatchSync.Interval
 		if interval <= 0 {
@@ -129,7 +190,12 @@ func main() {
 			syncCtx, cancel := context.WithTimeout(ctx, requestTimeout)
 			defer cancel()
 
-			saved, err := matchService.SyncUpcomingMatches(syncCtx, now, now.Add(horizon), cfg.MatchSync.Limit)
+			from := now
+			if cfg.MatchSync.ResultsLookback > 0 {
+				from = now.Add(-cfg.MatchSync.ResultsLookback)
+			}
+
+			saved, err := matchService.SyncUpcomingMatches(syncCtx, from, now.Add(horizon), cfg.MatchSync.Limit)
 			if err != nil {
 				log.Warn(
 					"upcoming matches sync failed",
@@ -170,9 +236,9 @@ func main() {
 			log.Error("gRPC server stopped", zap.Error(err))
 		}
 		stop()
-	case err := <-diagnosticErrCh:
+	case err := <-httpErrCh:
 		if err != nil {
-			log.Error("diagnostic http server stopped", zap.Error(err))
+			log.Error("diagnostic or metrics http server stopped", zap.Error(err))
 		}
 		stop()
 		grpcApp.Stop()
@@ -185,6 +251,250 @@ func main() {
 			log.Warn("failed to shutdown diagnostic http server", zap.Error(err))
 		}
 	}
+	if metricsSrv != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Warn("failed to shutdown metrics http server", zap.Error(err))
+		}
+	}
+}
+
+// matchStore is what the service, the diagnostic handler and the source
+// archive need from the storage driver.
+type matchStore interface {
+	ports.MatchRepository
+	ports.StadiumRepository
+	ports.MatchOverrideRepository
+	ports.CityDictionary
+	ports.SourceResponseArchive
+	GetByIDWithUpdatedAt(ctx context.Context, id models.MatchID) (models.Match, time.Time, error)
+	GetUnresolvedCities(ctx context.Context) ([]models.UnresolvedCity, error)
+	Close()
+}
+
+func openMatchStore(ctx context.Context, log *zap.Logger, cfg config.DBConfig) (matchStore, error) {
+	switch cfg.Driver {
+	case "", config.DBDriverPostgres:
+		return matchdb.New(ctx, cfg.DatabaseURL())
+	case config.DBDriverMemory:
+		log.Warn("match storage is kept in memory", zap.String("snapshot_path", cfg.SnapshotPath))
+		return memory.New(log, cfg.SnapshotPath)
+	default:
+		return nil, fmt.Errorf("unknown db driver %q", cfg.Driver)
+	}
+}
+
+func runSnapshotSave(ctx context.Context, log *zap.Logger, repo *memory.Repository, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := repo.Save(); err != nil {
+				log.Warn("failed to save memory snapshot", zap.Error(err))
+			}
+		}
+	}
+}
+
+func sourceFallbackPolicy(cfg config.SourceFallbackConfig, registry *sources.Registry) service.SourceFallbackPolicy {
+	policy := service.SourceFallbackPolicy{NotFoundTTL: cfg.NotFoundTTL}
+	if cfg.RatePerMinute > 0 {
+		policy.Limiter = ratelimit.NewKeyed(cfg.RatePerMinute, cfg.Burst)
+	}
+	if cfg.KnownIDsOnly {
+		policy.KnownIDs = registry
+	}
+	return policy
+}
+
+// buildMatchRepository puts the list cache in front of the database. The club
+// dictionary is dropped on startup because migrations are the only writer.
+func buildMatchRepository(ctx context.Context, log *zap.Logger, cfg config.ListCacheConfig, repo ports.MatchRepository, redisClient *redis.Client) ports.MatchRepository {
+	if !cfg.Enabled {
+		return repo
+	}
+
+	cachedRepo := cachedrepo.NewRepository(log, repo, matchredis.NewListCache(redisClient), cfg.ClubsTTL, cfg.UpcomingTTL, cfg.LocalTTL, cfg.LocalSize)
+	if err := cachedRepo.InvalidateClubs(ctx); err != nil {
+		log.Warn("failed to reset clubs cache", zap.Error(err))
+	}
+	return cachedRepo
+}
+
+// buildMatchSources creates the source registry. The returned client belongs to the
+// premierliga source without id offset and is used by the diagnostic handler.
+func buildMatchSources(log *zap.Logger, cfg *config.Config, sourceArchive ports.SourceResponseArchive) (*sources.Registry, *plclient.Client, error) {
+	var (
+		entries       []sources.Entry
+		primaryClient *plclient.Client
+	)
+
+	for _, src := range cfg.MatchSources() {
+		switch src.Kind {
+		case config.SourceKindPremierliga:
+			client := plclient.NewClient(
+				src.BaseURL,
+				&http.Client{Timeout: src.Timeout},
+				src.RetryMaxAttempts,
+				src.RetryBaseInterval,
+			)
+			configureArchive(log, cfg, client, sourceArchive, src.Name)
+			if src.IDOffset == 0 {
+				primaryClient = client
+			}
+			entries = append(entries, sources.Entry{
+				Name:        src.Name,
+				Competition: src.Competition,
+				IDOffset:    src.IDOffset,
+				Source:      premierliga.NewSource(client, src.TournamentType, src.MaxTournaments),
+			})
+		case config.SourceKindFile:
+			source, err := filesource.Load(src.Path)
+			if err != nil {
+				return nil, nil, fmt.Errorf("source %q: %w", src.Name, err)
+			}
+			entries = append(entries, sources.Entry{
+				Name:        src.Name,
+				Competition: src.Competition,
+				IDOffset:    src.IDOffset,
+				Source:      source,
+			})
+		default:
+			return nil, nil, fmt.Errorf("source %q: unknown kind %q", src.Name, src.Kind)
+		}
+
+		log.Info(
+			"match source configured",
+			zap.String("source", src.Name),
+			zap.String("kind", src.Kind),
+			zap.String("competition", src.Competition),
+			zap.Int64("id_offset", src.IDOffset),
+		)
+	}
+
+	registry, err := sources.NewRegistry(log, entries...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if primaryClient == nil {
+		primaryClient = plclient.NewClient(
+			cfg.Premierliga.BaseURL,
+			&http.Client{Timeout: cfg.Premierliga.Timeout},
+			cfg.Premierliga.RetryMaxAttempts,
+			cfg.Premierliga.RetryBaseInterval,
+		)
+		configureArchive(log, cfg, primaryClient, sourceArchive, config.SourceKindPremierliga)
+	}
+
+	return registry, primaryClient, nil
+}
+
+func buildSourceArchive(cfg *config.Config, repo ports.SourceResponseArchive) (ports.SourceResponseArchive, error) {
+	if !cfg.SourceArchive.Enabled {
+		if cfg.SourceArchive.Replay {
+			return nil, errors.New("replay requires source_archive.enabled")
+		}
+		return nil, nil
+	}
+
+	switch cfg.SourceArchive.Backend {
+	case "", config.ArchiveBackendPostgres:
+		return repo, nil
+	case config.ArchiveBackendFilesystem:
+		return archive.NewFileStore(cfg.SourceArchive.Dir)
+	default:
+		return nil, fmt.Errorf("unknown source archive backend %q", cfg.SourceArchive.Backend)
+	}
+}
+
+func configureArchive(log *zap.Logger, cfg *config.Config, client *plclient.Client, sourceArchive ports.SourceResponseArchive, source string) {
+	if sourceArchive == nil {
+		return
+	}
+	client.WithArchive(log, sourceArchive, source)
+	if cfg.SourceArchive.Replay {
+		client.WithReplay()
+		log.Warn("match source is replayed from the archive", zap.String("source", source))
+	}
+}
+
+func runArchivePurge(ctx context.Context, log *zap.Logger, sourceArchive ports.SourceResponseArchive, retention, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	purge := func() {
+		purgeCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		removed, err := sourceArchive.PurgeSourceResponses(purgeCtx, time.Now().Add(-retention))
+		if err != nil {
+			log.Warn("source archive purge failed", zap.Error(err))
+			return
+		}
+		if removed > 0 {
+			log.Info("source archive purged", zap.Int64("removed", removed))
+		}
+	}
+
+	purge()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purge()
+		}
+	}
+}
+
+// runOverrideExpiry restores the source values of matches whose overrides
+// expired. The first run starts from the zero time to catch up on expiries
+// missed while the service was down; a failed run is retried from the same point.
+func runOverrideExpiry(ctx context.Context, log *zap.Logger, matchService *service.MatchService, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	var since time.Time
+	revert := func() {
+		revertCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		now := time.Now().UTC()
+		reverted, err := matchService.RevertExpiredOverrides(revertCtx, since, now)
+		if err != nil {
+			log.Warn("expired overrides revert failed", zap.Error(err))
+			return
+		}
+		since = now
+		if reverted > 0 {
+			log.Info("expired overrides reverted", zap.Int("matches", reverted))
+		}
+	}
+
+	revert()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			revert()
+		}
+	}
 }
 
 func setupLogger(level string) *zap.Logger {
@@ -6,30 +6,38 @@ import (
 	"net"
 	"time"
 
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
+	matchtracing "github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/tracing"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/metrics"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 )
 
 type GrpcApp struct {
-	log        *zap.Logger
-	gRPCServer *grpc.Server
-	addr       string
+	log          *zap.Logger
+	gRPCServer   *grpc.Server
+	healthServer *health.Server
+	addr         string
 }
 
 func New(log *zap.Logger, host string, port int, register func(*grpc.Server)) *GrpcApp {
 	addr := fmt.Sprintf("%s:%d", host, port)
 
 	gRPCServer := grpc.NewServer(
+		// the gateway pings idle connections every 30s by default
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
 		grpc.ChainUnaryInterceptor(
-			tracingInterceptor(),
+			matchtracing.UnaryServerInterceptor("match-adapter/grpc"),
+			metrics.UnaryServerInterceptor(),
 			recoveryInterceptor(log),
 			loggingInterceptor(log),
 		),
@@ -44,49 +52,13 @@ func New(log *zap.Logger, host string, port int, register func(*grpc.Server)) *G
 	reflection.Register(gRPCServer)
 
 	return &GrpcApp{
-		log:        log,
-		gRPCServer: gRPCServer,
-		addr:       addr,
+		log:          log,
+		gRPCServer:   gRPCServer,
+		healthServer: healthServer,
+		addr:         addr,
 	}
 }
 
-func tracingInterceptor() grpc.UnaryServerInterceptor {
-	tracer := otel.Tracer("match-adapter/grpc")
-
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
-		}
-
-		ctx, span := tracer.Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
-		defer span.End()
-
-		return handler(ctx, req)
-	}
-}
-
-type metadataCarrier metadata.MD
-
-func (c metadataCarrier) Get(key string) string {
-	values := metadata.MD(c).Get(key)
-	if len(values) == 0 {
-		return ""
-	}
-	return values[0]
-}
-
-func (c metadataCarrier) Set(key, value string) {
-	metadata.MD(c).Set(key, value)
-}
-
-func (c metadataCarrier) Keys() []string {
-	keys := make([]string, 0, len(c))
-	for k := range c {
-		keys = append(keys, k)
-	}
-	return keys
-}
-
 func (a *GrpcApp) Run() error {
 	const op = "grpcapp.Run"
 
@@ -106,6 +78,8 @@ func (a *GrpcApp) Run() error {
 
 func (a *GrpcApp) Stop() {
 	a.log.Info("stopping gRPC server", zap.String("addr", a.addr))
+	// health checking clients move their calls to other replicas
+	a.healthServer.Shutdown()
 	a.gRPCServer.GracefulStop()
 }
 
@@ -121,6 +95,12 @@ func loggingInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 			zap.String("code", code.String()),
 			zap.Duration("duration", time.Since(start)),
 		}
+		if id := incomingValue(ctx, requestIDMetadataKey); id != "" {
+			fields = append(fields, zap.String("request_id", id))
+		}
+		if id := matchtracing.TraceID(ctx); id != "" {
+			fields = append(fields, zap.String("trace_id", id))
+		}
 
 		if err != nil {
 			log.Error("gRPC request failed", append(fields, zap.Error(err))...)
@@ -132,6 +112,18 @@ func loggingInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// requestIDMetadataKey carries the id of the gateway HTTP request.
+const requestIDMetadataKey = "x-request-id"
+
+func incomingValue(ctx context.Context, key string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
 func recoveryInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		defer func() {
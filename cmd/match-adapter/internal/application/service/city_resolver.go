@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"go.uber.org/zap"
+)
+
+const defaultCityDictionaryRefresh = 10 * time.Minute
+
+// CityResolver maps free-text city names from sources to the city dictionary.
+// Aliases are small enough to be kept in memory and are reloaded every refresh.
+type CityResolver struct {
+	log        *zap.Logger
+	dictionary ports.CityDictionary
+	refresh    time.Duration
+
+	mu       sync.RWMutex
+	byAlias  map[string]models.City
+	loadedAt time.Time
+}
+
+func NewCityResolver(log *zap.Logger, dictionary ports.CityDictionary, refresh time.Duration) *CityResolver {
+	if refresh <= 0 {
+		refresh = defaultCityDictionaryRefresh
+	}
+
+	return &CityResolver{
+		log:        log,
+		dictionary: dictionary,
+		refresh:    refresh,
+	}
+}
+
+func (r *CityResolver) ResolveCity(ctx context.Context, name string) (models.City, error) {
+	const op = "service.CityResolver.ResolveCity"
+
+	key := normalizeCityName(name)
+	if key == "" {
+		return models.City{}, derr.ErrCityIATANotFound
+	}
+
+	index, err := r.index(ctx)
+	if err != nil {
+		return models.City{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if city, ok := index[key]; ok {
+		return city, nil
+	}
+	if city, ok := fuzzyMatchCity(index, key); ok {
+		r.log.Debug("city resolved by fuzzy match", zap.String("city", name), zap.String("city_id", city.ID))
+		return city, nil
+	}
+
+	return models.City{}, derr.ErrCityIATANotFound
+}
+
+func (r *CityResolver) CityByName(ctx context.Context, name string) (models.City, bool) {
+	index, err := r.index(ctx)
+	if err != nil {
+		return models.City{}, false
+	}
+
+	city, ok := index[normalizeCityName(name)]
+	if !ok || city.Name != name {
+		return models.City{}, false
+	}
+	return city, true
+}
+
+func (r *CityResolver) ReportUnresolved(ctx context.Context, name string, matchID models.MatchID) error {
+	return r.dictionary.RecordUnresolvedCity(ctx, name, matchID)
+}
+
+func (r *CityResolver) index(ctx context.Context) (map[string]models.City, error) {
+	r.mu.RLock()
+	index, loadedAt := r.byAlias, r.loadedAt
+	r.mu.RUnlock()
+
+	if index != nil && time.Since(loadedAt) < r.refresh {
+		return index, nil
+	}
+
+	aliases, err := r.dictionary.GetCityAliases(ctx)
+	if err != nil {
+		if index == nil {
+			return nil, fmt.Errorf("load city aliases: %w", err)
+		}
+		// keep serving stale aliases and retry on the next refresh
+		r.log.Warn("city dictionary refresh failed", zap.Error(err))
+		r.mu.Lock()
+		r.loadedAt = time.Now()
+		r.mu.Unlock()
+		return index, nil
+	}
+
+	fresh := make(map[string]models.City, len(aliases))
+	for _, alias := range aliases {
+		if key := normalizeCityName(alias.Alias); key != "" {
+			fresh[key] = alias.City
+		}
+	}
+
+	r.mu.Lock()
+	r.byAlias = fresh
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+
+	return fresh, nil
+}
+
+var cityPrefixes = []string{"г.", "г ", "город ", "city of "}
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+	'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y",
+	'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// normalizeCityName folds case, ё/е, "г." prefixes, punctuation and script, so
+// "г. Ростов-на-Дону" and "Rostov na Donu" produce the same key.
+func normalizeCityName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "ё", "е")
+	for _, prefix := range cityPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			name = strings.TrimSpace(strings.TrimPrefix(name, prefix))
+			break
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range name {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteByte(' ')
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// fuzzyMatchCity accepts a small edit distance that grows with the name length
+// and refuses to pick between two different cities at the same distance.
+func fuzzyMatchCity(index map[string]models.City, key string) (models.City, bool) {
+	maxDistance := maxCityDistance(key)
+	if maxDistance == 0 {
+		return models.City{}, false
+	}
+
+	best := maxDistance + 1
+	var (
+		found     models.City
+		ambiguous bool
+	)
+	for alias, city := range index {
+		if abs(len(alias)-len(key)) > maxDistance {
+			continue
+		}
+		distance := levenshtein(key, alias)
+		switch {
+		case distance < best:
+			best, found, ambiguous = distance, city, false
+		case distance == best && city.ID != found.ID:
+			ambiguous = true
+		}
+	}
+
+	if best > maxDistance || ambiguous {
+		return models.City{}, false
+	}
+	return found, true
+}
+
+func maxCityDistance(key string) int {
+	switch n := len(key); {
+	case n < 5:
+		return 0
+	case n < 9:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// levenshtein works on bytes: normalized names are ASCII only.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
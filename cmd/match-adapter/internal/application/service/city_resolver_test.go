@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type cityDictionaryMock struct {
+	aliases    []models.CityAlias
+	err        error
+	calls      int
+	unresolved []string
+}
+
+func (m *cityDictionaryMock) GetCityAliases(_ context.Context) ([]models.CityAlias, error) {
+	m.calls++
+	return m.aliases, m.err
+}
+
+func (m *cityDictionaryMock) RecordUnresolvedCity(_ context.Context, name string, _ models.MatchID) error {
+	m.unresolved = append(m.unresolved, name)
+	return nil
+}
+
+func testCityDictionary() *cityDictionaryMock {
+	spb := models.City{ID: "saint-petersburg", Name: "Санкт-Петербург", IATA: "LED"}
+	rostov := models.City{ID: "rostov-on-don", Name: "Ростов-на-Дону", IATA: "ROV"}
+	grozny := models.City{ID: "grozny", Name: "Грозный", IATA: "GRV"}
+	return &cityDictionaryMock{aliases: []models.CityAlias{
+		{Alias: "Санкт-Петербург", City: spb},
+		{Alias: "Saint Petersburg", City: spb},
+		{Alias: "Ростов-на-Дону", City: rostov},
+		{Alias: "Грозный", City: grozny},
+		{Alias: "Орёл", City: models.City{ID: "oryol", Name: "Орёл", IATA: "OEL"}},
+	}}
+}
+
+func TestNormalizeCityName(t *testing.T) {
+	cases := map[string]string{
+		"г. Санкт-Петербург":   "sankt peterburg",
+		"Saint-Petersburg":     "saint petersburg",
+		"  ОРЕЛ ":              "orel",
+		"Орёл":                 "orel",
+		"город Ростов-на-Дону": "rostov na donu",
+		"«»":                   "",
+	}
+	for in, want := range cases {
+		if got := normalizeCityName(in); got != want {
+			t.Fatalf("normalizeCityName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCityResolver_ResolvesAliases(t *testing.T) {
+	resolver := NewCityResolver(zap.NewNop(), testCityDictionary(), time.Minute)
+
+	cases := map[string]string{
+		"г. Санкт-Петербург": "LED",
+		"SAINT PETERSBURG":   "LED",
+		"Rostov na Donu":     "ROV",
+		"Grozny":             "GRV", // transliterated, one edit away from "groznyi"
+		"Санкт-Петербур":     "LED", // typo
+		"орел":               "OEL",
+	}
+	for in, want := range cases {
+		city, err := resolver.ResolveCity(context.Background(), in)
+		if err != nil {
+			t.Fatalf("ResolveCity(%q) returned error: %v", in, err)
+		}
+		if city.IATA != want {
+			t.Fatalf("ResolveCity(%q) = %s, want %s", in, city.IATA, want)
+		}
+	}
+}
+
+func TestCityResolver_CityByName(t *testing.T) {
+	r := NewCityResolver(zap.NewNop(), testCityDictionary(), time.Minute)
+	ctx := context.Background()
+
+	if city, ok := r.CityByName(ctx, "Санкт-Петербург"); !ok || city.ID != "saint-petersburg" {
+		t.Fatalf("expected saint-petersburg, got %+v %v", city, ok)
+	}
+	// aliases and fuzzy matches are for resolving source names only
+	for _, name := range []string{"Saint Petersburg", "Санкт-Петербурк"} {
+		if city, ok := r.CityByName(ctx, name); ok {
+			t.Fatalf("expected no city for %q, got %+v", name, city)
+		}
+	}
+}
+
+func TestCityResolver_RejectsDistantNames(t *testing.T) {
+	resolver := NewCityResolver(zap.NewNop(), testCityDictionary(), time.Minute)
+
+	for _, in := range []string{"Новогорск", "Орск", ""} {
+		if _, err := resolver.ResolveCity(context.Background(), in); !errors.Is(err, derr.ErrCityIATANotFound) {
+			t.Fatalf("ResolveCity(%q): expected ErrCityIATANotFound, got %v", in, err)
+		}
+	}
+}
+
+func TestCityResolver_KeepsStaleAliasesOnRefreshFailure(t *testing.T) {
+	dictionary := testCityDictionary()
+	resolver := NewCityResolver(zap.NewNop(), dictionary, time.Nanosecond)
+
+	if _, err := resolver.ResolveCity(context.Background(), "Грозный"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dictionary.err = errors.New("db down")
+	time.Sleep(time.Millisecond)
+	city, err := resolver.ResolveCity(context.Background(), "Грозный")
+	if err != nil || city.IATA != "GRV" {
+		t.Fatalf("expected stale alias to be used, got %+v, %v", city, err)
+	}
+	if dictionary.calls != 2 {
+		t.Fatalf("expected reload attempt, got %d calls", dictionary.calls)
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+// Cursors are opaque for clients: base64url("<kickoff unix nanos>:<match id>").
+
+func encodeCursor(c models.MatchCursor) string {
+	raw := strconv.FormatInt(c.KickoffUTC.UTC().UnixNano(), 10) + ":" + string(c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(value string) (*models.MatchCursor, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", derr.ErrInvalidCursor, err)
+	}
+
+	kickoffPart, idPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, derr.ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(kickoffPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", derr.ErrInvalidCursor, err)
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, derr.ErrInvalidCursor
+	}
+
+	return &models.MatchCursor{
+		KickoffUTC: time.Unix(0, nanos).UTC(),
+		ID:         models.MatchID(strconv.FormatInt(id, 10)),
+	}, nil
+}
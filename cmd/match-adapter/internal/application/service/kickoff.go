@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+const (
+	// placeholderSlotMatches is how many matches of one competition have to share
+	// the exact kickoff instant before the instant is treated as a round placeholder.
+	placeholderSlotMatches = 4
+	// placeholderHorizon limits the history checks to matches whose time the league
+	// has not published yet; closer than that a shared slot is a real simultaneous
+	// round, e.g. the last one of the season.
+	placeholderHorizon = 21 * 24 * time.Hour
+)
+
+type kickoffSlot struct {
+	competition string
+	kickoff     time.Time
+}
+
+// markPlaceholderSlots marks tentative the kickoffs shared by most of a round: before the
+// schedule is set the source copies the round start time into every match.
+func markPlaceholderSlots(matches []models.Match, now time.Time) {
+	counts := make(map[kickoffSlot]int, len(matches))
+	for _, m := range matches {
+		counts[kickoffSlot{competition: m.Competition, kickoff: m.KickoffUTC.UTC()}]++
+	}
+
+	for i, m := range matches {
+		if m.KickoffUTC.Sub(now) < placeholderHorizon {
+			continue
+		}
+		if counts[kickoffSlot{competition: m.Competition, kickoff: m.KickoffUTC.UTC()}] >= placeholderSlotMatches {
+			matches[i].KickoffTentative = true
+		}
+	}
+}
+
+// keepKickoffTentative keeps a placeholder tentative until the source moves
+// it, so a sync that sees only part of a round does not promote it.
+func keepKickoffTentative(existing models.Match, updated models.Match, now time.Time) bool {
+	if !existing.KickoffTentative || updated.KickoffTentative {
+		return false
+	}
+	if updated.KickoffUTC.Sub(now) < placeholderHorizon {
+		return false
+	}
+	return existing.KickoffUTC.UTC() == updated.KickoffUTC.UTC()
+}
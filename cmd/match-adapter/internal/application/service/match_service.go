@@ -10,16 +10,21 @@ import (
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/metrics"
 	"go.uber.org/zap"
 )
 
 type MatchService struct {
 	log      *zap.Logger
 	source   ports.MatchSource
-	resolver ports.CityIATAResolver
-	repo     ports.MatchRepository
-	cache    ports.MatchCache
-	cacheTTL time.Duration
+	resolver ports.CityResolver
+	stadiums ports.StadiumRepository
+	// overrides may be nil, then source values are stored as is.
+	overrides ports.MatchOverrideRepository
+	repo      ports.MatchRepository
+	cache     ports.MatchCache
+	cacheTTL  time.Duration
+	fallback  SourceFallbackPolicy
 }
 
 const (
@@ -27,18 +32,34 @@ const (
 	maxUpcomingLimit     = 500
 )
 
-func NewMatchService(log *zap.Logger, source ports.MatchSource, resolver ports.CityIATAResolver, repo ports.MatchRepository, cache ports.MatchCache, cacheTTL time.Duration) *MatchService {
+func NewMatchService(log *zap.Logger, source ports.MatchSource, resolver ports.CityResolver, stadiums ports.StadiumRepository, overrides ports.MatchOverrideRepository, repo ports.MatchRepository, cache ports.MatchCache, cacheTTL time.Duration, fallback SourceFallbackPolicy) *MatchService {
 	return &MatchService{
-		log:      log,
-		source:   source,
-		resolver: resolver,
-		repo:     repo,
-		cache:    cache,
-		cacheTTL: cacheTTL,
+		log:       log,
+		source:    source,
+		resolver:  resolver,
+		stadiums:  stadiums,
+		overrides: overrides,
+		repo:      repo,
+		cache:     cache,
+		cacheTTL:  cacheTTL,
+		fallback:  fallback,
 	}
 }
 
 func (s *MatchService) GetMatch(ctx context.Context, id models.MatchID) (models.Match, error) {
+	match, err := s.loadMatch(ctx, id)
+	if err != nil {
+		return models.Match{}, err
+	}
+
+	matches := []models.Match{match}
+	s.attachVenues(ctx, matches)
+	s.attachCityNames(ctx, matches)
+
+	return matches[0], nil
+}
+
+func (s *MatchService) loadMatch(ctx context.Context, id models.MatchID) (models.Match, error) {
 	const op = "service.GetMatch"
 
 	logger := s.log.With(
@@ -71,20 +92,91 @@ func (s *MatchService) GetMatch(ctx context.Context, id models.MatchID) (models.
 		return models.Match{}, fmt.Errorf("%s: get match from repo: %w", op, err)
 	}
 
-	match, err = s.source.FetchByID(ctx, id)
+	match, err = s.loadFromSource(ctx, logger, id)
 	if err != nil {
-		return models.Match{}, fmt.Errorf("%s: fetch match from source: %w", op, err)
+		return models.Match{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return match, nil
+}
+
+// GetMatches loads the stored matches with one database query, in the order of
+// ids. Ids missing from the database go to the source one by one under the
+// same guards as GetMatch. Ids that fail are returned in the map instead of
+// failing the whole call.
+func (s *MatchService) GetMatches(ctx context.Context, ids []models.MatchID) ([]models.Match, map[models.MatchID]error, error) {
+	const op = "service.GetMatches"
+
+	logger := s.log.With(
+		zap.String("op", op),
+		zap.Int("ids", len(ids)),
+	)
+
+	stored, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: get matches from repo: %w", op, err)
+	}
+	byID := make(map[models.MatchID]models.Match, len(stored))
+	for _, m := range stored {
+		byID[m.ID] = m
+	}
+
+	matches := make([]models.Match, 0, len(ids))
+	failed := make(map[models.MatchID]error)
+	for _, id := range ids {
+		if match, ok := byID[id]; ok {
+			matches = append(matches, match)
+			continue
+		}
+
+		match, err := s.loadFromSource(ctx, logger.With(zap.String("match_id", string(id))), id)
+		if err != nil {
+			if isContextErr(err) {
+				return nil, nil, fmt.Errorf("%s: %w", op, err)
+			}
+			failed[id] = fmt.Errorf("%s: %w", op, err)
+			continue
+		}
+		matches = append(matches, match)
+	}
+
+	s.attachVenues(ctx, matches)
+	s.attachCityNames(ctx, matches)
+	return matches, failed, nil
+}
+
+// loadFromSource fetches a match the database doesn't have yet and stores it.
+func (s *MatchService) loadFromSource(ctx context.Context, logger *zap.Logger, id models.MatchID) (models.Match, error) {
+	if err := s.allowSourceFetch(ctx, logger, id); err != nil {
+		return models.Match{}, err
+	}
+
+	match, err := s.source.FetchByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, derr.ErrMatchNotFound) {
+			s.rememberNotFound(ctx, logger, id)
+		}
+		return models.Match{}, fmt.Errorf("fetch match from source: %w", err)
 	}
 
 	if match.DestinationIATA == "" {
-		if err := s.enrichDestinationFromCityOrClub(ctx, &match); err != nil {
-			return models.Match{}, fmt.Errorf("%s: resolve destination iata: %w", op, err)
+		if err := s.enrichDestination(ctx, &match); err != nil {
+			if !errors.Is(err, derr.ErrCityIATANotFound) {
+				return models.Match{}, fmt.Errorf("resolve destination iata: %w", err)
+			}
+			s.reportUnresolvedCity(ctx, logger, match)
 		}
 	}
+	s.resolveStadium(ctx, logger, &match)
+	if err := s.overrideSourceMatch(ctx, logger, &match, time.Now().UTC()); err != nil {
+		return models.Match{}, err
+	}
 
-	if err := s.repo.Upsert(ctx, match); err != nil {
-		return models.Match{}, fmt.Errorf("%s: upsert match: %w", op, err)
+	stored, err := s.repo.Upsert(ctx, match)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("upsert match: %w", err)
 	}
+	match.KickoffRevision, match.HomeScore, match.AwayScore = stored.KickoffRevision, stored.HomeScore, stored.AwayScore
+	s.invalidateUpcoming(ctx, logger)
 
 	if s.cache != nil {
 		if err := s.cache.Set(ctx, match, s.cacheTTL); err != nil {
@@ -96,17 +188,167 @@ func (s *MatchService) GetMatch(ctx context.Context, id models.MatchID) (models.
 	return match, nil
 }
 
-func (s *MatchService) GetUpcomingMatches(ctx context.Context, limit int, clubID string) ([]models.Match, error) {
+// GetUpcomingMatches returns one page of matches for the filter and the cursor
+// of the next page, which is empty when there is nothing left.
+func (s *MatchService) GetUpcomingMatches(ctx context.Context, filter models.MatchFilter, cursor string) ([]models.Match, string, error) {
 	const op = "service.GetUpcomingMatches"
 
-	limit = normalizeUpcomingLimit(limit)
+	// a past from would list played matches, those are GetPastMatches
+	if now := time.Now().UTC(); filter.FromUTC.Before(now) {
+		filter.FromUTC = now
+	}
+	filter.Order = models.SortAsc
+
+	return s.listPage(ctx, op, filter, cursor)
+}
+
+// GetPastMatches returns already played matches, newest first.
+func (s *MatchService) GetPastMatches(ctx context.Context, filter models.MatchFilter, cursor string) ([]models.Match, string, error) {
+	const op = "service.GetPastMatches"
+
+	now := time.Now().UTC()
+	if filter.ToUTC.IsZero() || filter.ToUTC.After(now) {
+		filter.ToUTC = now
+	}
+	filter.Order = models.SortDesc
+
+	return s.listPage(ctx, op, filter, cursor)
+}
+
+// ListMatches returns matches in an arbitrary range without implicit time bounds.
+func (s *MatchService) ListMatches(ctx context.Context, filter models.MatchFilter, cursor string) ([]models.Match, string, error) {
+	const op = "service.ListMatches"
 
-	matches, err := s.repo.GetUpcoming(ctx, limit, clubID)
+	return s.listPage(ctx, op, filter, cursor)
+}
+
+func (s *MatchService) listPage(ctx context.Context, op string, filter models.MatchFilter, cursor string) ([]models.Match, string, error) {
+	after, err := decodeCursor(cursor)
 	if err != nil {
-		return nil, fmt.Errorf("%s: get upcoming matches from repo: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	return matches, nil
+	if filter.City != "" {
+		// stored cities are canonical dictionary names
+		if city, err := s.resolver.ResolveCity(ctx, filter.City); err == nil && city.Name != "" {
+			filter.City = city.Name
+		}
+	}
+
+	limit := normalizeUpcomingLimit(filter.Limit)
+	filter.After = after
+	// one extra row tells whether there is a next page
+	filter.Limit = limit + 1
+
+	matches, err := s.repo.ListMatches(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: list matches from repo: %w", op, err)
+	}
+
+	next := ""
+	if len(matches) > limit {
+		matches = matches[:limit]
+		last := matches[len(matches)-1]
+		next = encodeCursor(models.MatchCursor{KickoffUTC: last.KickoffUTC, ID: last.ID})
+	}
+	s.attachVenues(ctx, matches)
+	s.attachCityNames(ctx, matches)
+
+	return matches, next, nil
+}
+
+func (s *MatchService) GetStadium(ctx context.Context, id string) (models.Stadium, error) {
+	const op = "service.GetStadium"
+
+	id = strings.TrimSpace(id)
+	if s.stadiums == nil || id == "" {
+		return models.Stadium{}, derr.ErrStadiumNotFound
+	}
+
+	stadiums, err := s.stadiums.GetStadiums(ctx, []string{id})
+	if err != nil {
+		return models.Stadium{}, fmt.Errorf("%s: get stadium from repo: %w", op, err)
+	}
+
+	stadium, ok := stadiums[id]
+	if !ok {
+		return models.Stadium{}, derr.ErrStadiumNotFound
+	}
+
+	return stadium, nil
+}
+
+// resolveStadium maps the source stadium string to the dictionary. An unknown
+// stadium is not an error: the match is stored without venue details.
+func (s *MatchService) resolveStadium(ctx context.Context, logger *zap.Logger, match *models.Match) {
+	if s.stadiums == nil || strings.TrimSpace(match.Stadium) == "" {
+		return
+	}
+
+	stadiumID, err := s.stadiums.ResolveStadiumID(ctx, match.Stadium)
+	if err != nil {
+		if errors.Is(err, derr.ErrStadiumNotFound) {
+			logger.Info("stadium is not mapped", zap.String("match_id", string(match.ID)), zap.String("stadium", match.Stadium))
+		} else {
+			logger.Warn("failed to resolve stadium", zap.String("match_id", string(match.ID)), zap.Error(err))
+		}
+		return
+	}
+
+	match.StadiumID = stadiumID
+}
+
+// attachVenues fills Venue from the stadium dictionary. Matches are still
+// returned without it if the dictionary can't be read.
+func (s *MatchService) attachVenues(ctx context.Context, matches []models.Match) {
+	if s.stadiums == nil {
+		return
+	}
+
+	ids := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		if match.StadiumID == "" {
+			continue
+		}
+		if _, ok := seen[match.StadiumID]; ok {
+			continue
+		}
+		seen[match.StadiumID] = struct{}{}
+		ids = append(ids, match.StadiumID)
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	stadiums, err := s.stadiums.GetStadiums(ctx, ids)
+	if err != nil {
+		s.log.Warn("failed to load stadiums", zap.Strings("stadium_ids", ids), zap.Error(err))
+		return
+	}
+
+	for i := range matches {
+		if stadium, ok := stadiums[matches[i].StadiumID]; ok {
+			matches[i].Venue = &stadium
+		}
+	}
+}
+
+// attachCityNames fills CityEN of matches whose city is a dictionary name,
+// which is what enrichDestination stores for resolved cities.
+func (s *MatchService) attachCityNames(ctx context.Context, matches []models.Match) {
+	if s.resolver == nil {
+		return
+	}
+
+	for i := range matches {
+		if matches[i].City == "" {
+			continue
+		}
+		if city, ok := s.resolver.CityByName(ctx, matches[i].City); ok {
+			matches[i].CityEN = city.NameEN
+		}
+	}
 }
 
 func (s *MatchService) GetClubs(ctx context.Context) ([]models.Club, error) {
@@ -121,6 +363,22 @@ func (s *MatchService) GetClubs(ctx context.Context) ([]models.Club, error) {
 }
 
 func (s *MatchService) SyncUpcomingMatches(ctx context.Context, from time.Time, to time.Time, limit int) (int, error) {
+	start := time.Now()
+	saved, failed, err := s.syncUpcomingMatches(ctx, from, to, limit)
+
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	metrics.SyncRuns.WithLabelValues(result).Inc()
+	metrics.SyncDuration.Observe(time.Since(start).Seconds())
+	metrics.SyncMatches.WithLabelValues("saved").Add(float64(saved))
+	metrics.SyncMatches.WithLabelValues("failed").Add(float64(failed))
+
+	return saved, err
+}
+
+func (s *MatchService) syncUpcomingMatches(ctx context.Context, from time.Time, to time.Time, limit int) (int, int, error) {
 	const op = "service.SyncUpcomingMatches"
 
 	if from.IsZero() {
@@ -144,70 +402,52 @@ func (s *MatchService) SyncUpcomingMatches(ctx context.Context, from time.Time,
 
 	ids, err := s.source.FetchUpcomingIDs(ctx, from, to, limit)
 	if err != nil {
-		return 0, fmt.Errorf("%s: fetch upcoming ids: %w", op, err)
+		return 0, 0, fmt.Errorf("%s: fetch upcoming ids: %w", op, err)
 	}
 	if len(ids) == 0 {
 		logger.Info("no upcoming matches from source")
-		return 0, nil
+		return 0, 0, nil
 	}
 
 	var saved int
 	var failed int
 
+	// the whole batch is fetched first: placeholder kickoffs are only visible
+	// across the matches of a round
+	fetched := make([]models.Match, 0, len(ids))
 	for _, id := range ids {
 		match, err := s.source.FetchByID(ctx, id)
 		if err != nil {
 			if isContextErr(err) {
-				return saved, err
+				return saved, failed, err
 			}
 			failed++
 			logger.Warn("failed to fetch match by id", zap.String("match_id", string(id)), zap.Error(err))
 			continue
 		}
+		fetched = append(fetched, match)
+	}
 
-		if match.DestinationIATA == "" {
-			if err := s.enrichDestinationFromCityOrClub(ctx, &match); err != nil {
-				if isContextErr(err) {
-					return saved, err
-				}
-				failed++
-				logger.Warn(
-					"failed to resolve destination iata",
-					zap.String("match_id", string(id)),
-					zap.String("city", match.City),
-					zap.String("club_home_id", match.HomeTeam),
-					zap.Error(err),
-				)
-				continue
-			}
-		}
+	now := time.Now().UTC()
+	markPlaceholderSlots(fetched, now)
 
-		existing, err := s.repo.GetByID(ctx, match.ID)
-		if err == nil {
-			logMatchDiff(logger, existing, match)
-		} else if !errors.Is(err, derr.ErrMatchNotFound) {
-			logger.Warn(
-				"failed to load existing match before upsert",
-				zap.String("match_id", string(id)),
-				zap.Error(err),
-			)
+	// the cached upcoming lists are dropped once for the whole batch
+	defer func() {
+		if saved > 0 {
+			s.invalidateUpcoming(context.WithoutCancel(ctx), logger)
 		}
+	}()
 
-		if err := s.repo.Upsert(ctx, match); err != nil {
+	for _, match := range fetched {
+		if err := s.saveSourceMatch(ctx, logger, &match, now); err != nil {
 			if isContextErr(err) {
-				return saved, err
+				return saved, failed, err
 			}
 			failed++
-			logger.Warn("failed to upsert match", zap.String("match_id", string(id)), zap.Error(err))
+			logger.Warn("failed to save match", zap.String("match_id", string(match.ID)), zap.Error(err))
 			continue
 		}
 
-		if s.cache != nil {
-			if err := s.cache.Set(ctx, match, s.cacheTTL); err != nil {
-				logger.Warn("redis cache write failed during sync", zap.String("match_id", string(id)), zap.Error(err))
-			}
-		}
-
 		saved++
 	}
 
@@ -219,10 +459,94 @@ func (s *MatchService) SyncUpcomingMatches(ctx context.Context, from time.Time,
 	)
 
 	if saved == 0 && len(ids) > 0 {
-		return 0, fmt.Errorf("%s: no matches synced", op)
+		return 0, failed, fmt.Errorf("%s: no matches synced", op)
+	}
+
+	return saved, failed, nil
+}
+
+// RefreshMatch reloads one match from the source and stores it the same way
+// the upcoming sync does.
+func (s *MatchService) RefreshMatch(ctx context.Context, id models.MatchID) (models.Match, error) {
+	const op = "service.RefreshMatch"
+
+	logger := s.log.With(
+		zap.String("op", op),
+		zap.String("match_id", string(id)),
+	)
+
+	match, err := s.source.FetchByID(ctx, id)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("%s: fetch match from source: %w", op, err)
+	}
+
+	if err := s.saveSourceMatch(ctx, logger, &match, time.Now().UTC()); err != nil {
+		return models.Match{}, fmt.Errorf("%s: %w", op, err)
+	}
+	s.invalidateUpcoming(ctx, logger)
+
+	logger.Info("match refreshed from source")
+	return match, nil
+}
+
+// saveSourceMatch enriches a freshly fetched match, carries over what storage
+// knows about it, applies the overrides and writes it to the database and the
+// cache. Comparisons with the stored match use its source values.
+func (s *MatchService) saveSourceMatch(ctx context.Context, logger *zap.Logger, match *models.Match, now time.Time) error {
+	if match.DestinationIATA == "" {
+		if err := s.enrichDestination(ctx, match); err != nil {
+			if !errors.Is(err, derr.ErrCityIATANotFound) {
+				return fmt.Errorf("resolve destination iata: %w", err)
+			}
+			s.reportUnresolvedCity(ctx, logger, *match)
+		}
 	}
+	s.resolveStadium(ctx, logger, match)
 
-	return saved, nil
+	existing, err := s.repo.GetByID(ctx, match.ID)
+	if err == nil {
+		existing = existing.WithoutOverrides()
+		logMatchDiff(logger, existing, *match)
+		if keepKickoffTentative(existing, *match, now) {
+			match.KickoffTentative = true
+		}
+	} else if !errors.Is(err, derr.ErrMatchNotFound) {
+		logger.Warn(
+			"failed to load existing match before upsert",
+			zap.String("match_id", string(match.ID)),
+			zap.Error(err),
+		)
+	}
+
+	if err := s.overrideSourceMatch(ctx, logger, match, now); err != nil {
+		return err
+	}
+
+	stored, err := s.repo.Upsert(ctx, *match)
+	if err != nil {
+		return fmt.Errorf("upsert match: %w", err)
+	}
+	match.KickoffRevision, match.HomeScore, match.AwayScore = stored.KickoffRevision, stored.HomeScore, stored.AwayScore
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, *match, s.cacheTTL); err != nil {
+			logger.Warn("redis cache write failed", zap.String("match_id", string(match.ID)), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// invalidateUpcoming drops cached upcoming lists after writes. A failure only
+// leaves the lists stale until their TTL.
+func (s *MatchService) invalidateUpcoming(ctx context.Context, logger *zap.Logger) {
+	invalidator, ok := s.repo.(ports.UpcomingInvalidator)
+	if !ok {
+		return
+	}
+	if err := invalidator.InvalidateUpcoming(ctx); err != nil {
+		logger.Warn("failed to invalidate upcoming matches cache", zap.Error(err))
+	}
 }
 
 func normalizeUpcomingLimit(limit int) int {
@@ -239,59 +563,71 @@ func isContextErr(err error) bool {
 	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
 }
 
-func (s *MatchService) enrichDestinationFromCityOrClub(ctx context.Context, match *models.Match) error {
+// enrichDestination resolves the match city through the city dictionary. The home
+// club city is used only when the source has no city at all: for an unknown
+// named city it would send neutral venues to the wrong airport.
+func (s *MatchService) enrichDestination(ctx context.Context, match *models.Match) error {
 	city := strings.TrimSpace(match.City)
-	if city != "" {
-		iata, err := s.resolver.ResolveDestinationIATA(ctx, city)
-		if err == nil {
-			match.DestinationIATA = iata
-			return nil
-		}
-		if !errors.Is(err, derr.ErrCityIATANotFound) {
-			return err
-		}
+	if city == "" {
+		return s.enrichDestinationFromHomeClub(ctx, match)
 	}
 
-	fallback, ok := fallbackByHomeClubID[match.HomeTeam]
-	if !ok {
-		return derr.ErrCityIATANotFound
+	resolved, err := s.resolver.ResolveCity(ctx, city)
+	if err != nil {
+		return err
 	}
 
-	if city == "" {
-		match.City = fallback.city
+	if resolved.Name != "" {
+		match.City = resolved.Name
 	}
-	match.DestinationIATA = fallback.iata
+	match.DestinationIATA = resolved.IATA
 
 	return nil
 }
 
-type clubFallback struct {
-	city string
-	iata string
+func (s *MatchService) enrichDestinationFromHomeClub(ctx context.Context, match *models.Match) error {
+	if match.HomeTeam == "" {
+		return derr.ErrCityIATANotFound
+	}
+
+	clubs, err := s.repo.GetClubs(ctx)
+	if err != nil {
+		return fmt.Errorf("get clubs: %w", err)
+	}
+
+	for _, club := range clubs {
+		if club.ID != match.HomeTeam || club.AirportIATA == "" {
+			continue
+		}
+		match.City = club.City
+		match.DestinationIATA = club.AirportIATA
+		return nil
+	}
+
+	return derr.ErrCityIATANotFound
 }
 
-var fallbackByHomeClubID = map[string]clubFallback{
-	"1":   {city: "Москва", iata: "MOW"},          // Спартак Москва
-	"2":   {city: "Москва", iata: "MOW"},          // ПФК ЦСКА
-	"3":   {city: "Санкт-Петербург", iata: "LED"}, // Зенит
-	"4":   {city: "Казань", iata: "KZN"},          // Рубин
-	"5":   {city: "Москва", iata: "MOW"},          // Локомотив
-	"7":   {city: "Москва", iata: "MOW"},          // Динамо Москва
-	"10":  {city: "Самара", iata: "KUF"},          // Крылья Советов
-	"11":  {city: "Ростов-на-Дону", iata: "ROV"}, // Ростов
-	"125": {city: "Каспийск", iata: "MCX"},       // Динамо Махачкала
-	"444": {city: "Калининград", iata: "KGD"},    // Балтика
-	"504": {city: "Оренбург", iata: "REN"},       // Оренбург
-	"525": {city: "Сочи", iata: "AER"},           // Сочи
-	"584": {city: "Краснодар", iata: "KRR"},      // Краснодар
-	"702": {city: "Грозный", iata: "GRV"},        // Ахмат
-	"704": {city: "Нижний Новгород", iata: "GOJ"}, // Пари НН
-	"807": {city: "Самара", iata: "KUF"},         // Акрон
+// reportUnresolvedCity keeps the city for the diagnostic report. The match is
+// still saved, only without a destination airport.
+func (s *MatchService) reportUnresolvedCity(ctx context.Context, logger *zap.Logger, match models.Match) {
+	logger.Warn(
+		"destination iata not resolved, saving match without it",
+		zap.String("match_id", string(match.ID)),
+		zap.String("city", match.City),
+		zap.String("club_home_id", match.HomeTeam),
+	)
+
+	if err := s.resolver.ReportUnresolved(ctx, match.City, match.ID); err != nil {
+		logger.Warn("failed to record unresolved city", zap.String("city", match.City), zap.Error(err))
+	}
 }
 
 func logMatchDiff(logger *zap.Logger, oldMatch models.Match, newMatch models.Match) {
 	diffFields := make([]string, 0, 8)
 
+	if oldMatch.Competition != newMatch.Competition {
+		diffFields = append(diffFields, "competition")
+	}
 	if oldMatch.HomeTeam != newMatch.HomeTeam {
 		diffFields = append(diffFields, "club_home_id")
 	}
@@ -301,18 +637,24 @@ func logMatchDiff(logger *zap.Logger, oldMatch models.Match, newMatch models.Mat
 	if oldMatch.City != newMatch.City {
 		diffFields = append(diffFields, "city")
 	}
-	if oldMatch.Stadium != newMatch.Stadium {
+	if oldMatch.Stadium != newMatch.Stadium || oldMatch.StadiumID != newMatch.StadiumID {
 		diffFields = append(diffFields, "stadium")
 	}
 	if oldMatch.KickoffUTC.UTC() != newMatch.KickoffUTC.UTC() {
 		diffFields = append(diffFields, "kickoff_utc")
 	}
+	if oldMatch.KickoffTentative != newMatch.KickoffTentative {
+		diffFields = append(diffFields, "kickoff_tentative")
+	}
 	if oldMatch.DestinationIATA != newMatch.DestinationIATA {
 		diffFields = append(diffFields, "destination_iata")
 	}
 	if oldMatch.TicketsLink != newMatch.TicketsLink {
 		diffFields = append(diffFields, "tickets_link")
 	}
+	if !equalScore(oldMatch.HomeScore, newMatch.HomeScore) || !equalScore(oldMatch.AwayScore, newMatch.AwayScore) {
+		diffFields = append(diffFields, "score")
+	}
 
 	if len(diffFields) == 0 {
 		return
@@ -330,3 +672,10 @@ func logMatchDiff(logger *zap.Logger, oldMatch models.Match, newMatch models.Mat
 		zap.String("new_away_club_id", newMatch.AwayTeam),
 	)
 }
+
+func equalScore(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -39,14 +39,59 @@ func (m *sourceMock) FetchUpcomingIDs(_ context.Context, _ time.Time, _ time.Tim
 }
 
 type resolverMock struct {
-	iata  string
-	err   error
-	calls int
+	name     string
+	nameEN   string
+	iata     string
+	err      error
+	calls    int
+	reported []string
 }
 
-func (m *resolverMock) ResolveDestinationIATA(_ context.Context, _ string) (string, error) {
+func (m *resolverMock) ResolveCity(_ context.Context, _ string) (models.City, error) {
 	m.calls++
-	return m.iata, m.err
+	return models.City{Name: m.name, IATA: m.iata}, m.err
+}
+
+func (m *resolverMock) CityByName(_ context.Context, name string) (models.City, bool) {
+	if m.err != nil || name != m.name {
+		return models.City{}, false
+	}
+	return models.City{Name: m.name, NameEN: m.nameEN, IATA: m.iata}, true
+}
+
+func (m *resolverMock) ReportUnresolved(_ context.Context, name string, _ models.MatchID) error {
+	m.reported = append(m.reported, name)
+	return nil
+}
+
+type stadiumMock struct {
+	ids          map[string]string
+	stadiums     map[string]models.Stadium
+	err          error
+	resolveCalls int
+	getCalls     int
+}
+
+func (m *stadiumMock) ResolveStadiumID(_ context.Context, name string) (string, error) {
+	m.resolveCalls++
+	if id, ok := m.ids[name]; ok {
+		return id, nil
+	}
+	return "", derr.ErrStadiumNotFound
+}
+
+func (m *stadiumMock) GetStadiums(_ context.Context, ids []string) (map[string]models.Stadium, error) {
+	m.getCalls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	out := make(map[string]models.Stadium, len(ids))
+	for _, id := range ids {
+		if stadium, ok := m.stadiums[id]; ok {
+			out[id] = stadium
+		}
+	}
+	return out, nil
 }
 
 type repoMock struct {
@@ -62,6 +107,11 @@ type repoMock struct {
 	upcomingCalls int
 	clubsCalls    int
 	upsertCalls   int
+	getManyCalls  int
+	invalidations int
+
+	stored         map[models.MatchID]models.Match
+	upcomingFilter models.MatchFilter
 }
 
 func (m *repoMock) GetByID(_ context.Context, _ models.MatchID) (models.Match, error) {
@@ -69,14 +119,44 @@ func (m *repoMock) GetByID(_ context.Context, _ models.MatchID) (models.Match, e
 	return m.getMatch, m.getErr
 }
 
-func (m *repoMock) Upsert(_ context.Context, match models.Match) error {
+func (m *repoMock) GetByIDs(_ context.Context, ids []models.MatchID) ([]models.Match, error) {
+	m.getManyCalls++
+	if m.getErr != nil && !errors.Is(m.getErr, derr.ErrMatchNotFound) {
+		return nil, m.getErr
+	}
+	var out []models.Match
+	for _, id := range ids {
+		if match, ok := m.stored[id]; ok {
+			out = append(out, match)
+		}
+	}
+	return out, nil
+}
+
+// Upsert bumps the kickoff revision of getMatch like storage does.
+func (m *repoMock) Upsert(_ context.Context, match models.Match) (models.Match, error) {
 	m.upsertCalls++
 	m.upserted = append(m.upserted, match)
-	return m.upsertErr
+	if m.upsertErr != nil {
+		return models.Match{}, m.upsertErr
+	}
+	if m.getErr == nil && m.getMatch.ID == match.ID {
+		match.KickoffRevision = m.getMatch.KickoffRevision
+		if !m.getMatch.KickoffUTC.Equal(match.KickoffUTC) {
+			match.KickoffRevision++
+		}
+	}
+	return match, nil
 }
 
-func (m *repoMock) GetUpcoming(_ context.Context, _ int, _ string) ([]models.Match, error) {
+func (m *repoMock) InvalidateUpcoming(_ context.Context) error {
+	m.invalidations++
+	return nil
+}
+
+func (m *repoMock) ListMatches(_ context.Context, filter models.MatchFilter) ([]models.Match, error) {
 	m.upcomingCalls++
+	m.upcomingFilter = filter
 	return m.upcoming, m.upcomingErr
 }
 
@@ -93,6 +173,9 @@ type cacheMock struct {
 	getCalls int
 	setCalls int
 	lastTTL  time.Duration
+
+	notFound    map[models.MatchID]time.Duration
+	notFoundErr error
 }
 
 func (m *cacheMock) GetByID(_ context.Context, _ models.MatchID) (models.Match, error) {
@@ -107,6 +190,22 @@ func (m *cacheMock) Set(_ context.Context, match models.Match, ttl time.Duration
 	return m.setErr
 }
 
+func (m *cacheMock) SetNotFound(_ context.Context, id models.MatchID, ttl time.Duration) error {
+	if m.notFound == nil {
+		m.notFound = make(map[models.MatchID]time.Duration)
+	}
+	m.notFound[id] = ttl
+	return nil
+}
+
+func (m *cacheMock) IsNotFound(_ context.Context, id models.MatchID) (bool, error) {
+	if m.notFoundErr != nil {
+		return false, m.notFoundErr
+	}
+	_, ok := m.notFound[id]
+	return ok, nil
+}
+
 func TestGetMatch_CacheHit(t *testing.T) {
 	cached := models.Match{ID: "100", City: "Moscow"}
 	cache := &cacheMock{getMatch: cached}
@@ -114,7 +213,7 @@ func TestGetMatch_CacheHit(t *testing.T) {
 	source := &sourceMock{}
 	resolver := &resolverMock{}
 
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 30*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 30*time.Minute, SourceFallbackPolicy{})
 	got, err := svc.GetMatch(context.Background(), "100")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -138,7 +237,7 @@ func TestGetMatch_CacheMissRepoHit(t *testing.T) {
 	resolver := &resolverMock{}
 
 	ttl := 15 * time.Minute
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, ttl)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, ttl, SourceFallbackPolicy{})
 	got, err := svc.GetMatch(context.Background(), "200")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -163,7 +262,7 @@ func TestGetMatch_SourceAndResolverFail(t *testing.T) {
 	source := &sourceMock{match: models.Match{ID: "300", City: "Unknown"}}
 	resolver := &resolverMock{err: errors.New("resolve fail")}
 
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 10*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 10*time.Minute, SourceFallbackPolicy{})
 	_, err := svc.GetMatch(context.Background(), "300")
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -182,7 +281,7 @@ func TestGetMatch_SourceFail(t *testing.T) {
 	source := &sourceMock{err: derr.ErrSourceUnavailable}
 	resolver := &resolverMock{}
 
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 10*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 10*time.Minute, SourceFallbackPolicy{})
 	_, err := svc.GetMatch(context.Background(), "400")
 	if !errors.Is(err, derr.ErrSourceUnavailable) {
 		t.Fatalf("expected source unavailable error, got %v", err)
@@ -192,6 +291,59 @@ func TestGetMatch_SourceFail(t *testing.T) {
 	}
 }
 
+func TestGetMatch_AttachesVenue(t *testing.T) {
+	cache := &cacheMock{getMatch: models.Match{ID: "100", StadiumID: "rostec-arena"}}
+	stadiums := &stadiumMock{stadiums: map[string]models.Stadium{
+		"rostec-arena": {ID: "rostec-arena", Timezone: "Europe/Kaliningrad"},
+	}}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, stadiums, nil, &repoMock{}, cache, 30*time.Minute, SourceFallbackPolicy{})
+	got, err := svc.GetMatch(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Venue == nil || got.Venue.Timezone != "Europe/Kaliningrad" {
+		t.Fatalf("expected Kaliningrad venue, got %+v", got.Venue)
+	}
+}
+
+func TestGetMatch_AttachesEnglishCityName(t *testing.T) {
+	cache := &cacheMock{getMatch: models.Match{ID: "100", City: "Калининград"}}
+	resolver := &resolverMock{name: "Калининград", nameEN: "Kaliningrad", iata: "KGD"}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, resolver, nil, nil, &repoMock{}, cache, 30*time.Minute, SourceFallbackPolicy{})
+	got, err := svc.GetMatch(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.CityEN != "Kaliningrad" {
+		t.Fatalf("expected Kaliningrad, got %q", got.CityEN)
+	}
+}
+
+func TestGetMatch_VenueLookupFailureIsNotFatal(t *testing.T) {
+	cache := &cacheMock{getMatch: models.Match{ID: "100", StadiumID: "rostec-arena"}}
+	stadiums := &stadiumMock{err: errors.New("db down")}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, stadiums, nil, &repoMock{}, cache, 30*time.Minute, SourceFallbackPolicy{})
+	got, err := svc.GetMatch(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Venue != nil {
+		t.Fatalf("expected no venue, got %+v", got.Venue)
+	}
+}
+
+func TestGetStadium_NotFound(t *testing.T) {
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, &stadiumMock{}, nil, &repoMock{}, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	_, err := svc.GetStadium(context.Background(), "unknown")
+	if !errors.Is(err, derr.ErrStadiumNotFound) {
+		t.Fatalf("expected ErrStadiumNotFound, got %v", err)
+	}
+}
+
 func TestGetUpcomingMatches_DefaultLimit(t *testing.T) {
 	repo := &repoMock{
 		upcoming: []models.Match{
@@ -199,20 +351,129 @@ func TestGetUpcomingMatches_DefaultLimit(t *testing.T) {
 			{ID: "2", City: "Kazan"},
 		},
 	}
-	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, repo, &cacheMock{}, 10*time.Minute)
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
 
-	got, err := svc.GetUpcomingMatches(context.Background(), 0, "")
+	got, next, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{}, "")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 	if len(got) != 2 {
 		t.Fatalf("expected 2 matches, got %d", len(got))
 	}
+	if next != "" {
+		t.Fatalf("expected empty next cursor, got %q", next)
+	}
+	if repo.upcomingFilter.Limit != defaultUpcomingLimit+1 {
+		t.Fatalf("expected repo limit %d, got %d", defaultUpcomingLimit+1, repo.upcomingFilter.Limit)
+	}
+	if repo.upcomingFilter.FromUTC.IsZero() || repo.upcomingFilter.Order != models.SortAsc {
+		t.Fatalf("expected ascending filter from now, got %+v", repo.upcomingFilter)
+	}
 	if repo.upcomingCalls != 1 {
 		t.Fatalf("expected 1 repo call, got %d", repo.upcomingCalls)
 	}
 }
 
+func TestGetUpcomingMatches_PassesFilter(t *testing.T) {
+	repo := &repoMock{}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	filter := models.MatchFilter{
+		Limit:       5,
+		Competition: models.CompetitionRussianCup,
+		City:        "Kazan",
+		ClubIDs:     []string{"4"},
+		ClubSide:    models.ClubSideAway,
+	}
+	if _, _, err := svc.GetUpcomingMatches(context.Background(), filter, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := repo.upcomingFilter
+	if got.Competition != models.CompetitionRussianCup || got.City != "Kazan" || got.ClubSide != models.ClubSideAway || len(got.ClubIDs) != 1 {
+		t.Fatalf("unexpected filter passed to repo: %+v", got)
+	}
+}
+
+func TestGetUpcomingMatches_ClampsFromToNow(t *testing.T) {
+	repo := &repoMock{}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	before := time.Now().UTC()
+	if _, _, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{FromUTC: before.AddDate(0, -1, 0)}, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.upcomingFilter.FromUTC.Before(before) {
+		t.Fatalf("expected from to be clamped to now, got %v", repo.upcomingFilter.FromUTC)
+	}
+
+	later := before.AddDate(0, 1, 0)
+	if _, _, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{FromUTC: later}, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !repo.upcomingFilter.FromUTC.Equal(later) {
+		t.Fatalf("expected future from to be kept, got %v", repo.upcomingFilter.FromUTC)
+	}
+}
+
+func TestGetUpcomingMatches_CursorPagination(t *testing.T) {
+	kickoff := time.Date(2026, 3, 7, 16, 0, 0, 0, time.UTC)
+	repo := &repoMock{
+		upcoming: []models.Match{
+			{ID: "1", KickoffUTC: kickoff},
+			{ID: "2", KickoffUTC: kickoff},
+			{ID: "3", KickoffUTC: kickoff.Add(time.Hour)},
+		},
+	}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	got, next, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{Limit: 2}, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 2 || next == "" {
+		t.Fatalf("expected 2 matches and next cursor, got %d matches, cursor %q", len(got), next)
+	}
+
+	repo.upcoming = repo.upcoming[2:]
+	if _, _, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{Limit: 2}, next); err != nil {
+		t.Fatalf("expected no error on second page, got %v", err)
+	}
+	after := repo.upcomingFilter.After
+	if after == nil || after.ID != "2" || !after.KickoffUTC.Equal(kickoff) {
+		t.Fatalf("expected cursor after match 2, got %+v", after)
+	}
+}
+
+func TestGetUpcomingMatches_InvalidCursor(t *testing.T) {
+	repo := &repoMock{}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	_, _, err := svc.GetUpcomingMatches(context.Background(), models.MatchFilter{}, "not a cursor")
+	if !errors.Is(err, derr.ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+	if repo.upcomingCalls != 0 {
+		t.Fatalf("expected no repo calls, got %d", repo.upcomingCalls)
+	}
+}
+
+func TestGetPastMatches_NewestFirstUntilNow(t *testing.T) {
+	repo := &repoMock{}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
+
+	filter := models.MatchFilter{ToUTC: time.Now().Add(24 * time.Hour), ClubIDs: []string{"3"}}
+	if _, _, err := svc.GetPastMatches(context.Background(), filter, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := repo.upcomingFilter
+	if got.Order != models.SortDesc {
+		t.Fatalf("expected descending order, got %v", got.Order)
+	}
+	if got.ToUTC.After(time.Now()) {
+		t.Fatalf("expected upper bound clamped to now, got %s", got.ToUTC)
+	}
+}
+
 func TestGetClubs(t *testing.T) {
 	repo := &repoMock{
 		clubs: []models.Club{
@@ -221,7 +482,7 @@ func TestGetClubs(t *testing.T) {
 		},
 	}
 
-	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, repo, &cacheMock{}, 10*time.Minute)
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, &cacheMock{}, 10*time.Minute, SourceFallbackPolicy{})
 
 	got, err := svc.GetClubs(context.Background())
 	if err != nil {
@@ -253,7 +514,7 @@ func TestSyncUpcomingMatches_Success(t *testing.T) {
 	resolver := &resolverMock{iata: "LED"}
 	repo := &repoMock{}
 	cache := &cacheMock{}
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 30*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 30*time.Minute, SourceFallbackPolicy{})
 
 	count, err := svc.SyncUpcomingMatches(
 		context.Background(),
@@ -287,6 +548,126 @@ func TestSyncUpcomingMatches_Success(t *testing.T) {
 	}
 }
 
+func TestSyncUpcomingMatches_InvalidatesUpcomingOnce(t *testing.T) {
+	kickoff := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	source := &sourceMock{matchByID: map[models.MatchID]models.Match{}}
+	for i, id := range []models.MatchID{"1", "2", "3"} {
+		source.upcomingIDs = append(source.upcomingIDs, id)
+		source.matchByID[id] = models.Match{ID: id, DestinationIATA: "LED", KickoffUTC: kickoff.Add(time.Duration(i) * time.Hour)}
+	}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), kickoff.Add(-time.Hour), kickoff.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.upsertCalls != 3 || repo.invalidations != 1 {
+		t.Fatalf("expected 3 upserts and one invalidation, got %d and %d", repo.upsertCalls, repo.invalidations)
+	}
+}
+
+func TestSyncUpcomingMatches_CachesStoredKickoffRevision(t *testing.T) {
+	oldKickoff := time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC)
+	newKickoff := oldKickoff.Add(24 * time.Hour)
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"16114"},
+		matchByID: map[models.MatchID]models.Match{
+			"16114": {ID: "16114", DestinationIATA: "LED", KickoffUTC: newKickoff},
+		},
+	}
+	repo := &repoMock{
+		getMatch: models.Match{ID: "16114", DestinationIATA: "LED", KickoffUTC: oldKickoff, KickoffRevision: 2},
+	}
+	cache := &cacheMock{}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, cache, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), oldKickoff.Add(-time.Hour), newKickoff.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(cache.setItems) != 1 || cache.setItems[0].KickoffRevision != 3 {
+		t.Fatalf("expected cached match with the stored kickoff revision 3, got %+v", cache.setItems)
+	}
+}
+
+func TestSyncUpcomingMatches_UnconfirmsSharedRoundSlot(t *testing.T) {
+	slot := time.Now().UTC().Add(60 * 24 * time.Hour).Truncate(time.Hour)
+	source := &sourceMock{matchByID: map[models.MatchID]models.Match{}}
+	for _, id := range []models.MatchID{"1", "2", "3", "4"} {
+		source.upcomingIDs = append(source.upcomingIDs, id)
+		source.matchByID[id] = models.Match{ID: id, DestinationIATA: "LED", KickoffUTC: slot}
+	}
+	source.upcomingIDs = append(source.upcomingIDs, "5")
+	source.matchByID["5"] = models.Match{ID: "5", DestinationIATA: "LED", KickoffUTC: slot.Add(2 * time.Hour)}
+
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), slot.Add(-time.Hour), slot.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, m := range repo.upserted {
+		want := m.ID != "5"
+		if m.KickoffTentative != want {
+			t.Fatalf("match %s: kickoff tentative %v, want %v", m.ID, m.KickoffTentative, want)
+		}
+	}
+}
+
+func TestSyncUpcomingMatches_KeepsPlaceholderUntilMoved(t *testing.T) {
+	slot := time.Now().UTC().Add(60 * 24 * time.Hour).Truncate(time.Hour)
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"16114"},
+		matchByID: map[models.MatchID]models.Match{
+			"16114": {ID: "16114", DestinationIATA: "LED", KickoffUTC: slot},
+		},
+	}
+	repo := &repoMock{getMatch: models.Match{ID: "16114", DestinationIATA: "LED", KickoffUTC: slot, KickoffTentative: true}}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), slot.Add(-time.Hour), slot.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.upserted) != 1 || !repo.upserted[0].KickoffTentative {
+		t.Fatalf("expected placeholder kickoff to stay unconfirmed, got %+v", repo.upserted)
+	}
+
+	moved := slot.Add(3 * time.Hour)
+	source.matchByID["16114"] = models.Match{ID: "16114", DestinationIATA: "LED", KickoffUTC: moved}
+	repo.upserted = nil
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), slot.Add(-time.Hour), moved.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.upserted) != 1 || repo.upserted[0].KickoffTentative {
+		t.Fatalf("expected moved kickoff to be confirmed, got %+v", repo.upserted)
+	}
+}
+
+func TestSyncUpcomingMatches_ResolvesStadium(t *testing.T) {
+	kickoff := time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC)
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"1", "2"},
+		matchByID: map[models.MatchID]models.Match{
+			"1": {ID: "1", DestinationIATA: "LED", Stadium: "«Газпром Арена»", KickoffUTC: kickoff},
+			"2": {ID: "2", DestinationIATA: "KZN", Stadium: "Unknown Field", KickoffUTC: kickoff},
+		},
+	}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	stadiums := &stadiumMock{ids: map[string]string{"«Газпром Арена»": "gazprom-arena"}}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, stadiums, nil, repo, &cacheMock{}, 30*time.Minute, SourceFallbackPolicy{})
+
+	count, err := svc.SyncUpcomingMatches(context.Background(), kickoff.Add(-time.Hour), kickoff.Add(time.Hour), 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected unmapped stadium not to fail sync, got count %d", count)
+	}
+	if repo.upserted[0].StadiumID != "gazprom-arena" || repo.upserted[1].StadiumID != "" {
+		t.Fatalf("unexpected stadium ids: %q, %q", repo.upserted[0].StadiumID, repo.upserted[1].StadiumID)
+	}
+}
+
 func TestSyncUpcomingMatches_PartialFailure(t *testing.T) {
 	kickoff := time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC)
 	source := &sourceMock{
@@ -306,7 +687,7 @@ func TestSyncUpcomingMatches_PartialFailure(t *testing.T) {
 	}
 	resolver := &resolverMock{iata: "LED"}
 	repo := &repoMock{}
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, &cacheMock{}, 15*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, &cacheMock{}, 15*time.Minute, SourceFallbackPolicy{})
 
 	count, err := svc.SyncUpcomingMatches(
 		context.Background(),
@@ -336,7 +717,7 @@ func TestSyncUpcomingMatches_AllFailed(t *testing.T) {
 		},
 	}
 	repo := &repoMock{}
-	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, repo, &cacheMock{}, 15*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, &cacheMock{}, 15*time.Minute, SourceFallbackPolicy{})
 
 	count, err := svc.SyncUpcomingMatches(context.Background(), time.Now(), time.Now().Add(24*time.Hour), 10)
 	if err == nil {
@@ -366,9 +747,9 @@ func TestSyncUpcomingMatches_UsesHomeClubFallbackWhenCityMissing(t *testing.T) {
 		},
 	}
 	resolver := &resolverMock{err: derr.ErrCityIATANotFound}
-	repo := &repoMock{}
+	repo := &repoMock{clubs: []models.Club{{ID: "504", City: "Оренбург", AirportIATA: "REN"}}}
 	cache := &cacheMock{}
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 15*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 15*time.Minute, SourceFallbackPolicy{})
 
 	count, err := svc.SyncUpcomingMatches(
 		context.Background(),
@@ -398,7 +779,7 @@ func TestSyncUpcomingMatches_UsesHomeClubFallbackWhenCityMissing(t *testing.T) {
 
 func TestGetMatch_UsesHomeClubFallbackWhenCityMissing(t *testing.T) {
 	cache := &cacheMock{getErr: derr.ErrMatchNotFound}
-	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound, clubs: []models.Club{{ID: "525", City: "Сочи", AirportIATA: "AER"}}}
 	source := &sourceMock{
 		match: models.Match{
 			ID:          "17000",
@@ -412,7 +793,7 @@ func TestGetMatch_UsesHomeClubFallbackWhenCityMissing(t *testing.T) {
 	}
 	resolver := &resolverMock{err: derr.ErrCityIATANotFound}
 
-	svc := NewMatchService(zap.NewNop(), source, resolver, repo, cache, 10*time.Minute)
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, cache, 10*time.Minute, SourceFallbackPolicy{})
 	got, err := svc.GetMatch(context.Background(), "17000")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -430,3 +811,132 @@ func TestGetMatch_UsesHomeClubFallbackWhenCityMissing(t *testing.T) {
 		t.Fatalf("expected upsert to be called once, got %d", repo.upsertCalls)
 	}
 }
+
+func TestSyncUpcomingMatches_SavesMatchWithUnresolvedCity(t *testing.T) {
+	kickoff := time.Date(2026, 5, 24, 16, 0, 0, 0, time.UTC)
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"18000"},
+		matchByID: map[models.MatchID]models.Match{
+			"18000": {ID: "18000", HomeTeam: "3", AwayTeam: "1", City: "Новогорск", KickoffUTC: kickoff},
+		},
+	}
+	resolver := &resolverMock{err: derr.ErrCityIATANotFound}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound, clubs: []models.Club{{ID: "3", City: "Санкт-Петербург", AirportIATA: "LED"}}}
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, &cacheMock{}, 15*time.Minute, SourceFallbackPolicy{})
+
+	count, err := svc.SyncUpcomingMatches(context.Background(), kickoff.Add(-time.Hour), kickoff.Add(time.Hour), 10)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 1 || len(repo.upserted) != 1 {
+		t.Fatalf("expected match saved, got count %d, upserted %d", count, len(repo.upserted))
+	}
+	if got := repo.upserted[0]; got.DestinationIATA != "" || got.City != "Новогорск" {
+		t.Fatalf("expected neutral venue kept without home club airport, got %q/%q", got.City, got.DestinationIATA)
+	}
+	if len(resolver.reported) != 1 || resolver.reported[0] != "Новогорск" {
+		t.Fatalf("expected unresolved city to be reported, got %v", resolver.reported)
+	}
+}
+
+func TestSyncUpcomingMatches_UsesCanonicalCityName(t *testing.T) {
+	kickoff := time.Date(2026, 5, 24, 16, 0, 0, 0, time.UTC)
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"18001"},
+		matchByID: map[models.MatchID]models.Match{
+			"18001": {ID: "18001", City: "г. Санкт Петербург", KickoffUTC: kickoff},
+		},
+	}
+	resolver := &resolverMock{name: "Санкт-Петербург", iata: "LED"}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, nil, repo, &cacheMock{}, 15*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.SyncUpcomingMatches(context.Background(), kickoff.Add(-time.Hour), kickoff.Add(time.Hour), 10); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := repo.upserted[0]; got.City != "Санкт-Петербург" || got.DestinationIATA != "LED" {
+		t.Fatalf("expected canonical city and LED, got %q/%q", got.City, got.DestinationIATA)
+	}
+}
+
+func TestRefreshMatch_SavesSourceVersion(t *testing.T) {
+	oldKickoff := time.Date(2026, 2, 27, 19, 30, 0, 0, time.UTC)
+	newKickoff := oldKickoff.Add(2 * time.Hour)
+	source := &sourceMock{match: models.Match{ID: "16114", City: "Saint Petersburg", KickoffUTC: newKickoff}}
+	repo := &repoMock{getMatch: models.Match{ID: "16114", DestinationIATA: "LED", KickoffUTC: oldKickoff, KickoffRevision: 1}}
+	cache := &cacheMock{}
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{iata: "LED"}, nil, nil, repo, cache, 30*time.Minute, SourceFallbackPolicy{})
+
+	got, err := svc.RefreshMatch(context.Background(), "16114")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.DestinationIATA != "LED" || got.KickoffRevision != 2 {
+		t.Fatalf("unexpected refreshed match: %+v", got)
+	}
+	if len(repo.upserted) != 1 || cache.setCalls != 1 {
+		t.Fatalf("expected match to be stored and cached, got %d upserts and %d cache writes", len(repo.upserted), cache.setCalls)
+	}
+}
+
+func TestRefreshMatch_SourceFail(t *testing.T) {
+	repo := &repoMock{}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{err: derr.ErrMatchNotFound}, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, err := svc.RefreshMatch(context.Background(), "16114"); !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+	if repo.upsertCalls != 0 {
+		t.Fatalf("expected no upsert, got %d", repo.upsertCalls)
+	}
+}
+
+func TestGetMatches_StoredFirstThenSource(t *testing.T) {
+	repo := &repoMock{
+		getErr: derr.ErrMatchNotFound,
+		stored: map[models.MatchID]models.Match{
+			"1": {ID: "1", City: "Kazan", DestinationIATA: "KZN"},
+			"3": {ID: "3", City: "Sochi", DestinationIATA: "AER"},
+		},
+	}
+	source := &sourceMock{
+		matchByID: map[models.MatchID]models.Match{
+			"2": {ID: "2", City: "Moscow", DestinationIATA: "MOW"},
+		},
+		errByID: map[models.MatchID]error{
+			"4": derr.ErrMatchNotFound,
+			"5": derr.ErrSourceUnavailable,
+		},
+	}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	matches, failed, err := svc.GetMatches(context.Background(), []models.MatchID{"3", "4", "2", "1", "5"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.getManyCalls != 1 || repo.getCalls != 0 {
+		t.Fatalf("expected one batch query, got %d batch and %d single", repo.getManyCalls, repo.getCalls)
+	}
+	if len(matches) != 3 || matches[0].ID != "3" || matches[1].ID != "2" || matches[2].ID != "1" {
+		t.Fatalf("expected matches in request order [3 2 1], got %+v", matches)
+	}
+	if source.calls != 3 {
+		t.Fatalf("expected source called for missing ids only, got %d", source.calls)
+	}
+	if len(repo.upserted) != 1 || repo.upserted[0].ID != "2" {
+		t.Fatalf("expected fetched match to be stored, got %+v", repo.upserted)
+	}
+	if !errors.Is(failed["4"], derr.ErrMatchNotFound) || !errors.Is(failed["5"], derr.ErrSourceUnavailable) || len(failed) != 2 {
+		t.Fatalf("unexpected per-id errors: %v", failed)
+	}
+}
+
+func TestGetMatches_RepoError(t *testing.T) {
+	repo := &repoMock{getErr: errors.New("db down")}
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, SourceFallbackPolicy{})
+
+	if _, _, err := svc.GetMatches(context.Background(), []models.MatchID{"1"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+// SetMatchOverride stores a manual correction and writes it into the stored
+// match right away instead of waiting for the next sync.
+func (s *MatchService) SetMatchOverride(ctx context.Context, override models.MatchOverride) (models.MatchOverride, models.Match, error) {
+	const op = "service.SetMatchOverride"
+
+	if s.overrides == nil {
+		return models.MatchOverride{}, models.Match{}, fmt.Errorf("%s: overrides are not configured", op)
+	}
+
+	now := time.Now().UTC()
+	override, err := normalizeOverride(override, now)
+	if err != nil {
+		return models.MatchOverride{}, models.Match{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger := s.log.With(
+		zap.String("op", op),
+		zap.String("match_id", string(override.MatchID)),
+		zap.String("field", string(override.Field)),
+	)
+
+	if _, err := s.repo.GetByID(ctx, override.MatchID); err != nil {
+		return models.MatchOverride{}, models.Match{}, fmt.Errorf("%s: get match from repo: %w", op, err)
+	}
+
+	saved, err := s.overrides.UpsertMatchOverride(ctx, override)
+	if err != nil {
+		return models.MatchOverride{}, models.Match{}, fmt.Errorf("%s: upsert override: %w", op, err)
+	}
+
+	match, err := s.reapplyOverrides(ctx, logger, override.MatchID, now)
+	if err != nil {
+		return models.MatchOverride{}, models.Match{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info(
+		"match override set",
+		zap.String("value", saved.Value),
+		zap.String("author", saved.Author),
+		zap.String("reason", saved.Reason),
+		zap.Time("expires_at", saved.ExpiresAt),
+	)
+	return saved, match, nil
+}
+
+// DeleteMatchOverride removes a correction and restores the stored source
+// value of the field.
+func (s *MatchService) DeleteMatchOverride(ctx context.Context, id models.MatchID, field models.OverrideField) (models.Match, error) {
+	const op = "service.DeleteMatchOverride"
+
+	if s.overrides == nil {
+		return models.Match{}, fmt.Errorf("%s: overrides are not configured", op)
+	}
+
+	logger := s.log.With(
+		zap.String("op", op),
+		zap.String("match_id", string(id)),
+		zap.String("field", string(field)),
+	)
+
+	if err := s.overrides.DeleteMatchOverride(ctx, id, field); err != nil {
+		return models.Match{}, fmt.Errorf("%s: delete override: %w", op, err)
+	}
+	logger.Info("match override deleted")
+
+	match, err := s.reapplyOverrides(ctx, logger, id, time.Now().UTC())
+	if err != nil {
+		return models.Match{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return match, nil
+}
+
+// RevertExpiredOverrides rewrites the matches whose overrides expired in
+// (since, now] and returns how many of them were rewritten.
+func (s *MatchService) RevertExpiredOverrides(ctx context.Context, since time.Time, now time.Time) (int, error) {
+	const op = "service.RevertExpiredOverrides"
+
+	if s.overrides == nil {
+		return 0, nil
+	}
+
+	logger := s.log.With(zap.String("op", op))
+
+	overrides, err := s.overrides.ListMatchOverrides(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: list overrides: %w", op, err)
+	}
+
+	var ids []models.MatchID
+	for _, o := range overrides {
+		if o.ExpiresAt.IsZero() || !o.ExpiresAt.After(since) || o.ExpiresAt.After(now) {
+			continue
+		}
+		if !slices.Contains(ids, o.MatchID) {
+			ids = append(ids, o.MatchID)
+		}
+	}
+
+	reverted := 0
+	var failed error
+	for _, id := range ids {
+		if _, err := s.reapplyOverrides(ctx, logger.With(zap.String("match_id", string(id))), id, now); err != nil {
+			if isContextErr(err) {
+				return reverted, fmt.Errorf("%s: %w", op, err)
+			}
+			if errors.Is(err, derr.ErrMatchNotFound) {
+				continue
+			}
+			logger.Warn("failed to revert expired override", zap.String("match_id", string(id)), zap.Error(err))
+			failed = err
+			continue
+		}
+		reverted++
+	}
+
+	if failed != nil {
+		return reverted, fmt.Errorf("%s: %w", op, failed)
+	}
+	return reverted, nil
+}
+
+// reapplyOverrides rebuilds a stored match from its source values and the
+// overrides active at now and writes it back to the database and the cache.
+func (s *MatchService) reapplyOverrides(ctx context.Context, logger *zap.Logger, id models.MatchID, now time.Time) (models.Match, error) {
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("get match from repo: %w", err)
+	}
+
+	match := existing.WithoutOverrides()
+	if err := s.overrideSourceMatch(ctx, logger, &match, now); err != nil {
+		return models.Match{}, err
+	}
+
+	stored, err := s.repo.Upsert(ctx, match)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("upsert match: %w", err)
+	}
+	match.KickoffRevision, match.HomeScore, match.AwayScore = stored.KickoffRevision, stored.HomeScore, stored.AwayScore
+	s.invalidateUpcoming(ctx, logger)
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, match, s.cacheTTL); err != nil {
+			logger.Warn("redis cache write failed", zap.Error(err))
+		}
+	}
+
+	matches := []models.Match{match}
+	s.attachVenues(ctx, matches)
+	s.attachCityNames(ctx, matches)
+	return matches[0], nil
+}
+
+// ListMatchOverrides lists the corrections of one match, or of all matches for an empty id.
+func (s *MatchService) ListMatchOverrides(ctx context.Context, id models.MatchID, includeExpired bool) ([]models.MatchOverride, error) {
+	const op = "service.ListMatchOverrides"
+
+	if s.overrides == nil {
+		return nil, nil
+	}
+
+	var ids []models.MatchID
+	if id != "" {
+		ids = []models.MatchID{id}
+	}
+
+	overrides, err := s.overrides.ListMatchOverrides(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list overrides: %w", op, err)
+	}
+	if includeExpired {
+		return overrides, nil
+	}
+
+	now := time.Now().UTC()
+	active := overrides[:0]
+	for _, o := range overrides {
+		if o.Active(now) {
+			active = append(active, o)
+		}
+	}
+	return active, nil
+}
+
+func (s *MatchService) loadOverrides(ctx context.Context, ids []models.MatchID) ([]models.MatchOverride, error) {
+	if s.overrides == nil || len(ids) == 0 {
+		return nil, nil
+	}
+
+	overrides, err := s.overrides.ListMatchOverrides(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// overrideSourceMatch writes the active overrides into a match that holds the
+// source values and keeps those in Source.
+func (s *MatchService) overrideSourceMatch(ctx context.Context, logger *zap.Logger, match *models.Match, now time.Time) error {
+	overrides, err := s.loadOverrides(ctx, []models.MatchID{match.ID})
+	if err != nil {
+		// storing the source values would undo the manual fix
+		return err
+	}
+
+	source := match.SourceValues()
+	s.applyOverrides(ctx, logger, match, overrides, now)
+	match.SetSourceValues(source)
+	return nil
+}
+
+// applyOverrides applies active overrides and re-derives what depends on the
+// overridden fields: the destination airport of a new city and the dictionary
+// entry of a new stadium.
+func (s *MatchService) applyOverrides(ctx context.Context, logger *zap.Logger, match *models.Match, overrides []models.MatchOverride, now time.Time) []models.OverrideField {
+	changed := models.ApplyOverrides(match, overrides, now)
+
+	for _, field := range changed {
+		switch field {
+		case models.OverrideCity:
+			if hasActiveOverride(overrides, match.ID, models.OverrideDestinationIATA, now) {
+				continue
+			}
+			match.DestinationIATA = ""
+			if err := s.enrichDestination(ctx, match); err != nil {
+				if !errors.Is(err, derr.ErrCityIATANotFound) {
+					logger.Warn("failed to resolve overridden city", zap.String("match_id", string(match.ID)), zap.Error(err))
+					continue
+				}
+				s.reportUnresolvedCity(ctx, logger, *match)
+			}
+		case models.OverrideStadium:
+			match.StadiumID = ""
+			s.resolveStadium(ctx, logger, match)
+		}
+	}
+
+	if len(changed) > 0 {
+		fields := make([]string, 0, len(changed))
+		for _, field := range changed {
+			fields = append(fields, string(field))
+		}
+		logger.Debug("match overrides applied", zap.String("match_id", string(match.ID)), zap.Strings("fields", fields))
+	}
+
+	return changed
+}
+
+func hasActiveOverride(overrides []models.MatchOverride, id models.MatchID, field models.OverrideField, now time.Time) bool {
+	for _, o := range overrides {
+		if o.MatchID == id && o.Field == field && o.Active(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeOverride(o models.MatchOverride, now time.Time) (models.MatchOverride, error) {
+	o.Value = strings.TrimSpace(o.Value)
+	o.Author = strings.TrimSpace(o.Author)
+	o.Reason = strings.TrimSpace(o.Reason)
+
+	if o.MatchID == "" {
+		return o, fmt.Errorf("%w: match id is required", derr.ErrInvalidOverride)
+	}
+	if o.Author == "" || o.Reason == "" {
+		return o, fmt.Errorf("%w: author and reason are required", derr.ErrInvalidOverride)
+	}
+	if o.Value == "" {
+		return o, fmt.Errorf("%w: value is required", derr.ErrInvalidOverride)
+	}
+	if !o.ExpiresAt.IsZero() && !o.ExpiresAt.After(now) {
+		return o, fmt.Errorf("%w: expires_at must be in the future", derr.ErrInvalidOverride)
+	}
+
+	switch o.Field {
+	case models.OverrideDestinationIATA:
+		o.Value = strings.ToUpper(o.Value)
+		if !isIATA(o.Value) {
+			return o, fmt.Errorf("%w: destination_iata must be 3 latin letters", derr.ErrInvalidOverride)
+		}
+	case models.OverrideCity, models.OverrideStadium:
+	case models.OverrideKickoffUTC:
+		kickoff, err := time.Parse(time.RFC3339, o.Value)
+		if err != nil {
+			return o, fmt.Errorf("%w: kickoff_utc must be RFC3339", derr.ErrInvalidOverride)
+		}
+		o.Value = kickoff.UTC().Format(time.RFC3339)
+	case models.OverrideTicketsLink:
+		u, err := url.Parse(o.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return o, fmt.Errorf("%w: tickets_link must be an http(s) url", derr.ErrInvalidOverride)
+		}
+	default:
+		return o, fmt.Errorf("%w: unknown field %q", derr.ErrInvalidOverride, o.Field)
+	}
+
+	return o, nil
+}
+
+func isIATA(value string) bool {
+	if len(value) != 3 {
+		return false
+	}
+	for _, ch := range value {
+		if ch < 'A' || ch > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type overridesMock struct {
+	overrides []models.MatchOverride
+	listErr   error
+	listCalls int
+	upserted  []models.MatchOverride
+	deleted   []models.OverrideField
+}
+
+func (m *overridesMock) ListMatchOverrides(_ context.Context, ids []models.MatchID) ([]models.MatchOverride, error) {
+	m.listCalls++
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	if ids == nil {
+		return m.overrides, nil
+	}
+	var out []models.MatchOverride
+	for _, o := range m.overrides {
+		for _, id := range ids {
+			if o.MatchID == id {
+				out = append(out, o)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (m *overridesMock) UpsertMatchOverride(_ context.Context, o models.MatchOverride) (models.MatchOverride, error) {
+	m.upserted = append(m.upserted, o)
+	m.overrides = append(m.overrides, o)
+	return o, nil
+}
+
+func (m *overridesMock) DeleteMatchOverride(_ context.Context, _ models.MatchID, field models.OverrideField) error {
+	m.deleted = append(m.deleted, field)
+	return nil
+}
+
+func TestSyncUpcomingMatches_StoresOverriddenValues(t *testing.T) {
+	sourceKickoff := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	fixedKickoff := sourceKickoff.Add(3 * time.Hour)
+
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"100"},
+		match: models.Match{
+			ID:          "100",
+			City:        "Казань",
+			TicketsLink: "https://stale.example",
+			KickoffUTC:  sourceKickoff,
+		},
+	}
+	overrides := &overridesMock{overrides: []models.MatchOverride{
+		{MatchID: "100", Field: models.OverrideTicketsLink, Value: "https://tickets.example"},
+		{MatchID: "100", Field: models.OverrideKickoffUTC, Value: fixedKickoff.Format(time.RFC3339)},
+	}}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	cache := &cacheMock{}
+	resolver := &resolverMock{name: "Казань", iata: "KZN"}
+
+	svc := NewMatchService(zap.NewNop(), source, resolver, nil, overrides, repo, cache, 15*time.Minute, SourceFallbackPolicy{})
+	if _, err := svc.SyncUpcomingMatches(context.Background(), time.Time{}, time.Time{}, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.upserted) != 1 || len(cache.setItems) != 1 {
+		t.Fatalf("expected one upsert and one cache write, got %d and %d", len(repo.upserted), len(cache.setItems))
+	}
+	for _, got := range []models.Match{repo.upserted[0], cache.setItems[0]} {
+		if got.TicketsLink != "https://tickets.example" || !got.KickoffUTC.Equal(fixedKickoff) || got.DestinationIATA != "KZN" {
+			t.Fatalf("expected overridden values to be stored, got %+v", got)
+		}
+		if got.Source == nil || got.Source.TicketsLink != "https://stale.example" || !got.Source.KickoffUTC.Equal(sourceKickoff) {
+			t.Fatalf("expected source values to be kept, got %+v", got.Source)
+		}
+	}
+}
+
+func TestSyncUpcomingMatches_OverridesUnavailable(t *testing.T) {
+	source := &sourceMock{
+		upcomingIDs: []models.MatchID{"100"},
+		match:       models.Match{ID: "100", City: "Казань", KickoffUTC: time.Now().Add(48 * time.Hour)},
+	}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	overrides := &overridesMock{listErr: errors.New("db down")}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{iata: "KZN"}, nil, overrides, repo, nil, 15*time.Minute, SourceFallbackPolicy{})
+	if _, err := svc.SyncUpcomingMatches(context.Background(), time.Time{}, time.Time{}, 10); err == nil {
+		t.Fatal("expected sync to fail without overrides")
+	}
+	if repo.upsertCalls != 0 {
+		t.Fatalf("expected source values not to replace overridden ones, got %d upserts", repo.upsertCalls)
+	}
+}
+
+func TestGetMatch_ReadsStoredValues(t *testing.T) {
+	stored := models.Match{ID: "100", City: "Казань", DestinationIATA: "KZN", KickoffUTC: time.Now().Add(48 * time.Hour)}
+	cache := &cacheMock{getMatch: stored}
+	overrides := &overridesMock{listErr: errors.New("must not be read")}
+	resolver := &resolverMock{iata: "KZN"}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, resolver, nil, overrides, &repoMock{}, cache, 15*time.Minute, SourceFallbackPolicy{})
+	got, err := svc.GetMatch(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.City != "Казань" || overrides.listCalls != 0 || len(resolver.reported) != 0 {
+		t.Fatalf("expected the stored match without override lookups, got %+v, %d lookups, reports %v", got, overrides.listCalls, resolver.reported)
+	}
+}
+
+func TestSetMatchOverride(t *testing.T) {
+	stored := models.Match{ID: "100", City: "Казань", DestinationIATA: "KZN", KickoffUTC: time.Now().Add(48 * time.Hour)}
+	repo := &repoMock{getMatch: stored}
+	cache := &cacheMock{getErr: derr.ErrMatchNotFound}
+	overrides := &overridesMock{}
+	resolver := &resolverMock{name: "Самара", iata: "KUF"}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, resolver, nil, overrides, repo, cache, 15*time.Minute, SourceFallbackPolicy{})
+
+	_, _, err := svc.SetMatchOverride(context.Background(), models.MatchOverride{MatchID: "100", Field: models.OverrideCity, Value: "Самара", Author: "ops"})
+	if !errors.Is(err, derr.ErrInvalidOverride) {
+		t.Fatalf("expected invalid override without reason, got %v", err)
+	}
+
+	saved, match, err := svc.SetMatchOverride(context.Background(), models.MatchOverride{
+		MatchID: "100",
+		Field:   models.OverrideCity,
+		Value:   " Самара ",
+		Author:  "ops",
+		Reason:  "moved to a neutral venue",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved.Value != "Самара" || len(overrides.upserted) != 1 {
+		t.Fatalf("unexpected saved override: %+v", saved)
+	}
+	if match.City != "Самара" || match.DestinationIATA != "KUF" {
+		t.Fatalf("expected city and destination to follow the override, got %+v", match)
+	}
+	if len(repo.upserted) != 1 || len(cache.setItems) != 1 || repo.invalidations != 1 {
+		t.Fatalf("expected the match to be rewritten, got %d upserts, %d cache writes, %d invalidations", len(repo.upserted), len(cache.setItems), repo.invalidations)
+	}
+	for _, m := range []models.Match{repo.upserted[0], cache.setItems[0]} {
+		if m.City != "Самара" || m.Source == nil || m.Source.City != "Казань" || m.Source.DestinationIATA != "KZN" {
+			t.Fatalf("expected the override stored with the source values, got %+v %+v", m, m.Source)
+		}
+	}
+}
+
+func TestRevertExpiredOverrides(t *testing.T) {
+	now := time.Now().UTC()
+	stored := models.Match{
+		ID:              "100",
+		City:            "Самара",
+		DestinationIATA: "KUF",
+		TicketsLink:     "https://tickets.example",
+		KickoffUTC:      now.Add(48 * time.Hour),
+		Source: &models.MatchSource{
+			City:            "Казань",
+			DestinationIATA: "KZN",
+			TicketsLink:     "https://stale.example",
+			KickoffUTC:      now.Add(48 * time.Hour),
+		},
+	}
+	repo := &repoMock{getMatch: stored}
+	cache := &cacheMock{}
+	overrides := &overridesMock{overrides: []models.MatchOverride{
+		{MatchID: "100", Field: models.OverrideCity, Value: "Самара", ExpiresAt: now.Add(-time.Minute)},
+		{MatchID: "100", Field: models.OverrideTicketsLink, Value: "https://tickets.example"},
+		{MatchID: "200", Field: models.OverrideCity, Value: "Самара", ExpiresAt: now.Add(-time.Hour)},
+	}}
+
+	svc := NewMatchService(zap.NewNop(), &sourceMock{}, &resolverMock{name: "Самара", iata: "KUF"}, nil, overrides, repo, cache, 15*time.Minute, SourceFallbackPolicy{})
+	reverted, err := svc.RevertExpiredOverrides(context.Background(), now.Add(-30*time.Minute), now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if reverted != 1 || len(repo.upserted) != 1 {
+		t.Fatalf("expected only match 100 to be rewritten, got %d reverted, %d upserts", reverted, len(repo.upserted))
+	}
+	got := repo.upserted[0]
+	if got.City != "Казань" || got.DestinationIATA != "KZN" || got.TicketsLink != "https://tickets.example" {
+		t.Fatalf("expected the source city and the active tickets override, got %+v", got)
+	}
+	if got.Source == nil || got.Source.City != "Казань" || got.Source.TicketsLink != "https://stale.example" {
+		t.Fatalf("expected the source values to be kept, got %+v", got.Source)
+	}
+	if len(cache.setItems) != 1 || cache.setItems[0].City != "Казань" || repo.invalidations != 1 {
+		t.Fatalf("expected the cache to be refreshed, got %+v and %d invalidations", cache.setItems, repo.invalidations)
+	}
+}
+
+func TestNormalizeOverride(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	base := models.MatchOverride{MatchID: "1", Author: "ops", Reason: "fix"}
+
+	o := base
+	o.Field, o.Value = models.OverrideKickoffUTC, "2026-03-07T19:30:00+03:00"
+	got, err := normalizeOverride(o, now)
+	if err != nil || got.Value != "2026-03-07T16:30:00Z" {
+		t.Fatalf("unexpected kickoff override: %+v %v", got, err)
+	}
+
+	o = base
+	o.Field, o.Value = models.OverrideDestinationIATA, "kzn"
+	if got, err := normalizeOverride(o, now); err != nil || got.Value != "KZN" {
+		t.Fatalf("unexpected iata override: %+v %v", got, err)
+	}
+
+	for _, bad := range []models.MatchOverride{
+		{Field: models.OverrideDestinationIATA, Value: "KAZAN"},
+		{Field: models.OverrideKickoffUTC, Value: "2026-03-07 19:30"},
+		{Field: models.OverrideTicketsLink, Value: "ftp://tickets.example"},
+		{Field: "score", Value: "1:0"},
+		{Field: models.OverrideCity, Value: "Самара", ExpiresAt: now.Add(-time.Minute)},
+	} {
+		bad.MatchID, bad.Author, bad.Reason = base.MatchID, base.Author, base.Reason
+		if _, err := normalizeOverride(bad, now); !errors.Is(err, derr.ErrInvalidOverride) {
+			t.Fatalf("expected invalid override for %+v, got %v", bad, err)
+		}
+	}
+}
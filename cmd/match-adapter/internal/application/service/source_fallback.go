@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"go.uber.org/zap"
+)
+
+// SourceFallbackPolicy guards the source call GetMatch makes for ids missing
+// from the database. The zero value lets every fetch through.
+type SourceFallbackPolicy struct {
+	// NotFoundTTL is how long an id the source doesn't know is answered from
+	// the negative cache.
+	NotFoundTTL time.Duration
+	// Limiter is keyed by the caller from the request context. Calls without
+	// a caller, like admin or sync, are not limited.
+	Limiter ports.RateLimiter
+	// KnownIDs, when set, rejects ids outside the tournaments of the sources.
+	KnownIDs ports.KnownMatchIDs
+}
+
+type callerKey struct{}
+
+// WithCaller marks the context with the client the request is made for.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	if caller == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func callerFromContext(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+	return caller, ok && caller != ""
+}
+
+// allowSourceFetch runs the cheap checks first so that ids rejected by them
+// don't take tokens from the caller.
+func (s *MatchService) allowSourceFetch(ctx context.Context, logger *zap.Logger, id models.MatchID) error {
+	if s.cache != nil && s.fallback.NotFoundTTL > 0 {
+		notFound, err := s.cache.IsNotFound(ctx, id)
+		if err != nil {
+			logger.Warn("redis negative cache read failed", zap.Error(err))
+		} else if notFound {
+			logger.Debug("match not found in source recently")
+			return derr.ErrMatchNotFound
+		}
+	}
+
+	if s.fallback.KnownIDs != nil {
+		known, err := s.fallback.KnownIDs.IsKnownMatchID(ctx, id)
+		if err != nil {
+			if isContextErr(err) {
+				return err
+			}
+			logger.Warn("failed to check known match ids", zap.Error(err))
+		} else if !known {
+			logger.Debug("match id is outside known tournaments")
+			return derr.ErrMatchNotFound
+		}
+	}
+
+	if s.fallback.Limiter != nil {
+		if caller, ok := callerFromContext(ctx); ok && !s.fallback.Limiter.Allow(caller) {
+			logger.Warn("source fallback rate limit exceeded", zap.String("caller", caller))
+			return derr.ErrSourceFallbackLimited
+		}
+	}
+
+	return nil
+}
+
+func (s *MatchService) rememberNotFound(ctx context.Context, logger *zap.Logger, id models.MatchID) {
+	if s.cache == nil || s.fallback.NotFoundTTL <= 0 {
+		return
+	}
+	if err := s.cache.SetNotFound(ctx, id, s.fallback.NotFoundTTL); err != nil {
+		logger.Warn("redis negative cache write failed", zap.Error(err))
+	}
+}
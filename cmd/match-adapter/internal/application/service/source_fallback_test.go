@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type limiterMock struct {
+	allow bool
+	keys  []string
+}
+
+func (m *limiterMock) Allow(key string) bool {
+	m.keys = append(m.keys, key)
+	return m.allow
+}
+
+type knownIDsMock struct {
+	known bool
+	err   error
+}
+
+func (m *knownIDsMock) IsKnownMatchID(_ context.Context, _ models.MatchID) (bool, error) {
+	return m.known, m.err
+}
+
+func TestGetMatch_SourceNotFoundIsCachedNegatively(t *testing.T) {
+	cache := &cacheMock{getErr: derr.ErrMatchNotFound}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{err: derr.ErrMatchNotFound}
+	policy := SourceFallbackPolicy{NotFoundTTL: 5 * time.Minute}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, cache, 30*time.Minute, policy)
+
+	for i := 0; i < 3; i++ {
+		if _, err := svc.GetMatch(context.Background(), "404"); !errors.Is(err, derr.ErrMatchNotFound) {
+			t.Fatalf("expected ErrMatchNotFound, got %v", err)
+		}
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected one source call, got %d", source.calls)
+	}
+	if cache.notFound["404"] != 5*time.Minute {
+		t.Fatalf("expected negative entry with 5m ttl, got %v", cache.notFound)
+	}
+}
+
+func TestGetMatch_SourceUnavailableIsNotCachedNegatively(t *testing.T) {
+	cache := &cacheMock{getErr: derr.ErrMatchNotFound}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{err: derr.ErrSourceUnavailable}
+	policy := SourceFallbackPolicy{NotFoundTTL: 5 * time.Minute}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, cache, 30*time.Minute, policy)
+
+	if _, err := svc.GetMatch(context.Background(), "500"); !errors.Is(err, derr.ErrSourceUnavailable) {
+		t.Fatalf("expected ErrSourceUnavailable, got %v", err)
+	}
+	if len(cache.notFound) != 0 {
+		t.Fatalf("expected no negative entry, got %v", cache.notFound)
+	}
+}
+
+func TestGetMatch_NegativeCacheReadFailureFallsThrough(t *testing.T) {
+	cache := &cacheMock{getErr: derr.ErrMatchNotFound, notFoundErr: errors.New("redis down")}
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{match: models.Match{ID: "300", City: "Kazan", DestinationIATA: "KZN"}}
+	policy := SourceFallbackPolicy{NotFoundTTL: 5 * time.Minute}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, cache, 30*time.Minute, policy)
+
+	if _, err := svc.GetMatch(context.Background(), "300"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected source to be called, got %d", source.calls)
+	}
+}
+
+func TestGetMatch_CallerLimited(t *testing.T) {
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{match: models.Match{ID: "300", City: "Kazan", DestinationIATA: "KZN"}}
+	limiter := &limiterMock{}
+	policy := SourceFallbackPolicy{Limiter: limiter}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, policy)
+
+	ctx := WithCaller(context.Background(), "203.0.113.7")
+	if _, err := svc.GetMatch(ctx, "300"); !errors.Is(err, derr.ErrSourceFallbackLimited) {
+		t.Fatalf("expected ErrSourceFallbackLimited, got %v", err)
+	}
+	if source.calls != 0 {
+		t.Fatalf("expected source not called, got %d", source.calls)
+	}
+	if len(limiter.keys) != 1 || limiter.keys[0] != "203.0.113.7" {
+		t.Fatalf("expected limiter keyed by caller, got %v", limiter.keys)
+	}
+}
+
+func TestGetMatch_LimiterSkipsCallsWithoutCaller(t *testing.T) {
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{match: models.Match{ID: "300", City: "Kazan", DestinationIATA: "KZN"}}
+	limiter := &limiterMock{}
+	policy := SourceFallbackPolicy{Limiter: limiter}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, policy)
+
+	if _, err := svc.GetMatch(context.Background(), "300"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(limiter.keys) != 0 {
+		t.Fatalf("expected limiter not consulted, got %v", limiter.keys)
+	}
+}
+
+func TestGetMatch_UnknownIDRejectedBeforeLimiter(t *testing.T) {
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{match: models.Match{ID: "999999", City: "Kazan"}}
+	limiter := &limiterMock{allow: true}
+	policy := SourceFallbackPolicy{Limiter: limiter, KnownIDs: &knownIDsMock{known: false}}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, policy)
+
+	ctx := WithCaller(context.Background(), "203.0.113.7")
+	if _, err := svc.GetMatch(ctx, "999999"); !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+	if source.calls != 0 || len(limiter.keys) != 0 {
+		t.Fatalf("expected neither source nor limiter used, got %d calls and %v", source.calls, limiter.keys)
+	}
+}
+
+func TestGetMatch_KnownIDsErrorAllowsFetch(t *testing.T) {
+	repo := &repoMock{getErr: derr.ErrMatchNotFound}
+	source := &sourceMock{match: models.Match{ID: "300", City: "Kazan", DestinationIATA: "KZN"}}
+	policy := SourceFallbackPolicy{KnownIDs: &knownIDsMock{err: derr.ErrSourceUnavailable}}
+
+	svc := NewMatchService(zap.NewNop(), source, &resolverMock{}, nil, nil, repo, nil, 30*time.Minute, policy)
+
+	if _, err := svc.GetMatch(context.Background(), "300"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected source to be called, got %d", source.calls)
+	}
+}
@@ -11,17 +11,24 @@ import (
 )
 
 type Config struct {
-	Env             string            `yaml:"env" env:"ENV" env-default:"local"`
-	RefreshTokenTTL time.Duration     `yaml:"refresh_token_ttl" env:"REFRESH_TOKEN_TTL" env-default:"168h"`
-	MatchCacheTTL   time.Duration     `yaml:"match_cache_ttl" env:"MATCH_CACHE_TTL" env-default:"30m"`
-	MatchSync       MatchSyncConfig   `yaml:"match_sync"`
-	DebugHTTP       DebugHTTPConfig   `yaml:"debug_http"`
-	Jaeger          string            `yaml:"jaeger" env:"JAEGER" env-default:"jaeger"`
-	Log             LogConfig         `yaml:"log"`
-	GRPC            GRPCConfig        `yaml:"grpc"`
-	Redis           RedisConfig       `yaml:"redis"`
-	DB              DBConfig          `yaml:"db"`
-	Premierliga     PremierligaConfig `yaml:"premierliga"`
+	Env             string               `yaml:"env" env:"ENV" env-default:"local"`
+	RefreshTokenTTL time.Duration        `yaml:"refresh_token_ttl" env:"REFRESH_TOKEN_TTL" env-default:"168h"`
+	MatchCacheTTL   time.Duration        `yaml:"match_cache_ttl" env:"MATCH_CACHE_TTL" env-default:"30m"`
+	CityAliasesTTL  time.Duration        `yaml:"city_aliases_ttl" env:"CITY_ALIASES_TTL" env-default:"10m"`
+	MatchSync       MatchSyncConfig      `yaml:"match_sync"`
+	Overrides       OverridesConfig      `yaml:"overrides"`
+	DebugHTTP       DebugHTTPConfig      `yaml:"debug_http"`
+	Metrics         MetricsConfig        `yaml:"metrics"`
+	Jaeger          string               `yaml:"jaeger" env:"JAEGER" env-default:"jaeger"`
+	Log             LogConfig            `yaml:"log"`
+	GRPC            GRPCConfig           `yaml:"grpc"`
+	Redis           RedisConfig          `yaml:"redis"`
+	DB              DBConfig             `yaml:"db"`
+	Premierliga     PremierligaConfig    `yaml:"premierliga"`
+	Sources         []MatchSourceConfig  `yaml:"sources"`
+	SourceArchive   SourceArchiveConfig  `yaml:"source_archive"`
+	SourceFallback  SourceFallbackConfig `yaml:"source_fallback"`
+	ListCache       ListCacheConfig      `yaml:"list_cache"`
 }
 
 type LogConfig struct {
@@ -32,16 +39,33 @@ type GRPCConfig struct {
 	Host    string        `yaml:"host" env:"GRPC_HOST"`
 	Port    int           `yaml:"port" env:"GRPC_PORT"`
 	Timeout time.Duration `yaml:"timeout" env:"GRPC_TIMEOUT"`
+	// AdminEnabled registers MatchAdminService on the same port.
+	AdminEnabled bool `yaml:"admin_enabled" env:"GRPC_ADMIN_ENABLED" env-default:"false"`
+	// TrustedPeers are addresses or CIDRs of the gateway and airfare-provider.
+	// x-client-ip is only taken from them, other callers are limited by their
+	// own address.
+	TrustedPeers []string `yaml:"trusted_peers" env:"GRPC_TRUSTED_PEERS" env-separator:","`
 }
 
+const (
+	DBDriverPostgres = "postgres"
+	// DBDriverMemory keeps everything in process memory, seeded from the
+	// migrations. Meant for local development and CI.
+	DBDriverMemory = "memory"
+)
+
 type DBConfig struct {
-	DSN      string `yaml:"dsn" env:"DB_DSN"`
-	Host     string `yaml:"host" env:"DB_HOST"`
-	Port     int    `yaml:"port" env:"DB_PORT"`
-	User     string `yaml:"user" env:"DB_USER"`
-	Password string `yaml:"password" env:"DB_PASSWORD"`
-	Name     string `yaml:"name" env:"DB_NAME"`
-	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE" env-default:"require"`
+	Driver string `yaml:"driver" env:"DB_DRIVER" env-default:"postgres"`
+	// SnapshotPath persists the memory driver to a JSON file, empty keeps nothing.
+	SnapshotPath     string        `yaml:"snapshot_path" env:"DB_SNAPSHOT_PATH"`
+	SnapshotInterval time.Duration `yaml:"snapshot_interval" env:"DB_SNAPSHOT_INTERVAL" env-default:"1m"`
+	DSN              string        `yaml:"dsn" env:"DB_DSN"`
+	Host             string        `yaml:"host" env:"DB_HOST"`
+	Port             int           `yaml:"port" env:"DB_PORT"`
+	User             string        `yaml:"user" env:"DB_USER"`
+	Password         string        `yaml:"password" env:"DB_PASSWORD"`
+	Name             string        `yaml:"name" env:"DB_NAME"`
+	SSLMode          string        `yaml:"sslmode" env:"DB_SSLMODE" env-default:"require"`
 }
 
 type RedisConfig struct {
@@ -57,6 +81,14 @@ type MatchSyncConfig struct {
 	Horizon        time.Duration `yaml:"horizon" env:"MATCH_SYNC_HORIZON" env-default:"8760h"`
 	Limit          int           `yaml:"limit" env:"MATCH_SYNC_LIMIT" env-default:"200"`
 	RequestTimeout time.Duration `yaml:"request_timeout" env:"MATCH_SYNC_REQUEST_TIMEOUT" env-default:"30s"`
+	// ResultsLookback re-syncs recently played matches so their scores get stored.
+	ResultsLookback time.Duration `yaml:"results_lookback" env:"MATCH_SYNC_RESULTS_LOOKBACK" env-default:"72h"`
+}
+
+// OverridesConfig controls how often matches with expired overrides are
+// rewritten back to their source values.
+type OverridesConfig struct {
+	ExpiryInterval time.Duration `yaml:"expiry_interval" env:"OVERRIDES_EXPIRY_INTERVAL" env-default:"1m"`
 }
 
 type DebugHTTPConfig struct {
@@ -66,6 +98,49 @@ type DebugHTTPConfig struct {
 	Timeout time.Duration `yaml:"timeout" env:"DEBUG_HTTP_TIMEOUT" env-default:"5s"`
 }
 
+// MetricsConfig serves Prometheus metrics on /metrics.
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled" env:"METRICS_ENABLED" env-default:"true"`
+	Host    string `yaml:"host" env:"METRICS_HOST" env-default:"0.0.0.0"`
+	Port    int    `yaml:"port" env:"METRICS_PORT" env-default:"9091"`
+}
+
+const (
+	ArchiveBackendPostgres   = "postgres"
+	ArchiveBackendFilesystem = "filesystem"
+)
+
+// SourceArchiveConfig controls the raw source response archive. With Replay the
+// sources are served from the archive and the upstream API is never called.
+type SourceArchiveConfig struct {
+	Enabled       bool          `yaml:"enabled" env:"SOURCE_ARCHIVE_ENABLED" env-default:"false"`
+	Backend       string        `yaml:"backend" env:"SOURCE_ARCHIVE_BACKEND" env-default:"postgres"`
+	Dir           string        `yaml:"dir" env:"SOURCE_ARCHIVE_DIR" env-default:"var/source-archive"`
+	Retention     time.Duration `yaml:"retention" env:"SOURCE_ARCHIVE_RETENTION" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env:"SOURCE_ARCHIVE_PURGE_INTERVAL" env-default:"1h"`
+	Replay        bool          `yaml:"replay" env:"SOURCE_ARCHIVE_REPLAY" env-default:"false"`
+}
+
+// SourceFallbackConfig guards GetMatch calls that go to the source for ids
+// missing from the database. RatePerMinute of 0 turns the per-caller limit off.
+type SourceFallbackConfig struct {
+	NotFoundTTL   time.Duration `yaml:"not_found_ttl" env:"SOURCE_FALLBACK_NOT_FOUND_TTL" env-default:"10m"`
+	RatePerMinute int           `yaml:"rate_per_minute" env:"SOURCE_FALLBACK_RATE_PER_MINUTE" env-default:"30"`
+	Burst         int           `yaml:"burst" env:"SOURCE_FALLBACK_BURST" env-default:"10"`
+	// KnownIDsOnly rejects ids outside the tournaments the sources serve.
+	KnownIDsOnly bool `yaml:"known_ids_only" env:"SOURCE_FALLBACK_KNOWN_IDS_ONLY" env-default:"false"`
+}
+
+// ListCacheConfig controls caching of clubs and upcoming match lists in Redis
+// with an in-process tier in front. A local_ttl of 0 turns the local tier off.
+type ListCacheConfig struct {
+	Enabled     bool          `yaml:"enabled" env:"LIST_CACHE_ENABLED" env-default:"true"`
+	ClubsTTL    time.Duration `yaml:"clubs_ttl" env:"LIST_CACHE_CLUBS_TTL" env-default:"6h"`
+	UpcomingTTL time.Duration `yaml:"upcoming_ttl" env:"LIST_CACHE_UPCOMING_TTL" env-default:"1h"`
+	LocalTTL    time.Duration `yaml:"local_ttl" env:"LIST_CACHE_LOCAL_TTL" env-default:"30s"`
+	LocalSize   int           `yaml:"local_size" env:"LIST_CACHE_LOCAL_SIZE" env-default:"128"`
+}
+
 func (c DBConfig) DatabaseURL() string {
 	if c.DSN != "" {
 		return c.DSN
@@ -97,6 +172,70 @@ type PremierligaConfig struct {
 	RetryBaseInterval time.Duration `yaml:"retry_base_interval" env:"PREMIERLIGA_RETRY_BASE_INTERVAL" env-default:"200ms"`
 }
 
+const (
+	SourceKindPremierliga = "premierliga"
+	// SourceKindFile reads a season schedule from a JSON or CSV file at path.
+	SourceKindFile = "file"
+)
+
+// MatchSourceConfig describes one entry of the source registry. Every source
+// must use its own id_offset so that match ids from different sources never collide.
+type MatchSourceConfig struct {
+	Name              string        `yaml:"name"`
+	Kind              string        `yaml:"kind"`
+	Competition       string        `yaml:"competition"`
+	IDOffset          int64         `yaml:"id_offset"`
+	BaseURL           string        `yaml:"base_url"`
+	Timeout           time.Duration `yaml:"timeout"`
+	RetryMaxAttempts  int           `yaml:"retry_max_attempts"`
+	RetryBaseInterval time.Duration `yaml:"retry_base_interval"`
+	TournamentType    int64         `yaml:"tournament_type"`
+	MaxTournaments    int           `yaml:"max_tournaments"`
+	Path              string        `yaml:"path"`
+}
+
+// MatchSources returns configured sources with defaults applied. Without a
+// sources section the legacy premierliga block is used as the only source.
+func (c *Config) MatchSources() []MatchSourceConfig {
+	if len(c.Sources) == 0 {
+		return []MatchSourceConfig{{
+			Name:              SourceKindPremierliga,
+			Kind:              SourceKindPremierliga,
+			Competition:       "rpl",
+			BaseURL:           c.Premierliga.BaseURL,
+			Timeout:           c.Premierliga.Timeout,
+			RetryMaxAttempts:  c.Premierliga.RetryMaxAttempts,
+			RetryBaseInterval: c.Premierliga.RetryBaseInterval,
+			TournamentType:    1,
+		}}
+	}
+
+	sources := make([]MatchSourceConfig, 0, len(c.Sources))
+	for _, src := range c.Sources {
+		if src.Kind == "" {
+			src.Kind = SourceKindPremierliga
+		}
+		if src.Name == "" {
+			src.Name = src.Competition
+		}
+		if src.BaseURL == "" {
+			src.BaseURL = c.Premierliga.BaseURL
+		}
+		if src.Timeout <= 0 {
+			src.Timeout = c.Premierliga.Timeout
+		}
+		if src.RetryMaxAttempts <= 0 {
+			src.RetryMaxAttempts = c.Premierliga.RetryMaxAttempts
+		}
+		if src.RetryBaseInterval <= 0 {
+			src.RetryBaseInterval = c.Premierliga.RetryBaseInterval
+		}
+		sources = append(sources, src)
+	}
+
+	return sources
+}
+
 func MustLoad() *Config {
 	path := fetchConfigPath()
 	if path == "" {
@@ -6,4 +6,11 @@ var (
 	ErrMatchNotFound     = errors.New("match not found")
 	ErrCityIATANotFound  = errors.New("city IATA not found")
 	ErrSourceUnavailable = errors.New("source unavailable")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrStadiumNotFound   = errors.New("stadium not found")
+
+	ErrSourceResponseNotFound = errors.New("source response not found")
+	ErrOverrideNotFound       = errors.New("match override not found")
+	ErrInvalidOverride        = errors.New("invalid match override")
+	ErrSourceFallbackLimited  = errors.New("source fallback rate limit exceeded")
 )
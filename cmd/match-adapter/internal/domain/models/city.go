@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type City struct {
+	ID     string
+	Name   string
+	NameEN string
+	IATA   string
+}
+
+// CityAlias is one known spelling of a city, including the city name itself.
+type CityAlias struct {
+	Alias string
+	City  City
+}
+
+type UnresolvedCity struct {
+	Name        string
+	Occurrences int
+	LastMatchID MatchID
+	FirstSeen   time.Time
+	LastSeen    time.Time
+}
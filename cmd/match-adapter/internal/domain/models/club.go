@@ -6,5 +6,6 @@ type Club struct {
 	NameEN      string
 	Logo        string
 	City        string
+	CityEN      string
 	AirportIATA string
 }
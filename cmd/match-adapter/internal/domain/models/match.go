@@ -4,13 +4,39 @@ import "time"
 
 type MatchID string
 
+const (
+	CompetitionPremierLeague = "rpl"
+	CompetitionRussianCup    = "cup"
+	CompetitionFNL           = "fnl"
+)
+
 type Match struct {
-	ID              MatchID
-	HomeTeam        string
-	AwayTeam        string
-	City            string
-	Stadium         string
+	ID          MatchID
+	Competition string
+	HomeTeam    string
+	AwayTeam    string
+	City        string
+	Stadium     string
+	// StadiumID references the stadium dictionary, empty until Stadium is mapped.
+	StadiumID       string
 	DestinationIATA string
 	TicketsLink     string
 	KickoffUTC      time.Time
+	// KickoffRevision is incremented by storage every time KickoffUTC changes.
+	KickoffRevision int
+	// KickoffTentative is set while the source only publishes a placeholder
+	// time: the match date is known, the hour is not. The zero value is a
+	// confirmed kickoff, which is what snapshots written without the field hold.
+	KickoffTentative bool
+	// HomeScore and AwayScore stay nil until the result is published.
+	HomeScore *int
+	AwayScore *int
+	// Source keeps the source values while manual overrides replace some of
+	// the fields above. It is nil when the match holds the source values.
+	Source *MatchSource
+	// Venue is attached on read from the stadium dictionary and never stored with the match.
+	Venue *Stadium `json:"-"`
+	// CityEN is attached on read like Venue, empty while City is not a
+	// dictionary city.
+	CityEN string `json:"-"`
 }
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type ClubSide int
+
+const (
+	ClubSideAny ClubSide = iota
+	ClubSideHome
+	ClubSideAway
+)
+
+type SortOrder int
+
+const (
+	SortAsc SortOrder = iota
+	SortDesc
+)
+
+// MatchCursor is a keyset position in the (kickoff_utc, match_id) ordering.
+type MatchCursor struct {
+	KickoffUTC time.Time
+	ID         MatchID
+}
+
+type MatchFilter struct {
+	Limit           int
+	FromUTC         time.Time
+	ToUTC           time.Time
+	Competition     string
+	DestinationIATA string
+	City            string
+	ClubIDs         []string
+	ClubSide        ClubSide
+	Order           SortOrder
+	After           *MatchCursor
+}
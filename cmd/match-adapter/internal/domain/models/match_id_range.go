@@ -0,0 +1,12 @@
+package models
+
+// MatchIDRange is an inclusive range of source-local match ids, usually the
+// matches of one tournament.
+type MatchIDRange struct {
+	From int64
+	To   int64
+}
+
+func (r MatchIDRange) Contains(id int64) bool {
+	return id >= r.From && id <= r.To
+}
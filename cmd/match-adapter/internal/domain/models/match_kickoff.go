@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// MatchKickoff is an upcoming match id listed together with its kickoff, so
+// that lists of several sources can be merged in kickoff order.
+type MatchKickoff struct {
+	ID         MatchID
+	KickoffUTC time.Time
+}
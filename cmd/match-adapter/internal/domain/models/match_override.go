@@ -0,0 +1,149 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+type OverrideField string
+
+const (
+	OverrideDestinationIATA OverrideField = "destination_iata"
+	OverrideCity            OverrideField = "city"
+	OverrideStadium         OverrideField = "stadium"
+	OverrideKickoffUTC      OverrideField = "kickoff_utc"
+	OverrideTicketsLink     OverrideField = "tickets_link"
+)
+
+// MatchOverride replaces one source field of a match. Value is kept as text,
+// a kickoff is RFC3339.
+type MatchOverride struct {
+	MatchID MatchID
+	Field   OverrideField
+	Value   string
+	Author  string
+	Reason  string
+	// ExpiresAt is zero for overrides that stay until deleted.
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (o MatchOverride) Active(now time.Time) bool {
+	return o.ExpiresAt.IsZero() || o.ExpiresAt.After(now)
+}
+
+// MatchSource holds the source values of the fields overrides can replace,
+// together with the stadium id and destination derived from them.
+type MatchSource struct {
+	City             string
+	Stadium          string
+	StadiumID        string
+	DestinationIATA  string
+	TicketsLink      string
+	KickoffUTC       time.Time
+	KickoffTentative bool
+}
+
+func (s MatchSource) Equal(other MatchSource) bool {
+	return s.City == other.City &&
+		s.Stadium == other.Stadium &&
+		s.StadiumID == other.StadiumID &&
+		s.DestinationIATA == other.DestinationIATA &&
+		s.TicketsLink == other.TicketsLink &&
+		s.KickoffUTC.Equal(other.KickoffUTC) &&
+		s.KickoffTentative == other.KickoffTentative
+}
+
+// SourceValues returns what the source publishes for the overridable fields.
+func (m Match) SourceValues() MatchSource {
+	if m.Source != nil {
+		return *m.Source
+	}
+	return MatchSource{
+		City:             m.City,
+		Stadium:          m.Stadium,
+		StadiumID:        m.StadiumID,
+		DestinationIATA:  m.DestinationIATA,
+		TicketsLink:      m.TicketsLink,
+		KickoffUTC:       m.KickoffUTC,
+		KickoffTentative: m.KickoffTentative,
+	}
+}
+
+// SetSourceValues records the source values of an overridden match. Source
+// stays nil when they are the values the match already holds.
+func (m *Match) SetSourceValues(source MatchSource) {
+	m.Source = nil
+	if source.Equal(m.SourceValues()) {
+		return
+	}
+	m.Source = &source
+}
+
+// WithoutOverrides returns the match with the source values in place of the
+// overridden ones.
+func (m Match) WithoutOverrides() Match {
+	source := m.SourceValues()
+	m.City = source.City
+	m.Stadium = source.Stadium
+	m.StadiumID = source.StadiumID
+	m.DestinationIATA = source.DestinationIATA
+	m.TicketsLink = source.TicketsLink
+	m.KickoffUTC = source.KickoffUTC
+	m.KickoffTentative = source.KickoffTentative
+	m.Source = nil
+	return m
+}
+
+// ApplyOverrides writes active overrides into the match and returns the fields
+// whose value actually changed.
+func ApplyOverrides(match *Match, overrides []MatchOverride, now time.Time) []OverrideField {
+	var changed []OverrideField
+
+	for _, o := range overrides {
+		if o.MatchID != match.ID || !o.Active(now) {
+			continue
+		}
+
+		value := strings.TrimSpace(o.Value)
+		switch o.Field {
+		case OverrideDestinationIATA:
+			if match.DestinationIATA == value {
+				continue
+			}
+			match.DestinationIATA = value
+		case OverrideCity:
+			if match.City == value {
+				continue
+			}
+			match.City = value
+		case OverrideStadium:
+			if match.Stadium == value {
+				continue
+			}
+			match.Stadium = value
+		case OverrideKickoffUTC:
+			kickoff, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				continue
+			}
+			// a manually set kickoff is a known time, not a placeholder
+			if match.KickoffUTC.Equal(kickoff) && !match.KickoffTentative {
+				continue
+			}
+			match.KickoffUTC = kickoff.UTC()
+			match.KickoffTentative = false
+		case OverrideTicketsLink:
+			if match.TicketsLink == value {
+				continue
+			}
+			match.TicketsLink = value
+		default:
+			continue
+		}
+		changed = append(changed, o.Field)
+	}
+
+	return changed
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// SourceResponse is a raw upstream answer kept for audit and replay.
+type SourceResponse struct {
+	Source      string
+	Endpoint    string
+	RequestBody []byte
+	StatusCode  int
+	Body        []byte
+	FetchedAt   time.Time
+}
+
+// SourceRequestHash keys archived responses by request body.
+func SourceRequestHash(requestBody []byte) string {
+	sum := sha256.Sum256(requestBody)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+type Stadium struct {
+	ID        string
+	Name      string
+	NameEN    string
+	City      string
+	CityEN    string
+	Latitude  float64
+	Longitude float64
+	// Timezone is an IANA zone name used to show local kickoff time.
+	Timezone string
+	// Airports are ordered by transfer time, nearest first.
+	Airports []StadiumAirport
+}
+
+type StadiumAirport struct {
+	IATA         string
+	TransferTime time.Duration
+}
+
+// NormalizeStadiumAlias turns «Газпром Арена» and "газпром  арена" into the
+// same key of the stadium alias dictionary.
+func NormalizeStadiumAlias(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '«', '»', '"', '“', '”', '„', '\'':
+			return -1
+		}
+		return r
+	}, name)
+
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
@@ -0,0 +1,20 @@
+package models
+
+import "testing"
+
+func TestNormalizeStadiumAlias(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"«Газпром Арена»":           "газпром арена",
+		"  \"ВТБ  Арена\" ":         "втб арена",
+		"Солидарность Самара Арена": "солидарность самара арена",
+		"«»": "",
+	}
+
+	for in, want := range cases {
+		if got := NormalizeStadiumAlias(in); got != want {
+			t.Fatalf("NormalizeStadiumAlias(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// UpcomingIndex is a cached snapshot of upcoming matches ordered by
+// (kickoff_utc, match_id). It holds every match from From on unless
+// Complete is false, in which case it was cut at the size limit.
+type UpcomingIndex struct {
+	From     time.Time
+	Complete bool
+	Matches  []Match
+}
@@ -9,14 +9,39 @@ import (
 
 type MatchRepository interface {
 	GetByID(ctx context.Context, id models.MatchID) (models.Match, error)
-	GetUpcoming(ctx context.Context, limit int, clubID string) ([]models.Match, error)
+	// GetByIDs returns the stored matches among ids, missing ones are skipped.
+	GetByIDs(ctx context.Context, ids []models.MatchID) ([]models.Match, error)
+	ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error)
 	GetClubs(ctx context.Context) ([]models.Club, error)
-	Upsert(ctx context.Context, match models.Match) error
+	// Upsert returns the match as stored, with the kickoff revision and the
+	// scores kept by storage.
+	Upsert(ctx context.Context, match models.Match) (models.Match, error)
 }
 
 type MatchCache interface {
 	GetByID(ctx context.Context, id models.MatchID) (models.Match, error)
 	Set(ctx context.Context, match models.Match, ttl time.Duration) error
+	// SetNotFound remembers that the source has no match with this id.
+	SetNotFound(ctx context.Context, id models.MatchID, ttl time.Duration) error
+	IsNotFound(ctx context.Context, id models.MatchID) (bool, error)
+}
+
+// MatchListCache keeps the club dictionary and upcoming match indexes. Indexes
+// are stored per generation, bumping the generation invalidates all of them.
+type MatchListCache interface {
+	GetClubs(ctx context.Context) ([]models.Club, bool, error)
+	SetClubs(ctx context.Context, clubs []models.Club, ttl time.Duration) error
+	DeleteClubs(ctx context.Context) error
+	UpcomingGeneration(ctx context.Context) (int64, error)
+	GetUpcoming(ctx context.Context, generation int64, key string) (models.UpcomingIndex, bool, error)
+	SetUpcoming(ctx context.Context, generation int64, key string, index models.UpcomingIndex, ttl time.Duration) error
+	InvalidateUpcoming(ctx context.Context) error
+}
+
+// UpcomingInvalidator is implemented by repositories that cache upcoming
+// lists. Writers call it once after a batch of upserts, not per match.
+type UpcomingInvalidator interface {
+	InvalidateUpcoming(ctx context.Context) error
 }
 
 type MatchSource interface {
@@ -24,6 +49,46 @@ type MatchSource interface {
 	FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error)
 }
 
-type CityIATAResolver interface {
-	ResolveDestinationIATA(ctx context.Context, city string) (string, error)
+// KnownMatchIDs tells whether an id belongs to a tournament known to the
+// sources. Sources that can't tell treat every id in their range as known.
+type KnownMatchIDs interface {
+	IsKnownMatchID(ctx context.Context, id models.MatchID) (bool, error)
+}
+
+// RateLimiter allows or denies one more call for a key.
+type RateLimiter interface {
+	Allow(key string) bool
+}
+
+type CityResolver interface {
+	ResolveCity(ctx context.Context, name string) (models.City, error)
+	// CityByName looks up a dictionary city by its exact name.
+	CityByName(ctx context.Context, name string) (models.City, bool)
+	ReportUnresolved(ctx context.Context, name string, matchID models.MatchID) error
+}
+
+type CityDictionary interface {
+	GetCityAliases(ctx context.Context) ([]models.CityAlias, error)
+	RecordUnresolvedCity(ctx context.Context, name string, matchID models.MatchID) error
+}
+
+type StadiumRepository interface {
+	ResolveStadiumID(ctx context.Context, name string) (string, error)
+	GetStadiums(ctx context.Context, ids []string) (map[string]models.Stadium, error)
+}
+
+// MatchOverrideRepository stores manual corrections. Listing with no ids
+// returns the overrides of all matches, expired ones included.
+type MatchOverrideRepository interface {
+	ListMatchOverrides(ctx context.Context, ids []models.MatchID) ([]models.MatchOverride, error)
+	UpsertMatchOverride(ctx context.Context, override models.MatchOverride) (models.MatchOverride, error)
+	DeleteMatchOverride(ctx context.Context, id models.MatchID, field models.OverrideField) error
+}
+
+// SourceResponseArchive keeps raw source responses. Lookups match the request
+// body byte for byte and return the most recent response.
+type SourceResponseArchive interface {
+	StoreSourceResponse(ctx context.Context, resp models.SourceResponse) error
+	LatestSourceResponse(ctx context.Context, source, endpoint string, requestBody []byte) (models.SourceResponse, error)
+	PurgeSourceResponses(ctx context.Context, before time.Time) (int64, error)
 }
@@ -0,0 +1,190 @@
+// Package archive stores raw source responses on the local filesystem.
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/gzipx"
+)
+
+const fileSuffix = ".json.gz"
+
+// FileStore keeps one gzip file per response under
+// <dir>/<source>/<endpoint>/<request hash>/<fetched at, unix nanos>.json.gz,
+// so the newest response for a request is the last file name in its directory.
+type FileStore struct {
+	dir string
+}
+
+type fileRecord struct {
+	Source      string    `json:"source"`
+	Endpoint    string    `json:"endpoint"`
+	RequestBody string    `json:"request_body"`
+	StatusCode  int       `json:"status_code"`
+	FetchedAt   time.Time `json:"fetched_at"`
+	Body        string    `json:"body"`
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if strings.TrimSpace(dir) == "" {
+		return nil, errors.New("archive dir is empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create archive dir: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) StoreSourceResponse(_ context.Context, resp models.SourceResponse) error {
+	fetchedAt := resp.FetchedAt
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now()
+	}
+
+	raw, err := json.Marshal(fileRecord{
+		Source:      resp.Source,
+		Endpoint:    resp.Endpoint,
+		RequestBody: string(resp.RequestBody),
+		StatusCode:  resp.StatusCode,
+		FetchedAt:   fetchedAt.UTC(),
+		Body:        string(resp.Body),
+	})
+	if err != nil {
+		return fmt.Errorf("encode source response: %w", err)
+	}
+	compressed, err := gzipx.Compress(raw)
+	if err != nil {
+		return fmt.Errorf("compress source response: %w", err)
+	}
+
+	dir := s.requestDir(resp.Source, resp.Endpoint, resp.RequestBody)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create archive dir: %w", err)
+	}
+
+	// write and rename so that replay never reads a half written file
+	name := filepath.Join(dir, fmt.Sprintf("%020d%s", fetchedAt.UnixNano(), fileSuffix))
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, compressed, 0o644); err != nil {
+		return fmt.Errorf("write source response: %w", err)
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename source response: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileStore) LatestSourceResponse(_ context.Context, source, endpoint string, requestBody []byte) (models.SourceResponse, error) {
+	entries, err := os.ReadDir(s.requestDir(source, endpoint, requestBody))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return models.SourceResponse{}, derr.ErrSourceResponseNotFound
+		}
+		return models.SourceResponse{}, fmt.Errorf("read archive dir: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), fileSuffix) {
+			names = append(names, e.Name())
+		}
+	}
+	if len(names) == 0 {
+		return models.SourceResponse{}, derr.ErrSourceResponseNotFound
+	}
+	sort.Strings(names)
+
+	return s.readFile(filepath.Join(s.requestDir(source, endpoint, requestBody), names[len(names)-1]))
+}
+
+// PurgeSourceResponses removes files by the fetch time encoded in their names.
+func (s *FileStore) PurgeSourceResponses(ctx context.Context, before time.Time) (int64, error) {
+	var removed int64
+
+	err := filepath.WalkDir(s.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), fileSuffix) {
+			return nil
+		}
+
+		nanos, err := strconv.ParseInt(strings.TrimSuffix(d.Name(), fileSuffix), 10, 64)
+		if err != nil || !time.Unix(0, nanos).Before(before) {
+			return nil
+		}
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		return removed, fmt.Errorf("purge archive: %w", err)
+	}
+
+	return removed, nil
+}
+
+func (s *FileStore) readFile(name string) (models.SourceResponse, error) {
+	compressed, err := os.ReadFile(name)
+	if err != nil {
+		return models.SourceResponse{}, fmt.Errorf("read source response: %w", err)
+	}
+	raw, err := gzipx.Decompress(compressed)
+	if err != nil {
+		return models.SourceResponse{}, fmt.Errorf("decompress source response: %w", err)
+	}
+
+	var rec fileRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return models.SourceResponse{}, fmt.Errorf("decode source response: %w", err)
+	}
+
+	return models.SourceResponse{
+		Source:      rec.Source,
+		Endpoint:    rec.Endpoint,
+		RequestBody: []byte(rec.RequestBody),
+		StatusCode:  rec.StatusCode,
+		Body:        []byte(rec.Body),
+		FetchedAt:   rec.FetchedAt,
+	}, nil
+}
+
+func (s *FileStore) requestDir(source, endpoint string, requestBody []byte) string {
+	return filepath.Join(s.dir, safeName(source), safeName(path.Base(endpoint)), models.SourceRequestHash(requestBody))
+}
+
+func safeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if name == "" {
+		return "_"
+	}
+	return name
+}
@@ -0,0 +1,55 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func TestFileStore_LatestAndPurge(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("new file store: %v", err)
+	}
+	ctx := context.Background()
+	first := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	request := []byte(`{"id":16114}`)
+
+	for i, body := range []string{`{"date":"2026-03-07"}`, `{"date":"2026-03-07UTC19:30:00"}`} {
+		err := store.StoreSourceResponse(ctx, models.SourceResponse{
+			Source:      "premierliga",
+			Endpoint:    "/api/getFullDataMatch",
+			RequestBody: request,
+			StatusCode:  200,
+			Body:        []byte(body),
+			FetchedAt:   first.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("store: %v", err)
+		}
+	}
+
+	got, err := store.LatestSourceResponse(ctx, "premierliga", "/api/getFullDataMatch", request)
+	if err != nil {
+		t.Fatalf("latest: %v", err)
+	}
+	if string(got.Body) != `{"date":"2026-03-07UTC19:30:00"}` || got.StatusCode != 200 || !got.FetchedAt.Equal(first.Add(time.Hour)) {
+		t.Fatalf("unexpected latest response: %+v", got)
+	}
+
+	if _, err := store.LatestSourceResponse(ctx, "premierliga", "/api/getFullDataMatch", []byte(`{"id":1}`)); !errors.Is(err, derr.ErrSourceResponseNotFound) {
+		t.Fatalf("expected ErrSourceResponseNotFound, got %v", err)
+	}
+
+	removed, err := store.PurgeSourceResponses(ctx, first.Add(30*time.Minute))
+	if err != nil || removed != 1 {
+		t.Fatalf("expected 1 purged response, got %d (%v)", removed, err)
+	}
+	if _, err := store.LatestSourceResponse(ctx, "premierliga", "/api/getFullDataMatch", request); err != nil {
+		t.Fatalf("expected the newer response to survive the purge: %v", err)
+	}
+}
@@ -0,0 +1,318 @@
+// Package cached puts Redis and an in-process LRU in front of the match
+// repository for the club dictionary and upcoming match lists.
+package cached
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/lru"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultIndexLimit caps one upcoming index. Pages past the cap are read
+	// from the database.
+	defaultIndexLimit = 2000
+	// indexLookback lets requests that computed "now" just before the index
+	// was built still be served from it.
+	indexLookback = time.Minute
+	clubsLocalKey = "clubs"
+)
+
+// Repository caches GetClubs and upcoming ListMatches calls. The upcoming
+// indexes are dropped by InvalidateUpcoming, which writers call once per
+// batch, the club dictionary by InvalidateClubs. Other calls go straight to
+// the wrapped repository.
+type Repository struct {
+	log         *zap.Logger
+	next        ports.MatchRepository
+	store       ports.MatchListCache
+	clubsTTL    time.Duration
+	upcomingTTL time.Duration
+	indexLimit  int
+
+	clubs   *lru.Cache[string, []models.Club]
+	indexes *lru.Cache[string, localIndex]
+	// generation guards the local tier against storing a value loaded
+	// before a concurrent invalidation.
+	generation atomic.Uint64
+	now        func() time.Time
+}
+
+// NewRepository wraps next. A localTTL of 0 turns the in-process tier off.
+// localIndex is an upcoming index kept in process with the Redis generation
+// it was built for, so that an invalidation on another instance is noticed.
+type localIndex struct {
+	generation int64
+	index      models.UpcomingIndex
+}
+
+func NewRepository(
+	log *zap.Logger,
+	next ports.MatchRepository,
+	store ports.MatchListCache,
+	clubsTTL time.Duration,
+	upcomingTTL time.Duration,
+	localTTL time.Duration,
+	localSize int,
+) *Repository {
+	r := &Repository{
+		log:         log,
+		next:        next,
+		store:       store,
+		clubsTTL:    clubsTTL,
+		upcomingTTL: upcomingTTL,
+		indexLimit:  defaultIndexLimit,
+		now:         time.Now,
+	}
+	if localTTL > 0 {
+		r.clubs = lru.New[string, []models.Club](1, localTTL)
+		r.indexes = lru.New[string, localIndex](localSize, localTTL)
+	}
+
+	return r
+}
+
+func (r *Repository) GetByID(ctx context.Context, id models.MatchID) (models.Match, error) {
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *Repository) GetByIDs(ctx context.Context, ids []models.MatchID) ([]models.Match, error) {
+	return r.next.GetByIDs(ctx, ids)
+}
+
+func (r *Repository) Upsert(ctx context.Context, match models.Match) (models.Match, error) {
+	return r.next.Upsert(ctx, match)
+}
+
+// InvalidateUpcoming drops the upcoming indexes here and, through the shared
+// generation, on every other instance: their local copies are checked against
+// it on each read.
+func (r *Repository) InvalidateUpcoming(ctx context.Context) error {
+	r.generation.Add(1)
+	if r.indexes != nil {
+		r.indexes.Purge()
+	}
+
+	return r.store.InvalidateUpcoming(ctx)
+}
+
+// InvalidateClubs drops the cached club dictionary. club_dictionary is only
+// changed by migrations, so it is called on startup.
+func (r *Repository) InvalidateClubs(ctx context.Context) error {
+	r.generation.Add(1)
+	if r.clubs != nil {
+		r.clubs.Purge()
+	}
+
+	return r.store.DeleteClubs(ctx)
+}
+
+func (r *Repository) GetClubs(ctx context.Context) ([]models.Club, error) {
+	if r.clubs != nil {
+		if clubs, ok := r.clubs.Get(clubsLocalKey); ok {
+			return slices.Clone(clubs), nil
+		}
+	}
+
+	generation := r.generation.Load()
+	clubs, ok, err := r.store.GetClubs(ctx)
+	if err != nil {
+		r.log.Warn("clubs cache read failed", zap.Error(err))
+	}
+	if !ok {
+		clubs, err = r.next.GetClubs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.store.SetClubs(ctx, clubs, r.clubsTTL); err != nil {
+			r.log.Warn("clubs cache write failed", zap.Error(err))
+		}
+	}
+
+	if r.clubs != nil && r.generation.Load() == generation {
+		r.clubs.Add(clubsLocalKey, slices.Clone(clubs))
+	}
+	return clubs, nil
+}
+
+// ListMatches serves ascending lists of all matches or of one club from the
+// upcoming index. Any other filter goes to the database.
+func (r *Repository) ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error) {
+	key, clubIDs, ok := indexKey(filter)
+	if !ok {
+		return r.next.ListMatches(ctx, filter)
+	}
+
+	index, ok := r.upcomingIndex(ctx, key, clubIDs)
+	if !ok || filter.FromUTC.Before(index.From) {
+		return r.next.ListMatches(ctx, filter)
+	}
+
+	page, ok := pageFromIndex(index, filter)
+	if !ok {
+		return r.next.ListMatches(ctx, filter)
+	}
+	return page, nil
+}
+
+// upcomingIndex reads the index from the local tier, Redis or the database.
+// A local copy costs one generation read; while Redis is down it is served
+// until localTTL runs out.
+func (r *Repository) upcomingIndex(ctx context.Context, key string, clubIDs []string) (models.UpcomingIndex, bool) {
+	logger := r.log.With(zap.String("index", key))
+	localGeneration := r.generation.Load()
+	generation, err := r.store.UpcomingGeneration(ctx)
+
+	if r.indexes != nil {
+		if local, ok := r.indexes.Get(key); ok && (err != nil || local.generation == generation) {
+			return local.index, true
+		}
+	}
+	if err != nil {
+		logger.Warn("upcoming matches cache read failed", zap.Error(err))
+		return models.UpcomingIndex{}, false
+	}
+
+	index, ok, err := r.store.GetUpcoming(ctx, generation, key)
+	if err != nil {
+		logger.Warn("upcoming matches cache read failed", zap.Error(err))
+	}
+	if ok {
+		slices.SortStableFunc(index.Matches, compareMatches)
+	} else {
+		index, err = r.buildIndex(ctx, clubIDs)
+		if err != nil {
+			logger.Warn("failed to build upcoming matches index", zap.Error(err))
+			return models.UpcomingIndex{}, false
+		}
+		if err := r.store.SetUpcoming(ctx, generation, key, index, r.upcomingTTL); err != nil {
+			logger.Warn("upcoming matches cache write failed", zap.Error(err))
+		}
+	}
+
+	if r.indexes != nil && r.generation.Load() == localGeneration {
+		r.indexes.Add(key, localIndex{generation: generation, index: index})
+	}
+	return index, true
+}
+
+func (r *Repository) buildIndex(ctx context.Context, clubIDs []string) (models.UpcomingIndex, error) {
+	from := r.now().UTC().Add(-indexLookback)
+	matches, err := r.next.ListMatches(ctx, models.MatchFilter{
+		Limit:   r.indexLimit + 1,
+		FromUTC: from,
+		ClubIDs: clubIDs,
+		Order:   models.SortAsc,
+	})
+	if err != nil {
+		return models.UpcomingIndex{}, err
+	}
+
+	complete := len(matches) <= r.indexLimit
+	if !complete {
+		matches = matches[:r.indexLimit]
+	}
+	return models.UpcomingIndex{From: from, Complete: complete, Matches: matches}, nil
+}
+
+// indexKey names the index that can answer filter, if any.
+func indexKey(filter models.MatchFilter) (string, []string, bool) {
+	if filter.Order != models.SortAsc || filter.FromUTC.IsZero() || filter.ClubSide != models.ClubSideAny {
+		return "", nil, false
+	}
+	if strings.TrimSpace(filter.Competition) != "" ||
+		strings.TrimSpace(filter.DestinationIATA) != "" ||
+		strings.TrimSpace(filter.City) != "" {
+		return "", nil, false
+	}
+
+	var clubIDs []string
+	for _, id := range filter.ClubIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			clubIDs = append(clubIDs, id)
+		}
+	}
+
+	switch len(clubIDs) {
+	case 0:
+		return "all", nil, true
+	case 1:
+		return "club:" + clubIDs[0], clubIDs, true
+	default:
+		return "", nil, false
+	}
+}
+
+// pageFromIndex applies the time range, cursor and limit of filter the way
+// the database query does. It fails when an incomplete index runs out before
+// the page is full.
+func pageFromIndex(index models.UpcomingIndex, filter models.MatchFilter) ([]models.Match, bool) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var afterID int64
+	if filter.After != nil {
+		id, err := strconv.ParseInt(string(filter.After.ID), 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		afterID = id
+	}
+
+	page := make([]models.Match, 0, limit)
+	for _, match := range index.Matches {
+		if match.KickoffUTC.Before(filter.FromUTC) {
+			continue
+		}
+		if !filter.ToUTC.IsZero() && match.KickoffUTC.After(filter.ToUTC) {
+			return page, true
+		}
+		if filter.After != nil && !isAfter(match, filter.After.KickoffUTC, afterID) {
+			continue
+		}
+
+		page = append(page, match)
+		if len(page) == limit {
+			return page, true
+		}
+	}
+
+	return page, index.Complete
+}
+
+func isAfter(match models.Match, kickoff time.Time, id int64) bool {
+	if !match.KickoffUTC.Equal(kickoff) {
+		return match.KickoffUTC.After(kickoff)
+	}
+	return matchIDNumber(match.ID) > id
+}
+
+func compareMatches(a, b models.Match) int {
+	if c := a.KickoffUTC.Compare(b.KickoffUTC); c != 0 {
+		return c
+	}
+	x, y := matchIDNumber(a.ID), matchIDNumber(b.ID)
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func matchIDNumber(id models.MatchID) int64 {
+	n, _ := strconv.ParseInt(string(id), 10, 64)
+	return n
+}
@@ -0,0 +1,377 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type repoMock struct {
+	matches    []models.Match
+	clubs      []models.Club
+	listCalls  int
+	clubsCalls int
+}
+
+func (m *repoMock) GetByID(_ context.Context, id models.MatchID) (models.Match, error) {
+	for _, match := range m.matches {
+		if match.ID == id {
+			return match, nil
+		}
+	}
+	return models.Match{}, errors.New("not found")
+}
+
+func (m *repoMock) GetByIDs(_ context.Context, _ []models.MatchID) ([]models.Match, error) {
+	return nil, nil
+}
+
+func (m *repoMock) ListMatches(_ context.Context, filter models.MatchFilter) ([]models.Match, error) {
+	m.listCalls++
+
+	matches := slices.Clone(m.matches)
+	slices.SortFunc(matches, compareMatches)
+
+	var out []models.Match
+	for _, match := range matches {
+		if match.KickoffUTC.Before(filter.FromUTC) {
+			continue
+		}
+		if !filter.ToUTC.IsZero() && match.KickoffUTC.After(filter.ToUTC) {
+			continue
+		}
+		if filter.Competition != "" && match.Competition != filter.Competition {
+			continue
+		}
+		if len(filter.ClubIDs) > 0 && !hasClub(filter.ClubIDs, match) {
+			continue
+		}
+		if filter.After != nil && !isAfter(match, filter.After.KickoffUTC, matchIDNumber(filter.After.ID)) {
+			continue
+		}
+		out = append(out, match)
+		if len(out) == filter.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func hasClub(ids []string, match models.Match) bool {
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == match.HomeTeam || id == match.AwayTeam {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *repoMock) GetClubs(_ context.Context) ([]models.Club, error) {
+	m.clubsCalls++
+	return m.clubs, nil
+}
+
+func (m *repoMock) Upsert(_ context.Context, match models.Match) (models.Match, error) {
+	for i := range m.matches {
+		if m.matches[i].ID == match.ID {
+			m.matches[i] = match
+			return match, nil
+		}
+	}
+	m.matches = append(m.matches, match)
+	return match, nil
+}
+
+type storeMock struct {
+	clubs      []models.Club
+	generation int64
+	indexes    map[string]models.UpcomingIndex
+}
+
+func newStoreMock() *storeMock {
+	return &storeMock{indexes: map[string]models.UpcomingIndex{}}
+}
+
+func (s *storeMock) GetClubs(_ context.Context) ([]models.Club, bool, error) {
+	return s.clubs, s.clubs != nil, nil
+}
+
+func (s *storeMock) SetClubs(_ context.Context, clubs []models.Club, _ time.Duration) error {
+	s.clubs = clubs
+	return nil
+}
+
+func (s *storeMock) DeleteClubs(_ context.Context) error {
+	s.clubs = nil
+	return nil
+}
+
+func (s *storeMock) UpcomingGeneration(_ context.Context) (int64, error) {
+	return s.generation, nil
+}
+
+func (s *storeMock) GetUpcoming(_ context.Context, generation int64, key string) (models.UpcomingIndex, bool, error) {
+	index, ok := s.indexes[fmt.Sprintf("%d:%s", generation, key)]
+	return index, ok, nil
+}
+
+func (s *storeMock) SetUpcoming(_ context.Context, generation int64, key string, index models.UpcomingIndex, _ time.Duration) error {
+	s.indexes[fmt.Sprintf("%d:%s", generation, key)] = index
+	return nil
+}
+
+func (s *storeMock) InvalidateUpcoming(_ context.Context) error {
+	s.generation++
+	return nil
+}
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testMatches() []models.Match {
+	return []models.Match{
+		{ID: "10", KickoffUTC: testNow.Add(-time.Hour), HomeTeam: "1", AwayTeam: "2", Competition: "rpl"},
+		{ID: "11", KickoffUTC: testNow.Add(24 * time.Hour), HomeTeam: "1", AwayTeam: "2", Competition: "rpl"},
+		{ID: "13", KickoffUTC: testNow.Add(48 * time.Hour), HomeTeam: "3", AwayTeam: "1", Competition: "rpl"},
+		{ID: "12", KickoffUTC: testNow.Add(48 * time.Hour), HomeTeam: "2", AwayTeam: "3", Competition: "cup"},
+		{ID: "14", KickoffUTC: testNow.Add(72 * time.Hour), HomeTeam: "2", AwayTeam: "4", Competition: "rpl"},
+		{ID: "15", KickoffUTC: testNow.Add(96 * time.Hour), HomeTeam: "4", AwayTeam: "1", Competition: "cup"},
+	}
+}
+
+func newTestRepository(next *repoMock, store *storeMock, localTTL time.Duration) *Repository {
+	r := NewRepository(zap.NewNop(), next, store, time.Hour, time.Hour, localTTL, 16)
+	r.now = func() time.Time { return testNow }
+	return r
+}
+
+func matchIDs(matches []models.Match) []models.MatchID {
+	ids := make([]models.MatchID, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+// listAll walks the cursor pages the way service.listPage does.
+func listAll(t *testing.T, repo interface {
+	ListMatches(context.Context, models.MatchFilter) ([]models.Match, error)
+}, filter models.MatchFilter, limit int) []models.MatchID {
+	t.Helper()
+
+	var ids []models.MatchID
+	for {
+		filter.Limit = limit + 1
+		page, err := repo.ListMatches(context.Background(), filter)
+		if err != nil {
+			t.Fatalf("list matches: %v", err)
+		}
+		if len(page) <= limit {
+			return append(ids, matchIDs(page)...)
+		}
+		page = page[:limit]
+		ids = append(ids, matchIDs(page)...)
+		last := page[len(page)-1]
+		filter.After = &models.MatchCursor{KickoffUTC: last.KickoffUTC, ID: last.ID}
+	}
+}
+
+func TestRepository_GetClubsReadThrough(t *testing.T) {
+	next := &repoMock{clubs: []models.Club{{ID: "1", NameRU: "Зенит"}}}
+	store := newStoreMock()
+	repo := newTestRepository(next, store, 0)
+
+	for range 3 {
+		clubs, err := repo.GetClubs(context.Background())
+		if err != nil {
+			t.Fatalf("get clubs: %v", err)
+		}
+		if !reflect.DeepEqual(clubs, next.clubs) {
+			t.Fatalf("unexpected clubs %+v", clubs)
+		}
+	}
+	if next.clubsCalls != 1 {
+		t.Fatalf("expected one database call, got %d", next.clubsCalls)
+	}
+
+	if err := repo.InvalidateClubs(context.Background()); err != nil {
+		t.Fatalf("invalidate clubs: %v", err)
+	}
+	if _, err := repo.GetClubs(context.Background()); err != nil {
+		t.Fatalf("get clubs: %v", err)
+	}
+	if next.clubsCalls != 2 {
+		t.Fatalf("expected a database call after invalidation, got %d", next.clubsCalls)
+	}
+}
+
+func TestRepository_UpcomingPagesMatchDatabase(t *testing.T) {
+	filters := map[string]models.MatchFilter{
+		"all":      {FromUTC: testNow},
+		"club":     {FromUTC: testNow, ClubIDs: []string{" 1 "}},
+		"to":       {FromUTC: testNow, ToUTC: testNow.Add(60 * time.Hour)},
+		"from_mid": {FromUTC: testNow.Add(30 * time.Hour)},
+	}
+
+	for name, filter := range filters {
+		t.Run(name, func(t *testing.T) {
+			next := &repoMock{matches: testMatches()}
+			repo := newTestRepository(next, newStoreMock(), time.Minute)
+
+			for _, limit := range []int{1, 2, 10} {
+				want := listAll(t, &repoMock{matches: testMatches()}, filter, limit)
+				got := listAll(t, repo, filter, limit)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("limit %d: expected %v, got %v", limit, want, got)
+				}
+			}
+			if next.listCalls != 1 {
+				t.Fatalf("expected one index build, got %d database calls", next.listCalls)
+			}
+		})
+	}
+}
+
+func TestRepository_InvalidateUpcoming(t *testing.T) {
+	next := &repoMock{matches: testMatches()}
+	store := newStoreMock()
+	repo := newTestRepository(next, store, time.Minute)
+	filter := models.MatchFilter{FromUTC: testNow, Limit: 10}
+
+	if _, err := repo.ListMatches(context.Background(), filter); err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+
+	moved := testMatches()[5]
+	moved.KickoffUTC = testNow.Add(2 * time.Hour)
+	if _, err := repo.Upsert(context.Background(), moved); err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if store.generation != 0 {
+		t.Fatalf("expected upsert to leave invalidation to the writer, got generation %d", store.generation)
+	}
+	if err := repo.InvalidateUpcoming(context.Background()); err != nil {
+		t.Fatalf("invalidate upcoming: %v", err)
+	}
+	if store.generation != 1 {
+		t.Fatalf("expected redis generation bump, got %d", store.generation)
+	}
+
+	page, err := repo.ListMatches(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+	if got := matchIDs(page); !reflect.DeepEqual(got, []models.MatchID{"15", "11", "12", "13", "14"}) {
+		t.Fatalf("expected moved match first, got %v", got)
+	}
+	if next.listCalls != 2 {
+		t.Fatalf("expected index rebuild, got %d database calls", next.listCalls)
+	}
+}
+
+func TestRepository_SharedIndexFromRedis(t *testing.T) {
+	next := &repoMock{matches: testMatches()}
+	store := newStoreMock()
+	filter := models.MatchFilter{FromUTC: testNow, Limit: 10}
+
+	for range 2 {
+		repo := newTestRepository(next, store, time.Minute)
+		if _, err := repo.ListMatches(context.Background(), filter); err != nil {
+			t.Fatalf("list matches: %v", err)
+		}
+	}
+	if next.listCalls != 1 {
+		t.Fatalf("expected the second instance to use redis, got %d database calls", next.listCalls)
+	}
+}
+
+func TestRepository_InvalidateUpcomingReachesOtherInstances(t *testing.T) {
+	next := &repoMock{matches: testMatches()}
+	store := newStoreMock()
+	writer := newTestRepository(next, store, time.Minute)
+	reader := newTestRepository(next, store, time.Minute)
+	filter := models.MatchFilter{FromUTC: testNow, Limit: 10}
+
+	for _, repo := range []*Repository{writer, reader, reader} {
+		if _, err := repo.ListMatches(context.Background(), filter); err != nil {
+			t.Fatalf("list matches: %v", err)
+		}
+	}
+	if next.listCalls != 1 {
+		t.Fatalf("expected one index build shared through redis, got %d database calls", next.listCalls)
+	}
+
+	moved := testMatches()[5]
+	moved.KickoffUTC = testNow.Add(2 * time.Hour)
+	if _, err := writer.Upsert(context.Background(), moved); err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if err := writer.InvalidateUpcoming(context.Background()); err != nil {
+		t.Fatalf("invalidate upcoming: %v", err)
+	}
+
+	page, err := reader.ListMatches(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+	if got := matchIDs(page); !reflect.DeepEqual(got, []models.MatchID{"15", "11", "12", "13", "14"}) {
+		t.Fatalf("expected the other instance to drop its local index, got %v", got)
+	}
+}
+
+func TestRepository_UncacheableFiltersGoToDatabase(t *testing.T) {
+	filters := map[string]models.MatchFilter{
+		"competition": {FromUTC: testNow, Competition: "rpl", Limit: 10},
+		"desc":        {FromUTC: testNow, Order: models.SortDesc, Limit: 10},
+		"two_clubs":   {FromUTC: testNow, ClubIDs: []string{"1", "2"}, Limit: 10},
+		"home_side":   {FromUTC: testNow, ClubIDs: []string{"1"}, ClubSide: models.ClubSideHome, Limit: 10},
+		"no_from":     {Limit: 10},
+		"past_from":   {FromUTC: testNow.Add(-2 * time.Hour), Limit: 10},
+	}
+
+	for name, filter := range filters {
+		t.Run(name, func(t *testing.T) {
+			next := &repoMock{matches: testMatches()}
+			repo := newTestRepository(next, newStoreMock(), time.Minute)
+			if name == "past_from" {
+				// the index is built first, the page falls outside of it
+				next.listCalls--
+			}
+
+			for range 2 {
+				if _, err := repo.ListMatches(context.Background(), filter); err != nil {
+					t.Fatalf("list matches: %v", err)
+				}
+			}
+			if next.listCalls != 2 {
+				t.Fatalf("expected every call to reach the database, got %d", next.listCalls)
+			}
+		})
+	}
+}
+
+func TestRepository_IncompleteIndex(t *testing.T) {
+	next := &repoMock{matches: testMatches()}
+	repo := newTestRepository(next, newStoreMock(), time.Minute)
+	repo.indexLimit = 3
+	filter := models.MatchFilter{FromUTC: testNow}
+
+	want := listAll(t, &repoMock{matches: testMatches()}, filter, 2)
+	calls := next.listCalls
+	got := listAll(t, repo, filter, 2)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	// build, then the second page runs past the cut index
+	if next.listCalls-calls != 3 {
+		t.Fatalf("expected 3 database calls, got %d", next.listCalls-calls)
+	}
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) ResolveStadiumID(_ context.Context, name string) (string, error) {
+	alias := models.NormalizeStadiumAlias(name)
+	if alias == "" {
+		return "", derr.ErrStadiumNotFound
+	}
+
+	stadiumID, ok := r.stadiumAliases[alias]
+	if !ok {
+		return "", derr.ErrStadiumNotFound
+	}
+	return stadiumID, nil
+}
+
+func (r *Repository) GetStadiums(_ context.Context, ids []string) (map[string]models.Stadium, error) {
+	stadiums := make(map[string]models.Stadium, len(ids))
+	for _, id := range ids {
+		stadium, ok := r.stadiums[id]
+		if !ok {
+			continue
+		}
+		stadium.Airports = slices.Clone(stadium.Airports)
+		stadiums[id] = stadium
+	}
+	return stadiums, nil
+}
+
+func (r *Repository) GetCityAliases(_ context.Context) ([]models.CityAlias, error) {
+	return slices.Clone(r.cityAliases), nil
+}
+
+func (r *Repository) RecordUnresolvedCity(_ context.Context, name string, matchID models.MatchID) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := storedTime(r.now())
+	city, ok := r.unresolved[name]
+	if !ok {
+		city = models.UnresolvedCity{Name: name, FirstSeen: now}
+	}
+	city.Occurrences++
+	city.LastSeen = now
+	if id, err := strconv.ParseInt(string(matchID), 10, 64); err == nil {
+		city.LastMatchID = models.MatchID(strconv.FormatInt(id, 10))
+	}
+
+	r.unresolved[name] = city
+	r.dirty = true
+	return nil
+}
+
+func (r *Repository) GetUnresolvedCities(_ context.Context) ([]models.UnresolvedCity, error) {
+	r.mu.RLock()
+	cities := make([]models.UnresolvedCity, 0, len(r.unresolved))
+	for _, city := range r.unresolved {
+		cities = append(cities, city)
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(cities, func(a, b models.UnresolvedCity) int {
+		if c := b.LastSeen.Compare(a.LastSeen); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return cities, nil
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) ListMatchOverrides(_ context.Context, ids []models.MatchID) ([]models.MatchOverride, error) {
+	matchIDs := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		parsed, err := strconv.ParseInt(string(id), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse match id %q: %w", id, err)
+		}
+		matchIDs[parsed] = struct{}{}
+	}
+
+	r.mu.RLock()
+	var keys []overrideKey
+	for key := range r.overrides {
+		if _, ok := matchIDs[key.matchID]; ok || len(matchIDs) == 0 {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b overrideKey) int {
+		if c := cmp.Compare(a.matchID, b.matchID); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.field), string(b.field))
+	})
+
+	var overrides []models.MatchOverride
+	for _, key := range keys {
+		overrides = append(overrides, r.overrides[key])
+	}
+	r.mu.RUnlock()
+
+	return overrides, nil
+}
+
+func (r *Repository) UpsertMatchOverride(_ context.Context, override models.MatchOverride) (models.MatchOverride, error) {
+	matchID, err := strconv.ParseInt(string(override.MatchID), 10, 64)
+	if err != nil {
+		return models.MatchOverride{}, fmt.Errorf("parse match id %q: %w", override.MatchID, err)
+	}
+
+	if !override.ExpiresAt.IsZero() {
+		override.ExpiresAt = storedTime(override.ExpiresAt)
+	}
+	override.MatchID = models.MatchID(strconv.FormatInt(matchID, 10))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := overrideKey{matchID: matchID, field: override.Field}
+	now := storedTime(r.now())
+	override.CreatedAt = now
+	if existing, ok := r.overrides[key]; ok {
+		override.CreatedAt = existing.CreatedAt
+	}
+	override.UpdatedAt = now
+
+	r.overrides[key] = override
+	r.dirty = true
+	return override, nil
+}
+
+func (r *Repository) DeleteMatchOverride(_ context.Context, id models.MatchID, field models.OverrideField) error {
+	matchID, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse match id %q: %w", id, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := overrideKey{matchID: matchID, field: field}
+	if _, ok := r.overrides[key]; !ok {
+		return derr.ErrOverrideNotFound
+	}
+
+	delete(r.overrides, key)
+	r.dirty = true
+	return nil
+}
@@ -0,0 +1,314 @@
+// Package memory is a MatchRepository kept in process memory for local
+// development and tests. Dictionaries are seeded from the SQL migrations,
+// everything written at runtime can be persisted to a JSON snapshot.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/postgres/migrations"
+	"go.uber.org/zap"
+)
+
+type storedMatch struct {
+	match     models.Match
+	updatedAt time.Time
+}
+
+type overrideKey struct {
+	matchID int64
+	field   models.OverrideField
+}
+
+type Repository struct {
+	log          *zap.Logger
+	snapshotPath string
+	now          func() time.Time
+
+	mu         sync.RWMutex
+	dirty      bool
+	matches    map[int64]storedMatch
+	overrides  map[overrideKey]models.MatchOverride
+	unresolved map[string]models.UnresolvedCity
+	responses  []models.SourceResponse
+
+	clubs          []models.Club
+	stadiums       map[string]models.Stadium
+	stadiumAliases map[string]string
+	cityAliases    []models.CityAlias
+}
+
+// New seeds the dictionaries from the migrations and loads the snapshot at
+// snapshotPath when it exists. An empty snapshotPath keeps nothing on disk.
+func New(log *zap.Logger, snapshotPath string) (*Repository, error) {
+	seed, err := loadSeed(migrations.FS)
+	if err != nil {
+		return nil, fmt.Errorf("seed from migrations: %w", err)
+	}
+
+	stadiums, stadiumAliases, err := seed.stadiums()
+	if err != nil {
+		return nil, fmt.Errorf("seed stadiums: %w", err)
+	}
+
+	clubs := seed.clubs()
+	slices.SortStableFunc(clubs, func(a, b models.Club) int {
+		return strings.Compare(a.NameRU, b.NameRU)
+	})
+
+	r := &Repository{
+		log:            log,
+		snapshotPath:   snapshotPath,
+		now:            time.Now,
+		matches:        make(map[int64]storedMatch),
+		overrides:      make(map[overrideKey]models.MatchOverride),
+		unresolved:     make(map[string]models.UnresolvedCity),
+		clubs:          clubs,
+		stadiums:       stadiums,
+		stadiumAliases: stadiumAliases,
+		cityAliases:    seed.cityAliases(),
+	}
+
+	if snapshotPath != "" {
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Close writes the snapshot.
+func (r *Repository) Close() {
+	if err := r.Save(); err != nil {
+		r.log.Warn("failed to save memory snapshot", zap.String("path", r.snapshotPath), zap.Error(err))
+	}
+}
+
+func (r *Repository) GetByID(ctx context.Context, id models.MatchID) (models.Match, error) {
+	match, _, err := r.GetByIDWithUpdatedAt(ctx, id)
+	return match, err
+}
+
+func (r *Repository) GetByIDWithUpdatedAt(_ context.Context, id models.MatchID) (models.Match, time.Time, error) {
+	matchID, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return models.Match{}, time.Time{}, fmt.Errorf("parse match id %q: %w", id, err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored, ok := r.matches[matchID]
+	if !ok {
+		return models.Match{}, time.Time{}, derr.ErrMatchNotFound
+	}
+	return copyMatch(stored.match), stored.updatedAt, nil
+}
+
+func (r *Repository) GetByIDs(_ context.Context, ids []models.MatchID) ([]models.Match, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	matchIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.ParseInt(string(id), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse match id %q: %w", id, err)
+		}
+		matchIDs = append(matchIDs, n)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := make([]models.Match, 0, len(matchIDs))
+	seen := make(map[int64]struct{}, len(matchIDs))
+	for _, id := range matchIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if stored, ok := r.matches[id]; ok {
+			matches = append(matches, copyMatch(stored.match))
+		}
+	}
+
+	return matches, nil
+}
+
+// ListMatches applies the filter the way the postgres query does.
+func (r *Repository) ListMatches(_ context.Context, filter models.MatchFilter) ([]models.Match, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var afterID int64
+	if filter.After != nil {
+		id, err := strconv.ParseInt(string(filter.After.ID), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse cursor match id %q: %w", filter.After.ID, err)
+		}
+		afterID = id
+	}
+
+	competition := strings.TrimSpace(filter.Competition)
+	iata := strings.ToUpper(strings.TrimSpace(filter.DestinationIATA))
+	city := strings.ToLower(strings.TrimSpace(filter.City))
+	clubIDs := make([]string, 0, len(filter.ClubIDs))
+	for _, id := range filter.ClubIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			clubIDs = append(clubIDs, id)
+		}
+	}
+
+	type row struct {
+		id    int64
+		match models.Match
+	}
+
+	r.mu.RLock()
+	rows := make([]row, 0, len(r.matches))
+	for id, stored := range r.matches {
+		m := stored.match
+		if !filter.FromUTC.IsZero() && m.KickoffUTC.Before(filter.FromUTC) {
+			continue
+		}
+		if !filter.ToUTC.IsZero() && m.KickoffUTC.After(filter.ToUTC) {
+			continue
+		}
+		if competition != "" && m.Competition != competition {
+			continue
+		}
+		if iata != "" && m.DestinationIATA != iata {
+			continue
+		}
+		if city != "" && strings.ToLower(m.City) != city {
+			continue
+		}
+		if len(clubIDs) > 0 && !matchesClubs(m, clubIDs, filter.ClubSide) {
+			continue
+		}
+		if filter.After != nil {
+			c := compareKeyset(m.KickoffUTC, id, filter.After.KickoffUTC, afterID)
+			if (filter.Order == models.SortDesc && c >= 0) || (filter.Order != models.SortDesc && c <= 0) {
+				continue
+			}
+		}
+		rows = append(rows, row{id: id, match: copyMatch(m)})
+	}
+	r.mu.RUnlock()
+
+	slices.SortFunc(rows, func(a, b row) int {
+		c := compareKeyset(a.match.KickoffUTC, a.id, b.match.KickoffUTC, b.id)
+		if filter.Order == models.SortDesc {
+			return -c
+		}
+		return c
+	})
+	if len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	matches := make([]models.Match, 0, len(rows))
+	for _, row := range rows {
+		matches = append(matches, row.match)
+	}
+	return matches, nil
+}
+
+func (r *Repository) GetClubs(_ context.Context) ([]models.Club, error) {
+	return slices.Clone(r.clubs), nil
+}
+
+func (r *Repository) Upsert(_ context.Context, match models.Match) (models.Match, error) {
+	matchID, err := strconv.ParseInt(string(match.ID), 10, 64)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("parse match id %q: %w", match.ID, err)
+	}
+
+	stored := copyMatch(match)
+	stored.ID = models.MatchID(strconv.FormatInt(matchID, 10))
+	stored.Competition = strings.TrimSpace(stored.Competition)
+	if stored.Competition == "" {
+		stored.Competition = models.CompetitionPremierLeague
+	}
+	stored.KickoffUTC = storedTime(stored.KickoffUTC)
+	source := match.SourceValues()
+	source.KickoffUTC = storedTime(source.KickoffUTC)
+	stored.SetSourceValues(source)
+	stored.Venue = nil
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored.KickoffRevision = 0
+	if existing, ok := r.matches[matchID]; ok {
+		stored.KickoffRevision = existing.match.KickoffRevision
+		if !existing.match.KickoffUTC.Equal(stored.KickoffUTC) {
+			stored.KickoffRevision++
+		}
+		if stored.HomeScore == nil {
+			stored.HomeScore = existing.match.HomeScore
+		}
+		if stored.AwayScore == nil {
+			stored.AwayScore = existing.match.AwayScore
+		}
+	}
+
+	r.matches[matchID] = storedMatch{match: stored, updatedAt: storedTime(r.now())}
+	r.dirty = true
+	return copyMatch(stored), nil
+}
+
+func matchesClubs(m models.Match, clubIDs []string, side models.ClubSide) bool {
+	home := slices.Contains(clubIDs, m.HomeTeam)
+	away := slices.Contains(clubIDs, m.AwayTeam)
+	switch side {
+	case models.ClubSideHome:
+		return home
+	case models.ClubSideAway:
+		return away
+	default:
+		return home || away
+	}
+}
+
+func compareKeyset(aKickoff time.Time, aID int64, bKickoff time.Time, bID int64) int {
+	if c := aKickoff.Compare(bKickoff); c != 0 {
+		return c
+	}
+	return cmp.Compare(aID, bID)
+}
+
+func copyMatch(m models.Match) models.Match {
+	if m.HomeScore != nil {
+		score := *m.HomeScore
+		m.HomeScore = &score
+	}
+	if m.AwayScore != nil {
+		score := *m.AwayScore
+		m.AwayScore = &score
+	}
+	if m.Source != nil {
+		source := *m.Source
+		m.Source = &source
+	}
+	return m
+}
+
+// storedTime drops what timestamptz can't hold.
+func storedTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
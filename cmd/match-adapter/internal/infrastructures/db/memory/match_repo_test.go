@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/repotest"
+	"go.uber.org/zap"
+)
+
+func TestRepository_Contract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repository {
+		repo, err := New(zap.NewNop(), "")
+		if err != nil {
+			t.Fatalf("new memory repository: %v", err)
+		}
+		return repo
+	})
+}
+
+func TestRepository_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	kickoff := time.Date(2026, 3, 8, 16, 0, 0, 0, time.UTC)
+
+	repo, err := New(zap.NewNop(), path)
+	if err != nil {
+		t.Fatalf("new memory repository: %v", err)
+	}
+	if _, err := repo.Upsert(ctx, models.Match{ID: "16114", HomeTeam: "3", AwayTeam: "4", KickoffUTC: kickoff}); err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if _, err := repo.Upsert(ctx, models.Match{ID: "16114", HomeTeam: "3", AwayTeam: "4", KickoffUTC: kickoff.Add(time.Hour)}); err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if _, err := repo.UpsertMatchOverride(ctx, models.MatchOverride{MatchID: "16114", Field: models.OverrideCity, Value: "Казань", Author: "ops", Reason: "r"}); err != nil {
+		t.Fatalf("upsert override: %v", err)
+	}
+	repo.Close()
+
+	reopened, err := New(zap.NewNop(), path)
+	if err != nil {
+		t.Fatalf("reopen memory repository: %v", err)
+	}
+	match, err := reopened.GetByID(ctx, "16114")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if !match.KickoffUTC.Equal(kickoff.Add(time.Hour)) || match.KickoffRevision != 1 || match.HomeTeam != "3" {
+		t.Fatalf("unexpected match after reload %+v", match)
+	}
+	overrides, err := reopened.ListMatchOverrides(ctx, nil)
+	if err != nil || len(overrides) != 1 || overrides[0].Value != "Казань" {
+		t.Fatalf("unexpected overrides after reload %+v %v", overrides, err)
+	}
+}
+
+func TestLoadSeed_LaterMigrationsWin(t *testing.T) {
+	repo, err := New(zap.NewNop(), "")
+	if err != nil {
+		t.Fatalf("new memory repository: %v", err)
+	}
+
+	clubs, err := repo.GetClubs(context.Background())
+	if err != nil {
+		t.Fatalf("get clubs: %v", err)
+	}
+	if len(clubs) != 16 {
+		t.Fatalf("expected 16 seeded clubs, got %d", len(clubs))
+	}
+	for _, club := range clubs {
+		// 004 adds logo, city and airport to the rows created by 002
+		if club.Logo == "" || club.AirportIATA == "" {
+			t.Fatalf("expected club metadata from migration 004, got %+v", club)
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package memory
+
+import (
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+// seedTables are the dictionary tables copied from the migrations, with the
+// columns that make up their primary key.
+var seedTables = map[string][]string{
+	"club_dictionary":  {"club_id"},
+	"stadiums":         {"stadium_id"},
+	"stadium_aliases":  {"alias"},
+	"stadium_airports": {"stadium_id", "airport_iata"},
+	"cities":           {"city_id"},
+	"city_aliases":     {"alias"},
+}
+
+type seedRow map[string]string
+
+// seedData is the state of the dictionary tables after all migrations ran.
+type seedData struct {
+	tables map[string]map[string]seedRow
+	order  map[string][]string
+}
+
+// loadSeed replays the INSERT statements of the migrations in file order.
+// Every insert of the migrations is an upsert, so a later row replaces the
+// columns it lists and keeps the others.
+func loadSeed(migrations fs.FS) (seedData, error) {
+	names, err := fs.Glob(migrations, "*.sql")
+	if err != nil {
+		return seedData{}, fmt.Errorf("list migrations: %w", err)
+	}
+	slices.Sort(names)
+
+	seed := seedData{
+		tables: make(map[string]map[string]seedRow, len(seedTables)),
+		order:  make(map[string][]string, len(seedTables)),
+	}
+	for _, name := range names {
+		data, err := fs.ReadFile(migrations, name)
+		if err != nil {
+			return seedData{}, fmt.Errorf("read migration %s: %w", name, err)
+		}
+		if err := seed.apply(string(data)); err != nil {
+			return seedData{}, fmt.Errorf("migration %s: %w", name, err)
+		}
+	}
+
+	return seed, nil
+}
+
+func (s *seedData) apply(sql string) error {
+	p := &sqlParser{src: sql}
+	for {
+		table, ok := p.nextInsert()
+		if !ok {
+			return nil
+		}
+		key, known := seedTables[table]
+
+		columns, err := p.identList()
+		if err != nil {
+			return fmt.Errorf("insert into %s: %w", table, err)
+		}
+		if !p.keyword("VALUES") {
+			return fmt.Errorf("insert into %s: expected VALUES", table)
+		}
+
+		for {
+			values, err := p.valueList()
+			if err != nil {
+				return fmt.Errorf("insert into %s: %w", table, err)
+			}
+			if len(values) != len(columns) {
+				return fmt.Errorf("insert into %s: %d values for %d columns", table, len(values), len(columns))
+			}
+			if known {
+				s.upsert(table, key, columns, values)
+			}
+			if !p.consume(',') {
+				break
+			}
+		}
+	}
+}
+
+func (s *seedData) upsert(table string, key, columns, values []string) {
+	row := make(seedRow, len(columns))
+	for i, column := range columns {
+		row[column] = values[i]
+	}
+
+	parts := make([]string, 0, len(key))
+	for _, column := range key {
+		parts = append(parts, row[column])
+	}
+	id := strings.Join(parts, "\x00")
+
+	rows := s.tables[table]
+	if rows == nil {
+		rows = make(map[string]seedRow)
+		s.tables[table] = rows
+	}
+	existing, ok := rows[id]
+	if !ok {
+		rows[id] = row
+		s.order[table] = append(s.order[table], id)
+		return
+	}
+	for column, value := range row {
+		existing[column] = value
+	}
+}
+
+func (s *seedData) rows(table string) []seedRow {
+	rows := make([]seedRow, 0, len(s.order[table]))
+	for _, id := range s.order[table] {
+		rows = append(rows, s.tables[table][id])
+	}
+	return rows
+}
+
+func (s *seedData) clubs() []models.Club {
+	citiesEN := s.cityNamesEN()
+	var clubs []models.Club
+	for _, row := range s.rows("club_dictionary") {
+		clubs = append(clubs, models.Club{
+			ID:          row["club_id"],
+			NameRU:      row["name_ru"],
+			NameEN:      row["name_en"],
+			Logo:        row["logo"],
+			City:        row["city"],
+			CityEN:      citiesEN[row["city"]],
+			AirportIATA: row["airport_iata"],
+		})
+	}
+	return clubs
+}
+
+func (s *seedData) stadiums() (map[string]models.Stadium, map[string]string, error) {
+	citiesEN := s.cityNamesEN()
+	stadiums := make(map[string]models.Stadium)
+	for _, row := range s.rows("stadiums") {
+		lat, err := strconv.ParseFloat(row["latitude"], 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("stadium %s latitude: %w", row["stadium_id"], err)
+		}
+		lon, err := strconv.ParseFloat(row["longitude"], 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("stadium %s longitude: %w", row["stadium_id"], err)
+		}
+		stadiums[row["stadium_id"]] = models.Stadium{
+			ID:        row["stadium_id"],
+			Name:      row["name"],
+			NameEN:    row["name_en"],
+			City:      row["city"],
+			CityEN:    citiesEN[row["city"]],
+			Latitude:  lat,
+			Longitude: lon,
+			Timezone:  row["timezone"],
+		}
+	}
+
+	for _, row := range s.rows("stadium_airports") {
+		stadium, ok := stadiums[row["stadium_id"]]
+		if !ok {
+			continue
+		}
+		minutes, err := strconv.Atoi(row["transfer_minutes"])
+		if err != nil {
+			return nil, nil, fmt.Errorf("stadium %s transfer minutes: %w", row["stadium_id"], err)
+		}
+		stadium.Airports = append(stadium.Airports, models.StadiumAirport{
+			IATA:         row["airport_iata"],
+			TransferTime: time.Duration(minutes) * time.Minute,
+		})
+		stadiums[stadium.ID] = stadium
+	}
+	for id, stadium := range stadiums {
+		slices.SortFunc(stadium.Airports, func(a, b models.StadiumAirport) int {
+			if a.TransferTime != b.TransferTime {
+				return int(a.TransferTime - b.TransferTime)
+			}
+			return strings.Compare(a.IATA, b.IATA)
+		})
+		stadiums[id] = stadium
+	}
+
+	aliases := make(map[string]string)
+	for _, row := range s.rows("stadium_aliases") {
+		aliases[row["alias"]] = row["stadium_id"]
+	}
+
+	return stadiums, aliases, nil
+}
+
+func (s *seedData) cityAliases() []models.CityAlias {
+	cities := make(map[string]models.City)
+	var aliases []models.CityAlias
+	for _, row := range s.rows("cities") {
+		city := models.City{ID: row["city_id"], Name: row["name"], NameEN: row["name_en"], IATA: row["iata"]}
+		cities[city.ID] = city
+		aliases = append(aliases, models.CityAlias{Alias: city.Name, City: city})
+	}
+	for _, row := range s.rows("city_aliases") {
+		city, ok := cities[row["city_id"]]
+		if !ok {
+			continue
+		}
+		aliases = append(aliases, models.CityAlias{Alias: row["alias"], City: city})
+	}
+	return aliases
+}
+
+// cityNamesEN maps city names to English the way the repository joins
+// clubs and stadiums with the cities table.
+func (s *seedData) cityNamesEN() map[string]string {
+	names := make(map[string]string)
+	for _, row := range s.rows("cities") {
+		names[row["name"]] = row["name_en"]
+	}
+	return names
+}
+
+// sqlParser understands just enough SQL to read the INSERT ... VALUES
+// statements of the migrations: quoted strings, numbers and keywords.
+type sqlParser struct {
+	src string
+	pos int
+}
+
+func (p *sqlParser) nextInsert() (string, bool) {
+	const insert = "INSERT INTO"
+	for {
+		for p.pos+len(insert) <= len(p.src) && !strings.EqualFold(p.src[p.pos:p.pos+len(insert)], insert) {
+			p.pos++
+		}
+		if p.pos+len(insert) > len(p.src) {
+			return "", false
+		}
+		p.pos += len(insert)
+		p.skipSpace()
+
+		name := p.ident()
+		if name == "" {
+			continue
+		}
+		return strings.TrimPrefix(name, "public."), true
+	}
+}
+
+func (p *sqlParser) identList() ([]string, error) {
+	if !p.consume('(') {
+		return nil, fmt.Errorf("expected column list at offset %d", p.pos)
+	}
+
+	var idents []string
+	for {
+		p.skipSpace()
+		ident := p.ident()
+		if ident == "" {
+			return nil, fmt.Errorf("expected column name at offset %d", p.pos)
+		}
+		idents = append(idents, ident)
+		if p.consume(')') {
+			return idents, nil
+		}
+		if !p.consume(',') {
+			return nil, fmt.Errorf("expected , or ) at offset %d", p.pos)
+		}
+	}
+}
+
+func (p *sqlParser) valueList() ([]string, error) {
+	if !p.consume('(') {
+		return nil, fmt.Errorf("expected values at offset %d", p.pos)
+	}
+
+	var values []string
+	for {
+		p.skipSpace()
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if p.consume(')') {
+			return values, nil
+		}
+		if !p.consume(',') {
+			return nil, fmt.Errorf("expected , or ) at offset %d", p.pos)
+		}
+	}
+}
+
+func (p *sqlParser) value() (string, error) {
+	if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+		var b strings.Builder
+		for p.pos++; p.pos < len(p.src); p.pos++ {
+			ch := p.src[p.pos]
+			if ch != '\'' {
+				b.WriteByte(ch)
+				continue
+			}
+			if p.pos+1 < len(p.src) && p.src[p.pos+1] == '\'' {
+				b.WriteByte('\'')
+				p.pos++
+				continue
+			}
+			p.pos++
+			return b.String(), nil
+		}
+		return "", fmt.Errorf("unterminated string")
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) && strings.IndexByte("+-.0123456789", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", fmt.Errorf("unsupported value at offset %d", p.pos)
+	}
+	return p.src[start:p.pos], nil
+}
+
+func (p *sqlParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		ch := rune(p.src[p.pos])
+		if ch != '_' && ch != '.' && !unicode.IsLetter(ch) && !unicode.IsDigit(ch) {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *sqlParser) keyword(word string) bool {
+	p.skipSpace()
+	end := p.pos + len(word)
+	if end > len(p.src) || !strings.EqualFold(p.src[p.pos:end], word) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *sqlParser) consume(ch byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == ch {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSpace skips whitespace and -- comments.
+func (p *sqlParser) skipSpace() {
+	for p.pos < len(p.src) {
+		switch {
+		case unicode.IsSpace(rune(p.src[p.pos])):
+			p.pos++
+		case strings.HasPrefix(p.src[p.pos:], "--"):
+			end := strings.IndexByte(p.src[p.pos:], '\n')
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 1
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+// snapshot holds the tables written at runtime. Dictionaries are not part of
+// it, they always come from the migrations.
+type snapshot struct {
+	Matches          []snapshotMatch         `json:"matches"`
+	Overrides        []models.MatchOverride  `json:"match_overrides"`
+	UnresolvedCities []models.UnresolvedCity `json:"unresolved_cities"`
+	SourceResponses  []models.SourceResponse `json:"source_responses"`
+}
+
+type snapshotMatch struct {
+	Match     models.Match `json:"match"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// Save writes the snapshot if anything changed since the last save. The file
+// is replaced atomically.
+func (r *Repository) Save() error {
+	if r.snapshotPath == "" {
+		return nil
+	}
+
+	r.mu.Lock()
+	if !r.dirty {
+		r.mu.Unlock()
+		return nil
+	}
+	snap := snapshot{
+		Overrides:       make([]models.MatchOverride, 0, len(r.overrides)),
+		SourceResponses: r.responses,
+	}
+	for _, stored := range r.matches {
+		snap.Matches = append(snap.Matches, snapshotMatch{Match: stored.match, UpdatedAt: stored.updatedAt})
+	}
+	for _, override := range r.overrides {
+		snap.Overrides = append(snap.Overrides, override)
+	}
+	for _, city := range r.unresolved {
+		snap.UnresolvedCities = append(snap.UnresolvedCities, city)
+	}
+	data, err := json.Marshal(snap)
+	r.dirty = false
+	r.mu.Unlock()
+	if err != nil {
+		r.markDirty()
+		return fmt.Errorf("marshal memory snapshot: %w", err)
+	}
+
+	if err := writeFileAtomic(r.snapshotPath, data); err != nil {
+		r.markDirty()
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) markDirty() {
+	r.mu.Lock()
+	r.dirty = true
+	r.mu.Unlock()
+}
+
+func (r *Repository) load() error {
+	data, err := os.ReadFile(r.snapshotPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read memory snapshot: %w", err)
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return fmt.Errorf("parse memory snapshot %s: %w", r.snapshotPath, err)
+	}
+
+	for _, stored := range snap.Matches {
+		id, err := strconv.ParseInt(string(stored.Match.ID), 10, 64)
+		if err != nil {
+			return fmt.Errorf("memory snapshot: parse match id %q: %w", stored.Match.ID, err)
+		}
+		r.matches[id] = storedMatch{match: stored.Match, updatedAt: stored.UpdatedAt}
+	}
+	for _, override := range snap.Overrides {
+		id, err := strconv.ParseInt(string(override.MatchID), 10, 64)
+		if err != nil {
+			return fmt.Errorf("memory snapshot: parse match id %q: %w", override.MatchID, err)
+		}
+		r.overrides[overrideKey{matchID: id, field: override.Field}] = override
+	}
+	for _, city := range snap.UnresolvedCities {
+		r.unresolved[city.Name] = city
+	}
+	r.responses = snap.SourceResponses
+
+	return nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create snapshot dir: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write memory snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("rename memory snapshot: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) StoreSourceResponse(_ context.Context, resp models.SourceResponse) error {
+	if resp.FetchedAt.IsZero() {
+		resp.FetchedAt = r.now()
+	}
+	resp.FetchedAt = storedTime(resp.FetchedAt)
+	resp.RequestBody = slices.Clone(resp.RequestBody)
+	resp.Body = slices.Clone(resp.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.responses = append(r.responses, resp)
+	r.dirty = true
+	return nil
+}
+
+func (r *Repository) LatestSourceResponse(_ context.Context, source, endpoint string, requestBody []byte) (models.SourceResponse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		latest models.SourceResponse
+		found  bool
+	)
+	for _, resp := range r.responses {
+		if resp.Source != source || resp.Endpoint != endpoint || !bytes.Equal(resp.RequestBody, requestBody) {
+			continue
+		}
+		if !found || resp.FetchedAt.After(latest.FetchedAt) {
+			latest = resp
+			found = true
+		}
+	}
+	if !found {
+		return models.SourceResponse{}, derr.ErrSourceResponseNotFound
+	}
+
+	latest.RequestBody = slices.Clone(latest.RequestBody)
+	latest.Body = slices.Clone(latest.Body)
+	return latest, nil
+}
+
+func (r *Repository) PurgeSourceResponses(_ context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.responses[:0]
+	for _, resp := range r.responses {
+		if !resp.FetchedAt.Before(before) {
+			kept = append(kept, resp)
+		}
+	}
+	removed := int64(len(r.responses) - len(kept))
+	clear(r.responses[len(kept):])
+	r.responses = kept
+	if removed > 0 {
+		r.dirty = true
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,5 @@
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS competition TEXT NOT NULL DEFAULT 'rpl';
+
+CREATE INDEX IF NOT EXISTS matches_competition_kickoff_utc_idx
+  ON public.matches (competition, kickoff_utc);
@@ -0,0 +1,2 @@
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS kickoff_revision INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+CREATE INDEX IF NOT EXISTS matches_kickoff_utc_match_id_idx
+  ON public.matches (kickoff_utc, match_id);
+
+CREATE INDEX IF NOT EXISTS matches_destination_iata_kickoff_utc_idx
+  ON public.matches (destination_iata, kickoff_utc);
+
+CREATE INDEX IF NOT EXISTS matches_city_lower_kickoff_utc_idx
+  ON public.matches (lower(city), kickoff_utc);
+
+CREATE INDEX IF NOT EXISTS matches_club_home_id_kickoff_utc_idx
+  ON public.matches (club_home_id, kickoff_utc);
+
+CREATE INDEX IF NOT EXISTS matches_club_away_id_kickoff_utc_idx
+  ON public.matches (club_away_id, kickoff_utc);
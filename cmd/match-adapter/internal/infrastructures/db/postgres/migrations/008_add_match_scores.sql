@@ -0,0 +1,3 @@
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS home_score INTEGER,
+  ADD COLUMN IF NOT EXISTS away_score INTEGER;
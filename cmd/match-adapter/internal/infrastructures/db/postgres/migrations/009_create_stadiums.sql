@@ -0,0 +1,108 @@
+CREATE TABLE IF NOT EXISTS public.stadiums (
+  stadium_id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  city TEXT NOT NULL,
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  timezone TEXT NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- alias is the source spelling lower-cased, without quotes and with single spaces
+CREATE TABLE IF NOT EXISTS public.stadium_aliases (
+  alias TEXT PRIMARY KEY,
+  stadium_id TEXT NOT NULL REFERENCES public.stadiums (stadium_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS public.stadium_airports (
+  stadium_id TEXT NOT NULL REFERENCES public.stadiums (stadium_id) ON DELETE CASCADE,
+  airport_iata TEXT NOT NULL,
+  transfer_minutes INTEGER NOT NULL,
+  PRIMARY KEY (stadium_id, airport_iata)
+);
+
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS stadium_id TEXT REFERENCES public.stadiums (stadium_id) ON DELETE SET NULL;
+
+INSERT INTO public.stadiums (stadium_id, name, city, latitude, longitude, timezone)
+VALUES
+  ('gazprom-arena', 'Газпром Арена', 'Санкт-Петербург', 59.9728, 30.2204, 'Europe/Moscow'),
+  ('lukoil-arena', 'Лукойл Арена', 'Москва', 55.8177, 37.4403, 'Europe/Moscow'),
+  ('veb-arena', 'ВЭБ Арена', 'Москва', 55.7911, 37.5161, 'Europe/Moscow'),
+  ('rzd-arena', 'РЖД Арена', 'Москва', 55.8033, 37.7411, 'Europe/Moscow'),
+  ('vtb-arena', 'ВТБ Арена', 'Москва', 55.7916, 37.5597, 'Europe/Moscow'),
+  ('ak-bars-arena', 'Ак Барс Арена', 'Казань', 55.8207, 49.1612, 'Europe/Moscow'),
+  ('solidarnost-arena', 'Солидарность Самара Арена', 'Самара', 53.2777, 50.2368, 'Europe/Samara'),
+  ('rostov-arena', 'Ростов Арена', 'Ростов-на-Дону', 47.2094, 39.7378, 'Europe/Moscow'),
+  ('anzhi-arena', 'Анжи Арена', 'Каспийск', 42.8877, 47.6293, 'Europe/Moscow'),
+  ('rostec-arena', 'Ростех Арена', 'Калининград', 54.6983, 20.5336, 'Europe/Kaliningrad'),
+  ('gazovik', 'Газовик', 'Оренбург', 51.7707, 55.1058, 'Asia/Yekaterinburg'),
+  ('fisht', 'Фишт', 'Сочи', 43.4023, 39.9560, 'Europe/Moscow'),
+  ('ozon-arena', 'Ozon Арена', 'Краснодар', 45.0446, 39.0293, 'Europe/Moscow'),
+  ('akhmat-arena', 'Ахмат Арена', 'Грозный', 43.3236, 45.7015, 'Europe/Moscow'),
+  ('nizhny-novgorod', 'Нижний Новгород', 'Нижний Новгород', 56.3375, 43.9633, 'Europe/Moscow')
+ON CONFLICT (stadium_id) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  city = EXCLUDED.city,
+  latitude = EXCLUDED.latitude,
+  longitude = EXCLUDED.longitude,
+  timezone = EXCLUDED.timezone,
+  updated_at = now();
+
+INSERT INTO public.stadium_aliases (alias, stadium_id)
+VALUES
+  ('газпром арена', 'gazprom-arena'),
+  ('стадион санкт-петербург', 'gazprom-arena'),
+  ('лукойл арена', 'lukoil-arena'),
+  ('открытие банк арена', 'lukoil-arena'),
+  ('вэб арена', 'veb-arena'),
+  ('арена цска', 'veb-arena'),
+  ('ржд арена', 'rzd-arena'),
+  ('втб арена', 'vtb-arena'),
+  ('втб арена - центральный стадион динамо', 'vtb-arena'),
+  ('ак барс арена', 'ak-bars-arena'),
+  ('солидарность самара арена', 'solidarnost-arena'),
+  ('солидарность арена', 'solidarnost-arena'),
+  ('ростов арена', 'rostov-arena'),
+  ('анжи арена', 'anzhi-arena'),
+  ('ростех арена', 'rostec-arena'),
+  ('стадион калининград', 'rostec-arena'),
+  ('калининград', 'rostec-arena'),
+  ('газовик', 'gazovik'),
+  ('фишт', 'fisht'),
+  ('ozon арена', 'ozon-arena'),
+  ('краснодар', 'ozon-arena'),
+  ('ахмат арена', 'akhmat-arena'),
+  ('нижний новгород', 'nizhny-novgorod')
+ON CONFLICT (alias) DO UPDATE
+SET stadium_id = EXCLUDED.stadium_id;
+
+INSERT INTO public.stadium_airports (stadium_id, airport_iata, transfer_minutes)
+VALUES
+  ('gazprom-arena', 'LED', 50),
+  ('lukoil-arena', 'SVO', 40),
+  ('lukoil-arena', 'VKO', 60),
+  ('lukoil-arena', 'DME', 90),
+  ('veb-arena', 'SVO', 45),
+  ('veb-arena', 'VKO', 55),
+  ('veb-arena', 'DME', 80),
+  ('rzd-arena', 'SVO', 50),
+  ('rzd-arena', 'DME', 70),
+  ('rzd-arena', 'VKO', 75),
+  ('vtb-arena', 'SVO', 40),
+  ('vtb-arena', 'VKO', 55),
+  ('vtb-arena', 'DME', 80),
+  ('ak-bars-arena', 'KZN', 40),
+  ('solidarnost-arena', 'KUF', 45),
+  ('rostov-arena', 'ROV', 45),
+  ('anzhi-arena', 'MCX', 30),
+  ('rostec-arena', 'KGD', 35),
+  ('gazovik', 'REN', 30),
+  ('fisht', 'AER', 25),
+  ('ozon-arena', 'KRR', 35),
+  ('akhmat-arena', 'GRV', 25),
+  ('nizhny-novgorod', 'GOJ', 35)
+ON CONFLICT (stadium_id, airport_iata) DO UPDATE
+SET transfer_minutes = EXCLUDED.transfer_minutes;
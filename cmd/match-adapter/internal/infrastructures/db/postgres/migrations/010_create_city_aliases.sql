@@ -0,0 +1,121 @@
+CREATE TABLE IF NOT EXISTS public.cities (
+  city_id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  iata TEXT NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Aliases are stored as written; the resolver normalises case, ё/е,
+-- "г." prefixes and transliteration on load.
+CREATE TABLE IF NOT EXISTS public.city_aliases (
+  alias TEXT PRIMARY KEY,
+  city_id TEXT NOT NULL REFERENCES public.cities (city_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS public.unresolved_cities (
+  city TEXT PRIMARY KEY,
+  occurrences INTEGER NOT NULL DEFAULT 1,
+  last_match_id BIGINT,
+  first_seen_at timestamptz NOT NULL DEFAULT now(),
+  last_seen_at timestamptz NOT NULL DEFAULT now()
+);
+
+INSERT INTO public.cities (city_id, name, iata)
+VALUES
+  ('moscow', 'Москва', 'MOW'),
+  ('saint-petersburg', 'Санкт-Петербург', 'LED'),
+  ('kazan', 'Казань', 'KZN'),
+  ('samara', 'Самара', 'KUF'),
+  ('tolyatti', 'Тольятти', 'KUF'),
+  ('rostov-on-don', 'Ростов-на-Дону', 'ROV'),
+  ('makhachkala', 'Махачкала', 'MCX'),
+  ('kaspiysk', 'Каспийск', 'MCX'),
+  ('kaliningrad', 'Калининград', 'KGD'),
+  ('orenburg', 'Оренбург', 'REN'),
+  ('sochi', 'Сочи', 'AER'),
+  ('krasnodar', 'Краснодар', 'KRR'),
+  ('grozny', 'Грозный', 'GRV'),
+  ('nizhny-novgorod', 'Нижний Новгород', 'GOJ'),
+  ('voronezh', 'Воронеж', 'VOZ'),
+  ('yekaterinburg', 'Екатеринбург', 'SVX'),
+  ('perm', 'Пермь', 'PEE'),
+  ('ufa', 'Уфа', 'UFA'),
+  ('tula', 'Тула', 'MOW'),
+  ('khimki', 'Химки', 'MOW'),
+  ('ramenskoye', 'Раменское', 'MOW'),
+  ('volgograd', 'Волгоград', 'VOG'),
+  ('saransk', 'Саранск', 'SKX'),
+  ('astrakhan', 'Астрахань', 'ASF'),
+  ('tomsk', 'Томск', 'TOF'),
+  ('novosibirsk', 'Новосибирск', 'OVB'),
+  ('khabarovsk', 'Хабаровск', 'KHV'),
+  ('vladikavkaz', 'Владикавказ', 'OGZ'),
+  ('yaroslavl', 'Ярославль', 'IAR'),
+  ('arkhangelsk', 'Архангельск', 'ARH'),
+  ('ulyanovsk', 'Ульяновск', 'ULV'),
+  ('tyumen', 'Тюмень', 'TJM'),
+  ('chelyabinsk', 'Челябинск', 'CEK'),
+  ('omsk', 'Омск', 'OMS'),
+  ('krasnoyarsk', 'Красноярск', 'KJA'),
+  ('irkutsk', 'Иркутск', 'IKT'),
+  ('vladivostok', 'Владивосток', 'VVO')
+ON CONFLICT (city_id) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  iata = EXCLUDED.iata,
+  updated_at = now();
+
+-- Russian names are matched through city names themselves; English and
+-- alternative spellings go here.
+INSERT INTO public.city_aliases (alias, city_id)
+VALUES
+  ('Moscow', 'moscow'),
+  ('Saint Petersburg', 'saint-petersburg'),
+  ('St. Petersburg', 'saint-petersburg'),
+  ('Санкт Петербург', 'saint-petersburg'),
+  ('СПб', 'saint-petersburg'),
+  ('Петербург', 'saint-petersburg'),
+  ('Kazan', 'kazan'),
+  ('Samara', 'samara'),
+  ('Togliatti', 'tolyatti'),
+  ('Тольятти', 'tolyatti'),
+  ('Rostov-on-Don', 'rostov-on-don'),
+  ('Ростов', 'rostov-on-don'),
+  ('Makhachkala', 'makhachkala'),
+  ('Kaspiysk', 'kaspiysk'),
+  ('Kaliningrad', 'kaliningrad'),
+  ('Orenburg', 'orenburg'),
+  ('Sochi', 'sochi'),
+  ('Krasnodar', 'krasnodar'),
+  ('Grozny', 'grozny'),
+  ('Nizhny Novgorod', 'nizhny-novgorod'),
+  ('Н. Новгород', 'nizhny-novgorod'),
+  ('Voronezh', 'voronezh'),
+  ('Yekaterinburg', 'yekaterinburg'),
+  ('Ekaterinburg', 'yekaterinburg'),
+  ('Perm', 'perm'),
+  ('Ufa', 'ufa'),
+  ('Tula', 'tula'),
+  ('Khimki', 'khimki'),
+  ('Ramenskoye', 'ramenskoye'),
+  ('Volgograd', 'volgograd'),
+  ('Saransk', 'saransk'),
+  ('Astrakhan', 'astrakhan'),
+  ('Tomsk', 'tomsk'),
+  ('Novosibirsk', 'novosibirsk'),
+  ('Khabarovsk', 'khabarovsk'),
+  ('Vladikavkaz', 'vladikavkaz'),
+  ('Yaroslavl', 'yaroslavl'),
+  ('Arkhangelsk', 'arkhangelsk'),
+  ('Ulyanovsk', 'ulyanovsk'),
+  ('Tyumen', 'tyumen'),
+  ('Chelyabinsk', 'chelyabinsk'),
+  ('Omsk', 'omsk'),
+  ('Krasnoyarsk', 'krasnoyarsk'),
+  ('Irkutsk', 'irkutsk'),
+  ('Vladivostok', 'vladivostok')
+ON CONFLICT (alias) DO UPDATE
+SET city_id = EXCLUDED.city_id;
+
+-- city_iata is superseded by cities/city_aliases and kept only for rollback.
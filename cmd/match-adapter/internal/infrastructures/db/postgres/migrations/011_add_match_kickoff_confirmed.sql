@@ -0,0 +1,9 @@
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS kickoff_confirmed BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Premierliga publishes unscheduled rounds at local midnight; the next sync
+-- re-evaluates these, this only keeps the gap until then honest.
+UPDATE public.matches
+SET kickoff_confirmed = FALSE
+WHERE kickoff_utc > now()
+  AND (kickoff_utc AT TIME ZONE 'Europe/Moscow')::time = TIME '00:00';
@@ -0,0 +1,18 @@
+-- Raw upstream responses for audit and replay. Bodies are gzip-compressed,
+-- request_hash is sha256 of the request body and keys the replay lookups.
+CREATE TABLE IF NOT EXISTS public.source_responses (
+  id BIGSERIAL PRIMARY KEY,
+  source TEXT NOT NULL,
+  endpoint TEXT NOT NULL,
+  request_hash TEXT NOT NULL,
+  request_body TEXT NOT NULL,
+  status_code INTEGER NOT NULL,
+  body_gzip BYTEA NOT NULL,
+  fetched_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS source_responses_lookup_idx
+  ON public.source_responses (source, endpoint, request_hash, fetched_at DESC);
+
+CREATE INDEX IF NOT EXISTS source_responses_fetched_at_idx
+  ON public.source_responses (fetched_at);
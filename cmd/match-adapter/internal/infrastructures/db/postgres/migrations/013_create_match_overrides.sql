@@ -0,0 +1,13 @@
+-- Manual corrections of source data, one row per overridden field. They are
+-- written into matches; expired rows are ignored and kept as history.
+CREATE TABLE IF NOT EXISTS public.match_overrides (
+  match_id BIGINT NOT NULL,
+  field TEXT NOT NULL CHECK (field IN ('destination_iata', 'city', 'stadium', 'kickoff_utc', 'tickets_link')),
+  value TEXT NOT NULL,
+  author TEXT NOT NULL,
+  reason TEXT NOT NULL,
+  expires_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (match_id, field)
+);
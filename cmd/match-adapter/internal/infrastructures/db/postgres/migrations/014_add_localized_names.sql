@@ -0,0 +1,73 @@
+-- English names for localized responses. Club and stadium cities are
+-- translated through the cities dictionary by their Russian name.
+ALTER TABLE public.cities
+  ADD COLUMN IF NOT EXISTS name_en TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE public.stadiums
+  ADD COLUMN IF NOT EXISTS name_en TEXT NOT NULL DEFAULT '';
+
+INSERT INTO public.cities (city_id, name, iata, name_en)
+VALUES
+  ('moscow', 'Москва', 'MOW', 'Moscow'),
+  ('saint-petersburg', 'Санкт-Петербург', 'LED', 'Saint Petersburg'),
+  ('kazan', 'Казань', 'KZN', 'Kazan'),
+  ('samara', 'Самара', 'KUF', 'Samara'),
+  ('tolyatti', 'Тольятти', 'KUF', 'Tolyatti'),
+  ('rostov-on-don', 'Ростов-на-Дону', 'ROV', 'Rostov-on-Don'),
+  ('makhachkala', 'Махачкала', 'MCX', 'Makhachkala'),
+  ('kaspiysk', 'Каспийск', 'MCX', 'Kaspiysk'),
+  ('kaliningrad', 'Калининград', 'KGD', 'Kaliningrad'),
+  ('orenburg', 'Оренбург', 'REN', 'Orenburg'),
+  ('sochi', 'Сочи', 'AER', 'Sochi'),
+  ('krasnodar', 'Краснодар', 'KRR', 'Krasnodar'),
+  ('grozny', 'Грозный', 'GRV', 'Grozny'),
+  ('nizhny-novgorod', 'Нижний Новгород', 'GOJ', 'Nizhny Novgorod'),
+  ('voronezh', 'Воронеж', 'VOZ', 'Voronezh'),
+  ('yekaterinburg', 'Екатеринбург', 'SVX', 'Yekaterinburg'),
+  ('perm', 'Пермь', 'PEE', 'Perm'),
+  ('ufa', 'Уфа', 'UFA', 'Ufa'),
+  ('tula', 'Тула', 'MOW', 'Tula'),
+  ('khimki', 'Химки', 'MOW', 'Khimki'),
+  ('ramenskoye', 'Раменское', 'MOW', 'Ramenskoye'),
+  ('volgograd', 'Волгоград', 'VOG', 'Volgograd'),
+  ('saransk', 'Саранск', 'SKX', 'Saransk'),
+  ('astrakhan', 'Астрахань', 'ASF', 'Astrakhan'),
+  ('tomsk', 'Томск', 'TOF', 'Tomsk'),
+  ('novosibirsk', 'Новосибирск', 'OVB', 'Novosibirsk'),
+  ('khabarovsk', 'Хабаровск', 'KHV', 'Khabarovsk'),
+  ('vladikavkaz', 'Владикавказ', 'OGZ', 'Vladikavkaz'),
+  ('yaroslavl', 'Ярославль', 'IAR', 'Yaroslavl'),
+  ('arkhangelsk', 'Архангельск', 'ARH', 'Arkhangelsk'),
+  ('ulyanovsk', 'Ульяновск', 'ULV', 'Ulyanovsk'),
+  ('tyumen', 'Тюмень', 'TJM', 'Tyumen'),
+  ('chelyabinsk', 'Челябинск', 'CEK', 'Chelyabinsk'),
+  ('omsk', 'Омск', 'OMS', 'Omsk'),
+  ('krasnoyarsk', 'Красноярск', 'KJA', 'Krasnoyarsk'),
+  ('irkutsk', 'Иркутск', 'IKT', 'Irkutsk'),
+  ('vladivostok', 'Владивосток', 'VVO', 'Vladivostok')
+ON CONFLICT (city_id) DO UPDATE
+SET
+  name_en = EXCLUDED.name_en,
+  updated_at = now();
+
+INSERT INTO public.stadiums (stadium_id, name, city, latitude, longitude, timezone, name_en)
+VALUES
+  ('gazprom-arena', 'Газпром Арена', 'Санкт-Петербург', 59.9728, 30.2204, 'Europe/Moscow', 'Gazprom Arena'),
+  ('lukoil-arena', 'Лукойл Арена', 'Москва', 55.8177, 37.4403, 'Europe/Moscow', 'Lukoil Arena'),
+  ('veb-arena', 'ВЭБ Арена', 'Москва', 55.7911, 37.5161, 'Europe/Moscow', 'VEB Arena'),
+  ('rzd-arena', 'РЖД Арена', 'Москва', 55.8033, 37.7411, 'Europe/Moscow', 'RZD Arena'),
+  ('vtb-arena', 'ВТБ Арена', 'Москва', 55.7916, 37.5597, 'Europe/Moscow', 'VTB Arena'),
+  ('ak-bars-arena', 'Ак Барс Арена', 'Казань', 55.8207, 49.1612, 'Europe/Moscow', 'Ak Bars Arena'),
+  ('solidarnost-arena', 'Солидарность Самара Арена', 'Самара', 53.2777, 50.2368, 'Europe/Samara', 'Solidarnost Samara Arena'),
+  ('rostov-arena', 'Ростов Арена', 'Ростов-на-Дону', 47.2094, 39.7378, 'Europe/Moscow', 'Rostov Arena'),
+  ('anzhi-arena', 'Анжи Арена', 'Каспийск', 42.8877, 47.6293, 'Europe/Moscow', 'Anzhi Arena'),
+  ('rostec-arena', 'Ростех Арена', 'Калининград', 54.6983, 20.5336, 'Europe/Kaliningrad', 'Rostec Arena'),
+  ('gazovik', 'Газовик', 'Оренбург', 51.7707, 55.1058, 'Asia/Yekaterinburg', 'Gazovik'),
+  ('fisht', 'Фишт', 'Сочи', 43.4023, 39.9560, 'Europe/Moscow', 'Fisht'),
+  ('ozon-arena', 'Ozon Арена', 'Краснодар', 45.0446, 39.0293, 'Europe/Moscow', 'Ozon Arena'),
+  ('akhmat-arena', 'Ахмат Арена', 'Грозный', 43.3236, 45.7015, 'Europe/Moscow', 'Akhmat Arena'),
+  ('nizhny-novgorod', 'Нижний Новгород', 'Нижний Новгород', 56.3375, 43.9633, 'Europe/Moscow', 'Nizhny Novgorod Stadium')
+ON CONFLICT (stadium_id) DO UPDATE
+SET
+  name_en = EXCLUDED.name_en,
+  updated_at = now();
@@ -0,0 +1,31 @@
+-- matches holds the values with manual overrides applied, so filters, ordering
+-- and cursors see them. The source values are kept next to them to restore a
+-- field once its override is deleted or expires.
+ALTER TABLE public.matches
+  ADD COLUMN IF NOT EXISTS source_kickoff_utc timestamptz,
+  ADD COLUMN IF NOT EXISTS source_kickoff_confirmed BOOLEAN,
+  ADD COLUMN IF NOT EXISTS source_city TEXT,
+  ADD COLUMN IF NOT EXISTS source_stadium TEXT,
+  ADD COLUMN IF NOT EXISTS source_stadium_id TEXT REFERENCES public.stadiums (stadium_id) ON DELETE SET NULL,
+  ADD COLUMN IF NOT EXISTS source_destination_iata TEXT,
+  ADD COLUMN IF NOT EXISTS source_tickets_link TEXT;
+
+-- until now overrides were applied on read and rows held the source values
+UPDATE public.matches
+SET
+  source_kickoff_utc = kickoff_utc,
+  source_kickoff_confirmed = kickoff_confirmed,
+  source_city = city,
+  source_stadium = stadium,
+  source_stadium_id = stadium_id,
+  source_destination_iata = destination_iata,
+  source_tickets_link = tickets_link
+WHERE source_kickoff_utc IS NULL;
+
+ALTER TABLE public.matches
+  ALTER COLUMN source_kickoff_utc SET NOT NULL,
+  ALTER COLUMN source_kickoff_confirmed SET NOT NULL,
+  ALTER COLUMN source_city SET NOT NULL,
+  ALTER COLUMN source_stadium SET NOT NULL,
+  ALTER COLUMN source_destination_iata SET NOT NULL,
+  ALTER COLUMN source_tickets_link SET NOT NULL;
@@ -0,0 +1,8 @@
+// Package migrations holds the SQL migrations. They are applied by hand; the
+// embedded copy seeds the dictionaries of the in-memory repository.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
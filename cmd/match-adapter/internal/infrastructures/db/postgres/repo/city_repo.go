@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) GetCityAliases(ctx context.Context) ([]models.CityAlias, error) {
+	const query = `
+		SELECT c.name, c.city_id, c.name, c.name_en, c.iata
+		FROM cities c
+		UNION ALL
+		SELECT a.alias, c.city_id, c.name, c.name_en, c.iata
+		FROM city_aliases a
+		JOIN cities c ON c.city_id = a.city_id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query city aliases: %w", err)
+	}
+	defer rows.Close()
+
+	aliases := make([]models.CityAlias, 0, 128)
+	for rows.Next() {
+		var alias models.CityAlias
+		if err := rows.Scan(&alias.Alias, &alias.City.ID, &alias.City.Name, &alias.City.NameEN, &alias.City.IATA); err != nil {
+			return nil, fmt.Errorf("scan city alias: %w", err)
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate city aliases: %w", err)
+	}
+
+	return aliases, nil
+}
+
+func (r *Repository) RecordUnresolvedCity(ctx context.Context, name string, matchID models.MatchID) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+
+	var lastMatchID *int64
+	if id, err := strconv.ParseInt(string(matchID), 10, 64); err == nil {
+		lastMatchID = &id
+	}
+
+	const query = `
+		INSERT INTO unresolved_cities (city, occurrences, last_match_id, first_seen_at, last_seen_at)
+		VALUES ($1, 1, $2, now(), now())
+		ON CONFLICT (city) DO UPDATE SET
+			occurrences = unresolved_cities.occurrences + 1,
+			last_match_id = COALESCE(EXCLUDED.last_match_id, unresolved_cities.last_match_id),
+			last_seen_at = now()
+	`
+
+	if _, err := r.db.Exec(ctx, query, name, lastMatchID); err != nil {
+		return fmt.Errorf("record unresolved city: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetUnresolvedCities(ctx context.Context) ([]models.UnresolvedCity, error) {
+	const query = `
+		SELECT city, occurrences, last_match_id, first_seen_at, last_seen_at
+		FROM unresolved_cities
+		ORDER BY last_seen_at DESC, city ASC
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query unresolved cities: %w", err)
+	}
+	defer rows.Close()
+
+	cities := make([]models.UnresolvedCity, 0, 16)
+	for rows.Next() {
+		var (
+			city        models.UnresolvedCity
+			lastMatchID *int64
+		)
+		if err := rows.Scan(&city.Name, &city.Occurrences, &lastMatchID, &city.FirstSeen, &city.LastSeen); err != nil {
+			return nil, fmt.Errorf("scan unresolved city: %w", err)
+		}
+		if lastMatchID != nil {
+			city.LastMatchID = models.MatchID(strconv.FormatInt(*lastMatchID, 10))
+		}
+		cities = append(cities, city)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate unresolved cities: %w", err)
+	}
+
+	return cities, nil
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/db/repotest"
+)
+
+// TestRepository_Contract needs a disposable database with all migrations
+// applied. The tables written by the suite are truncated before every test.
+func TestRepository_Contract(t *testing.T) {
+	dsn := os.Getenv("MATCH_ADAPTER_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("MATCH_ADAPTER_TEST_DATABASE_URL is not set")
+	}
+
+	repotest.Run(t, func(t *testing.T) repotest.Repository {
+		ctx := context.Background()
+		repo, err := New(ctx, dsn)
+		if err != nil {
+			t.Fatalf("connect postgres: %v", err)
+		}
+		t.Cleanup(repo.Close)
+
+		const truncate = `TRUNCATE matches, match_overrides, unresolved_cities, source_responses`
+		if _, err := repo.db.Exec(ctx, truncate); err != nil {
+			t.Fatalf("truncate tables: %v", err)
+		}
+		return repo
+	})
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) ListMatchOverrides(ctx context.Context, ids []models.MatchID) ([]models.MatchOverride, error) {
+	matchIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := strconv.ParseInt(string(id), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse match id %q: %w", id, err)
+		}
+		matchIDs = append(matchIDs, parsed)
+	}
+
+	query := `
+		SELECT match_id, field, value, author, reason, expires_at, created_at, updated_at
+		FROM match_overrides
+	`
+	var args []any
+	if len(matchIDs) > 0 {
+		query += "WHERE match_id = ANY($1)\n"
+		args = append(args, matchIDs)
+	}
+	query += "ORDER BY match_id ASC, field ASC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query match overrides: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []models.MatchOverride
+	for rows.Next() {
+		var (
+			matchID   int64
+			field     string
+			expiresAt *time.Time
+			override  models.MatchOverride
+		)
+		if err := rows.Scan(
+			&matchID,
+			&field,
+			&override.Value,
+			&override.Author,
+			&override.Reason,
+			&expiresAt,
+			&override.CreatedAt,
+			&override.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan match override: %w", err)
+		}
+
+		override.MatchID = models.MatchID(strconv.FormatInt(matchID, 10))
+		override.Field = models.OverrideField(field)
+		if expiresAt != nil {
+			override.ExpiresAt = *expiresAt
+		}
+		overrides = append(overrides, override)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate match overrides: %w", err)
+	}
+
+	return overrides, nil
+}
+
+func (r *Repository) UpsertMatchOverride(ctx context.Context, override models.MatchOverride) (models.MatchOverride, error) {
+	matchID, err := strconv.ParseInt(string(override.MatchID), 10, 64)
+	if err != nil {
+		return models.MatchOverride{}, fmt.Errorf("parse match id %q: %w", override.MatchID, err)
+	}
+
+	var expiresAt *time.Time
+	if !override.ExpiresAt.IsZero() {
+		v := override.ExpiresAt.UTC()
+		expiresAt = &v
+	}
+
+	const query = `
+		INSERT INTO match_overrides (match_id, field, value, author, reason, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now(), now())
+		ON CONFLICT (match_id, field) DO UPDATE SET
+			value = EXCLUDED.value,
+			author = EXCLUDED.author,
+			reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = now()
+		RETURNING created_at, updated_at
+	`
+
+	err = r.db.QueryRow(ctx, query,
+		matchID,
+		string(override.Field),
+		override.Value,
+		override.Author,
+		override.Reason,
+		expiresAt,
+	).Scan(&override.CreatedAt, &override.UpdatedAt)
+	if err != nil {
+		return models.MatchOverride{}, fmt.Errorf("upsert match override: %w", err)
+	}
+
+	return override, nil
+}
+
+func (r *Repository) DeleteMatchOverride(ctx context.Context, id models.MatchID, field models.OverrideField) error {
+	matchID, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse match id %q: %w", id, err)
+	}
+
+	const query = `DELETE FROM match_overrides WHERE match_id = $1 AND field = $2`
+
+	tag, err := r.db.Exec(ctx, query, matchID, string(field))
+	if err != nil {
+		return fmt.Errorf("delete match override: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return derr.ErrOverrideNotFound
+	}
+
+	return nil
+}
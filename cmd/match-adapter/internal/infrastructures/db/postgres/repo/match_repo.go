@@ -53,6 +53,11 @@ func (r *Repository) Close() {
 	r.db.Close()
 }
 
+// Stat reports the connection pool for metrics.
+func (r *Repository) Stat() *pgxpool.Stat {
+	return r.db.Stat()
+}
+
 func (r *Repository) GetByID(ctx context.Context, id models.MatchID) (models.Match, error) {
 	match, _, err := r.getByIDWithUpdatedAt(ctx, id)
 	return match, err
@@ -71,13 +76,26 @@ func (r *Repository) getByIDWithUpdatedAt(ctx context.Context, id models.MatchID
 	const query = `
 		SELECT
 			match_id,
+			competition,
 			kickoff_utc,
+			kickoff_revision,
+			NOT kickoff_confirmed,
 			city,
 			stadium,
+			COALESCE(stadium_id, ''),
 			destination_iata,
 			tickets_link,
 			COALESCE(club_home_id, ''),
 			COALESCE(club_away_id, ''),
+			home_score,
+			away_score,
+			source_kickoff_utc,
+			NOT source_kickoff_confirmed,
+			source_city,
+			source_stadium,
+			COALESCE(source_stadium_id, ''),
+			source_destination_iata,
+			source_tickets_link,
 			updated_at
 		FROM matches
 		WHERE match_id = $1
@@ -86,18 +104,32 @@ func (r *Repository) getByIDWithUpdatedAt(ctx context.Context, id models.MatchID
 	var (
 		storedID int64
 		match    models.Match
+		source   models.MatchSource
 		updated  time.Time
 	)
 
 	err = r.db.QueryRow(ctx, query, matchID).Scan(
 		&storedID,
+		&match.Competition,
 		&match.KickoffUTC,
+		&match.KickoffRevision,
+		&match.KickoffTentative,
 		&match.City,
 		&match.Stadium,
+		&match.StadiumID,
 		&match.DestinationIATA,
 		&match.TicketsLink,
 		&match.HomeTeam,
 		&match.AwayTeam,
+		&match.HomeScore,
+		&match.AwayScore,
+		&source.KickoffUTC,
+		&source.KickoffTentative,
+		&source.City,
+		&source.Stadium,
+		&source.StadiumID,
+		&source.DestinationIATA,
+		&source.TicketsLink,
 		&updated,
 	)
 	if err != nil {
@@ -108,80 +140,279 @@ func (r *Repository) getByIDWithUpdatedAt(ctx context.Context, id models.MatchID
 	}
 
 	match.ID = models.MatchID(strconv.FormatInt(storedID, 10))
+	match.SetSourceValues(source)
 	return match, updated, nil
 }
 
-func (r *Repository) GetUpcoming(ctx context.Context, limit int, clubID string) ([]models.Match, error) {
-	if limit <= 0 {
-		limit = 10
+// GetByIDs loads the stored matches among ids in one query. Missing ids are
+// left out of the result, which is in no particular order.
+func (r *Repository) GetByIDs(ctx context.Context, ids []models.MatchID) ([]models.Match, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	matchIDs := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		n, err := strconv.ParseInt(string(id), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse match id %q: %w", id, err)
+		}
+		matchIDs = append(matchIDs, n)
 	}
-	clubID = strings.TrimSpace(clubID)
 
 	const query = `
 		SELECT
 			match_id,
+			competition,
 			kickoff_utc,
+			kickoff_revision,
+			NOT kickoff_confirmed,
 			city,
 			stadium,
+			COALESCE(stadium_id, ''),
 			destination_iata,
 			tickets_link,
 			COALESCE(club_home_id, ''),
-			COALESCE(club_away_id, '')
+			COALESCE(club_away_id, ''),
+			home_score,
+			away_score,
+			source_kickoff_utc,
+			NOT source_kickoff_confirmed,
+			source_city,
+			source_stadium,
+			COALESCE(source_stadium_id, ''),
+			source_destination_iata,
+			source_tickets_link
 		FROM matches
-		WHERE kickoff_utc >= now()
-		  AND ($2 = '' OR club_home_id = $2 OR club_away_id = $2)
-		ORDER BY kickoff_utc ASC
-		LIMIT $1
+		WHERE match_id = ANY($1)
 	`
 
-	rows, err := r.db.Query(ctx, query, limit, clubID)
+	rows, err := r.db.Query(ctx, query, matchIDs)
 	if err != nil {
-		return nil, fmt.Errorf("query upcoming matches: %w", err)
+		return nil, fmt.Errorf("query matches by ids: %w", err)
 	}
 	defer rows.Close()
 
-	matches := make([]models.Match, 0, limit)
+	matches := make([]models.Match, 0, len(ids))
 	for rows.Next() {
 		var (
 			storedID int64
 			match    models.Match
+			source   models.MatchSource
 		)
 
 		if err := rows.Scan(
 			&storedID,
+			&match.Competition,
 			&match.KickoffUTC,
+			&match.KickoffRevision,
+			&match.KickoffTentative,
 			&match.City,
 			&match.Stadium,
+			&match.StadiumID,
 			&match.DestinationIATA,
 			&match.TicketsLink,
 			&match.HomeTeam,
 			&match.AwayTeam,
+			&match.HomeScore,
+			&match.AwayScore,
+			&source.KickoffUTC,
+			&source.KickoffTentative,
+			&source.City,
+			&source.Stadium,
+			&source.StadiumID,
+			&source.DestinationIATA,
+			&source.TicketsLink,
 		); err != nil {
-			return nil, fmt.Errorf("scan upcoming match: %w", err)
+			return nil, fmt.Errorf("scan match: %w", err)
 		}
 
 		match.ID = models.MatchID(strconv.FormatInt(storedID, 10))
+		match.SetSourceValues(source)
 		matches = append(matches, match)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate upcoming matches: %w", err)
+		return nil, fmt.Errorf("iterate matches: %w", err)
 	}
 
 	return matches, nil
 }
 
+func (r *Repository) ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error) {
+	query, args, err := buildListQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query matches: %w", err)
+	}
+	defer rows.Close()
+
+	matches := make([]models.Match, 0, filter.Limit)
+	for rows.Next() {
+		var (
+			storedID int64
+			match    models.Match
+			source   models.MatchSource
+		)
+
+		if err := rows.Scan(
+			&storedID,
+			&match.Competition,
+			&match.KickoffUTC,
+			&match.KickoffRevision,
+			&match.KickoffTentative,
+			&match.City,
+			&match.Stadium,
+			&match.StadiumID,
+			&match.DestinationIATA,
+			&match.TicketsLink,
+			&match.HomeTeam,
+			&match.AwayTeam,
+			&match.HomeScore,
+			&match.AwayScore,
+			&source.KickoffUTC,
+			&source.KickoffTentative,
+			&source.City,
+			&source.Stadium,
+			&source.StadiumID,
+			&source.DestinationIATA,
+			&source.TicketsLink,
+		); err != nil {
+			return nil, fmt.Errorf("scan match: %w", err)
+		}
+
+		match.ID = models.MatchID(strconv.FormatInt(storedID, 10))
+		match.SetSourceValues(source)
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate matches: %w", err)
+	}
+
+	return matches, nil
+}
+
+// buildListQuery renders the matches query with only the conditions that are set,
+// so the planner can pick the matching (column, kickoff_utc) index.
+func buildListQuery(filter models.MatchFilter) (string, []any, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if !filter.FromUTC.IsZero() {
+		conds = append(conds, "kickoff_utc >= "+arg(filter.FromUTC.UTC()))
+	}
+	if !filter.ToUTC.IsZero() {
+		conds = append(conds, "kickoff_utc <= "+arg(filter.ToUTC.UTC()))
+	}
+	if competition := strings.TrimSpace(filter.Competition); competition != "" {
+		conds = append(conds, "competition = "+arg(competition))
+	}
+	if iata := strings.TrimSpace(filter.DestinationIATA); iata != "" {
+		conds = append(conds, "destination_iata = "+arg(strings.ToUpper(iata)))
+	}
+	if city := strings.TrimSpace(filter.City); city != "" {
+		conds = append(conds, "lower(city) = lower("+arg(city)+")")
+	}
+
+	clubIDs := make([]string, 0, len(filter.ClubIDs))
+	for _, id := range filter.ClubIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			clubIDs = append(clubIDs, id)
+		}
+	}
+	if len(clubIDs) > 0 {
+		placeholder := arg(clubIDs)
+		switch filter.ClubSide {
+		case models.ClubSideHome:
+			conds = append(conds, "club_home_id = ANY("+placeholder+")")
+		case models.ClubSideAway:
+			conds = append(conds, "club_away_id = ANY("+placeholder+")")
+		default:
+			conds = append(conds, "(club_home_id = ANY("+placeholder+") OR club_away_id = ANY("+placeholder+"))")
+		}
+	}
+
+	if filter.After != nil {
+		afterID, err := strconv.ParseInt(string(filter.After.ID), 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("parse cursor match id %q: %w", filter.After.ID, err)
+		}
+		op := ">"
+		if filter.Order == models.SortDesc {
+			op = "<"
+		}
+		conds = append(conds, "(kickoff_utc, match_id) "+op+" ("+arg(filter.After.KickoffUTC.UTC())+", "+arg(afterID)+")")
+	}
+
+	order := "ASC"
+	if filter.Order == models.SortDesc {
+		order = "DESC"
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, "\n\t\t  AND ")
+	}
+
+	query := `
+		SELECT
+			match_id,
+			competition,
+			kickoff_utc,
+			kickoff_revision,
+			NOT kickoff_confirmed,
+			city,
+			stadium,
+			COALESCE(stadium_id, ''),
+			destination_iata,
+			tickets_link,
+			COALESCE(club_home_id, ''),
+			COALESCE(club_away_id, ''),
+			home_score,
+			away_score,
+			source_kickoff_utc,
+			NOT source_kickoff_confirmed,
+			source_city,
+			source_stadium,
+			COALESCE(source_stadium_id, ''),
+			source_destination_iata,
+			source_tickets_link
+		FROM matches
+		` + where + `
+		ORDER BY kickoff_utc ` + order + `, match_id ` + order + `
+		LIMIT ` + arg(limit)
+
+	return query, args, nil
+}
+
 func (r *Repository) GetClubs(ctx context.Context) ([]models.Club, error) {
 	const query = `
 		SELECT
-			club_id,
-			name_ru,
-			COALESCE(name_en, ''),
-			COALESCE(logo, ''),
-			COALESCE(city, ''),
-			COALESCE(airport_iata, '')
-		FROM club_dictionary
-		ORDER BY name_ru ASC
+			d.club_id,
+			d.name_ru,
+			COALESCE(d.name_en, ''),
+			COALESCE(d.logo, ''),
+			COALESCE(d.city, ''),
+			COALESCE((SELECT c.name_en FROM cities c WHERE c.name = d.city LIMIT 1), ''),
+			COALESCE(d.airport_iata, '')
+		FROM club_dictionary d
+		ORDER BY d.name_ru ASC
 	`
 
 	rows, err := r.db.Query(ctx, query)
@@ -193,7 +424,7 @@ func (r *Repository) GetClubs(ctx context.Context) ([]models.Club, error) {
 	clubs := make([]models.Club, 0, 32)
 	for rows.Next() {
 		var club models.Club
-		if err := rows.Scan(&club.ID, &club.NameRU, &club.NameEN, &club.Logo, &club.City, &club.AirportIATA); err != nil {
+		if err := rows.Scan(&club.ID, &club.NameRU, &club.NameEN, &club.Logo, &club.City, &club.CityEN, &club.AirportIATA); err != nil {
 			return nil, fmt.Errorf("scan club: %w", err)
 		}
 		clubs = append(clubs, club)
@@ -206,68 +437,97 @@ func (r *Repository) GetClubs(ctx context.Context) ([]models.Club, error) {
 	return clubs, nil
 }
 
-func (r *Repository) Upsert(ctx context.Context, match models.Match) error {
+func (r *Repository) Upsert(ctx context.Context, match models.Match) (models.Match, error) {
 	matchID, err := strconv.ParseInt(string(match.ID), 10, 64)
 	if err != nil {
-		return fmt.Errorf("parse match id %q: %w", match.ID, err)
+		return models.Match{}, fmt.Errorf("parse match id %q: %w", match.ID, err)
 	}
 
 	const query = `
 		INSERT INTO matches (
 			match_id,
+			competition,
 			kickoff_utc,
+			kickoff_confirmed,
 			city,
 			stadium,
+			stadium_id,
 			tickets_link,
 			destination_iata,
 			club_home_id,
 			club_away_id,
+			home_score,
+			away_score,
+			source_kickoff_utc,
+			source_kickoff_confirmed,
+			source_city,
+			source_stadium,
+			source_stadium_id,
+			source_destination_iata,
+			source_tickets_link,
 			updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now())
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, NULLIF($18, ''), $19, $20, now())
 		ON CONFLICT (match_id) DO UPDATE SET
+			competition = EXCLUDED.competition,
+			kickoff_revision = CASE
+				WHEN matches.kickoff_utc <> EXCLUDED.kickoff_utc THEN matches.kickoff_revision + 1
+				ELSE matches.kickoff_revision
+			END,
 			kickoff_utc = EXCLUDED.kickoff_utc,
+			kickoff_confirmed = EXCLUDED.kickoff_confirmed,
 			city = EXCLUDED.city,
 			stadium = EXCLUDED.stadium,
+			stadium_id = EXCLUDED.stadium_id,
 			tickets_link = EXCLUDED.tickets_link,
 			destination_iata = EXCLUDED.destination_iata,
 			club_home_id = EXCLUDED.club_home_id,
 			club_away_id = EXCLUDED.club_away_id,
+			home_score = COALESCE(EXCLUDED.home_score, matches.home_score),
+			away_score = COALESCE(EXCLUDED.away_score, matches.away_score),
+			source_kickoff_utc = EXCLUDED.source_kickoff_utc,
+			source_kickoff_confirmed = EXCLUDED.source_kickoff_confirmed,
+			source_city = EXCLUDED.source_city,
+			source_stadium = EXCLUDED.source_stadium,
+			source_stadium_id = EXCLUDED.source_stadium_id,
+			source_destination_iata = EXCLUDED.source_destination_iata,
+			source_tickets_link = EXCLUDED.source_tickets_link,
 			updated_at = now()
+		RETURNING kickoff_revision, home_score, away_score
 	`
 
-	_, err = r.db.Exec(ctx, query,
+	competition := strings.TrimSpace(match.Competition)
+	if competition == "" {
+		competition = models.CompetitionPremierLeague
+	}
+
+	match.Competition = competition
+	source := match.SourceValues()
+	err = r.db.QueryRow(ctx, query,
 		matchID,
+		competition,
 		match.KickoffUTC,
+		!match.KickoffTentative,
 		match.City,
 		match.Stadium,
+		match.StadiumID,
 		match.TicketsLink,
 		match.DestinationIATA,
 		match.HomeTeam,
 		match.AwayTeam,
-	)
-	if err != nil {
-		return fmt.Errorf("upsert match: %w", err)
-	}
-
-	return nil
-}
-
-func (r *Repository) ResolveDestinationIATA(ctx context.Context, city string) (string, error) {
-	const query = `
-		SELECT iata
-		FROM city_iata
-		WHERE city = $1
-	`
-
-	var iata string
-	err := r.db.QueryRow(ctx, query, city).Scan(&iata)
+		match.HomeScore,
+		match.AwayScore,
+		source.KickoffUTC,
+		!source.KickoffTentative,
+		source.City,
+		source.Stadium,
+		source.StadiumID,
+		source.DestinationIATA,
+		source.TicketsLink,
+	).Scan(&match.KickoffRevision, &match.HomeScore, &match.AwayScore)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return "", derr.ErrCityIATANotFound
-		}
-		return "", fmt.Errorf("resolve city iata: %w", err)
+		return models.Match{}, fmt.Errorf("upsert match: %w", err)
 	}
 
-	return iata, nil
+	return match, nil
 }
@@ -1,9 +1,12 @@
 package postgres
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
 )
 
 func TestBuildPoolConfig_DisablesPreparedStatements(t *testing.T) {
@@ -24,3 +27,94 @@ func TestBuildPoolConfig_DisablesPreparedStatements(t *testing.T) {
 		t.Fatalf("unexpected description cache capacity: got %d", cfg.ConnConfig.DescriptionCacheCapacity)
 	}
 }
+
+func TestBuildListQuery_AllFilters(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	to := from.Add(72 * time.Hour)
+	query, args, err := buildListQuery(models.MatchFilter{
+		Limit:           21,
+		FromUTC:         from,
+		ToUTC:           to,
+		Competition:     "rpl",
+		DestinationIATA: "kzn",
+		City:            "Kazan",
+		ClubIDs:         []string{"4", " ", "3"},
+		ClubSide:        models.ClubSideHome,
+		After:           &models.MatchCursor{KickoffUTC: from, ID: "16114"},
+	})
+	if err != nil {
+		t.Fatalf("buildListQuery returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		"kickoff_utc >= $1",
+		"kickoff_utc <= $2",
+		"competition = $3",
+		"destination_iata = $4",
+		"lower(city) = lower($5)",
+		"club_home_id = ANY($6)",
+		"(kickoff_utc, match_id) > ($7, $8)",
+		"ORDER BY kickoff_utc ASC, match_id ASC",
+		"LIMIT $9",
+	} {
+		if !strings.Contains(query, want) {
+			t.Fatalf("expected query to contain %q, got:\n%s", want, query)
+		}
+	}
+	if strings.Contains(query, "club_away_id = ANY") {
+		t.Fatalf("home side must not filter away club, got:\n%s", query)
+	}
+
+	if len(args) != 9 {
+		t.Fatalf("expected 9 args, got %d: %v", len(args), args)
+	}
+	if args[3] != "KZN" {
+		t.Fatalf("expected upper-cased iata, got %v", args[3])
+	}
+	if ids, ok := args[5].([]string); !ok || len(ids) != 2 {
+		t.Fatalf("expected 2 club ids, got %v", args[5])
+	}
+	if args[7] != int64(16114) || args[8] != 21 {
+		t.Fatalf("unexpected cursor/limit args: %v", args[7:])
+	}
+}
+
+func TestBuildListQuery_Defaults(t *testing.T) {
+	t.Parallel()
+
+	query, args, err := buildListQuery(models.MatchFilter{})
+	if err != nil {
+		t.Fatalf("buildListQuery returned error: %v", err)
+	}
+	if strings.Contains(query, "WHERE") {
+		t.Fatalf("expected no conditions, got:\n%s", query)
+	}
+	if len(args) != 1 || args[0] != 10 {
+		t.Fatalf("expected only default limit arg, got %v", args)
+	}
+}
+
+func TestBuildListQuery_DescendingCursor(t *testing.T) {
+	t.Parallel()
+
+	before := time.Date(2025, 11, 1, 16, 0, 0, 0, time.UTC)
+	query, _, err := buildListQuery(models.MatchFilter{
+		ToUTC: before,
+		Order: models.SortDesc,
+		After: &models.MatchCursor{KickoffUTC: before, ID: "15000"},
+	})
+	if err != nil {
+		t.Fatalf("buildListQuery returned error: %v", err)
+	}
+	for _, want := range []string{
+		"kickoff_utc <= $1",
+		"(kickoff_utc, match_id) < ($2, $3)",
+		"ORDER BY kickoff_utc DESC, match_id DESC",
+	} {
+		if !strings.Contains(query, want) {
+			t.Fatalf("expected query to contain %q, got:\n%s", want, query)
+		}
+	}
+}
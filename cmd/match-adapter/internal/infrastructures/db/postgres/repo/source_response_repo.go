@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/gzipx"
+)
+
+func (r *Repository) StoreSourceResponse(ctx context.Context, resp models.SourceResponse) error {
+	body, err := gzipx.Compress(resp.Body)
+	if err != nil {
+		return fmt.Errorf("compress source response: %w", err)
+	}
+
+	fetchedAt := resp.FetchedAt
+	if fetchedAt.IsZero() {
+		fetchedAt = time.Now()
+	}
+
+	const query = `
+		INSERT INTO source_responses (source, endpoint, request_hash, request_body, status_code, body_gzip, fetched_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = r.db.Exec(ctx, query,
+		resp.Source,
+		resp.Endpoint,
+		models.SourceRequestHash(resp.RequestBody),
+		string(resp.RequestBody),
+		resp.StatusCode,
+		body,
+		fetchedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("insert source response: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) LatestSourceResponse(ctx context.Context, source, endpoint string, requestBody []byte) (models.SourceResponse, error) {
+	const query = `
+		SELECT request_body, status_code, body_gzip, fetched_at
+		FROM source_responses
+		WHERE source = $1 AND endpoint = $2 AND request_hash = $3
+		ORDER BY fetched_at DESC
+		LIMIT 1
+	`
+
+	var (
+		reqBody string
+		body    []byte
+		resp    = models.SourceResponse{Source: source, Endpoint: endpoint}
+	)
+	err := r.db.QueryRow(ctx, query, source, endpoint, models.SourceRequestHash(requestBody)).Scan(
+		&reqBody,
+		&resp.StatusCode,
+		&body,
+		&resp.FetchedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SourceResponse{}, derr.ErrSourceResponseNotFound
+		}
+		return models.SourceResponse{}, fmt.Errorf("query source response: %w", err)
+	}
+
+	resp.Body, err = gzipx.Decompress(body)
+	if err != nil {
+		return models.SourceResponse{}, fmt.Errorf("decompress source response: %w", err)
+	}
+	resp.RequestBody = []byte(reqBody)
+
+	return resp, nil
+}
+
+func (r *Repository) PurgeSourceResponses(ctx context.Context, before time.Time) (int64, error) {
+	const query = `DELETE FROM source_responses WHERE fetched_at < $1`
+
+	tag, err := r.db.Exec(ctx, query, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("purge source responses: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
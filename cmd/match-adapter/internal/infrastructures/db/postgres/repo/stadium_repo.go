@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func (r *Repository) ResolveStadiumID(ctx context.Context, name string) (string, error) {
+	alias := models.NormalizeStadiumAlias(name)
+	if alias == "" {
+		return "", derr.ErrStadiumNotFound
+	}
+
+	const query = `
+		SELECT stadium_id
+		FROM stadium_aliases
+		WHERE alias = $1
+	`
+
+	var stadiumID string
+	err := r.db.QueryRow(ctx, query, alias).Scan(&stadiumID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", derr.ErrStadiumNotFound
+		}
+		return "", fmt.Errorf("resolve stadium alias: %w", err)
+	}
+
+	return stadiumID, nil
+}
+
+func (r *Repository) GetStadiums(ctx context.Context, ids []string) (map[string]models.Stadium, error) {
+	stadiums := make(map[string]models.Stadium, len(ids))
+	if len(ids) == 0 {
+		return stadiums, nil
+	}
+
+	const stadiumsQuery = `
+		SELECT
+			s.stadium_id, s.name, s.name_en, s.city,
+			COALESCE((SELECT c.name_en FROM cities c WHERE c.name = s.city LIMIT 1), ''),
+			s.latitude, s.longitude, s.timezone
+		FROM stadiums s
+		WHERE s.stadium_id = ANY($1)
+	`
+
+	rows, err := r.db.Query(ctx, stadiumsQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query stadiums: %w", err)
+	}
+	for rows.Next() {
+		var stadium models.Stadium
+		if err := rows.Scan(&stadium.ID, &stadium.Name, &stadium.NameEN, &stadium.City, &stadium.CityEN, &stadium.Latitude, &stadium.Longitude, &stadium.Timezone); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan stadium: %w", err)
+		}
+		stadiums[stadium.ID] = stadium
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stadiums: %w", err)
+	}
+
+	const airportsQuery = `
+		SELECT stadium_id, airport_iata, transfer_minutes
+		FROM stadium_airports
+		WHERE stadium_id = ANY($1)
+		ORDER BY stadium_id ASC, transfer_minutes ASC, airport_iata ASC
+	`
+
+	rows, err = r.db.Query(ctx, airportsQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query stadium airports: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			stadiumID string
+			iata      string
+			minutes   int
+		)
+		if err := rows.Scan(&stadiumID, &iata, &minutes); err != nil {
+			return nil, fmt.Errorf("scan stadium airport: %w", err)
+		}
+
+		stadium, ok := stadiums[stadiumID]
+		if !ok {
+			continue
+		}
+		stadium.Airports = append(stadium.Airports, models.StadiumAirport{
+			IATA:         iata,
+			TransferTime: time.Duration(minutes) * time.Minute,
+		})
+		stadiums[stadiumID] = stadium
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stadium airports: %w", err)
+	}
+
+	return stadiums, nil
+}
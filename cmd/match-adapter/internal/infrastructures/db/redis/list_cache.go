@@ -0,0 +1,198 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	clubsKey              = "clubs"
+	upcomingGenerationKey = "upcoming:generation"
+)
+
+// ListCache stores upcoming indexes as a sorted set of zero padded match ids
+// scored by kickoff, so equal kickoffs keep the match_id order of the database.
+// Match bodies live in a hash next to the set.
+type ListCache struct {
+	redis *redis.Client
+}
+
+type upcomingMeta struct {
+	From     time.Time `json:"from"`
+	Complete bool      `json:"complete"`
+}
+
+func NewListCache(redis *redis.Client) *ListCache {
+	return &ListCache{redis: redis}
+}
+
+func (c *ListCache) GetClubs(ctx context.Context) ([]models.Club, bool, error) {
+	data, err := c.redis.Get(ctx, clubsKey).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("redis get clubs: %w", err)
+	}
+
+	var clubs []models.Club
+	if err := json.Unmarshal(data, &clubs); err != nil {
+		return nil, false, fmt.Errorf("unmarshal cached clubs: %w", err)
+	}
+
+	return clubs, true, nil
+}
+
+func (c *ListCache) SetClubs(ctx context.Context, clubs []models.Club, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(clubs)
+	if err != nil {
+		return fmt.Errorf("marshal clubs for cache: %w", err)
+	}
+
+	if err := c.redis.Set(ctx, clubsKey, data, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set clubs: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ListCache) DeleteClubs(ctx context.Context) error {
+	if err := c.redis.Del(ctx, clubsKey).Err(); err != nil {
+		return fmt.Errorf("redis delete clubs: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ListCache) UpcomingGeneration(ctx context.Context) (int64, error) {
+	generation, err := c.redis.Get(ctx, upcomingGenerationKey).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("redis get upcoming generation: %w", err)
+	}
+
+	return generation, nil
+}
+
+func (c *ListCache) GetUpcoming(ctx context.Context, generation int64, key string) (models.UpcomingIndex, bool, error) {
+	setKey := upcomingKey(generation, key)
+
+	pipe := c.redis.Pipeline()
+	metaCmd := pipe.Get(ctx, setKey+":meta")
+	idsCmd := pipe.ZRange(ctx, setKey, 0, -1)
+	bodiesCmd := pipe.HGetAll(ctx, setKey+":matches")
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return models.UpcomingIndex{}, false, fmt.Errorf("redis get upcoming index: %w", err)
+	}
+
+	metaData, err := metaCmd.Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return models.UpcomingIndex{}, false, nil
+		}
+		return models.UpcomingIndex{}, false, fmt.Errorf("redis get upcoming meta: %w", err)
+	}
+
+	var meta upcomingMeta
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return models.UpcomingIndex{}, false, fmt.Errorf("unmarshal upcoming meta: %w", err)
+	}
+
+	ids := idsCmd.Val()
+	bodies := bodiesCmd.Val()
+	index := models.UpcomingIndex{
+		From:     meta.From,
+		Complete: meta.Complete,
+		Matches:  make([]models.Match, 0, len(ids)),
+	}
+	for _, id := range ids {
+		body, ok := bodies[id]
+		if !ok {
+			// partially expired index, rebuild it
+			return models.UpcomingIndex{}, false, nil
+		}
+
+		var match models.Match
+		if err := json.Unmarshal([]byte(body), &match); err != nil {
+			return models.UpcomingIndex{}, false, fmt.Errorf("unmarshal cached upcoming match: %w", err)
+		}
+		index.Matches = append(index.Matches, match)
+	}
+
+	return index, true, nil
+}
+
+func (c *ListCache) SetUpcoming(ctx context.Context, generation int64, key string, index models.UpcomingIndex, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	setKey := upcomingKey(generation, key)
+	meta, err := json.Marshal(upcomingMeta{From: index.From.UTC(), Complete: index.Complete})
+	if err != nil {
+		return fmt.Errorf("marshal upcoming meta: %w", err)
+	}
+
+	members := make([]redis.Z, 0, len(index.Matches))
+	bodies := make(map[string]any, len(index.Matches))
+	for _, match := range index.Matches {
+		id, err := strconv.ParseInt(string(match.ID), 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse match id %q: %w", match.ID, err)
+		}
+
+		normalized := match
+		normalized.KickoffUTC = normalized.KickoffUTC.UTC()
+		data, err := json.Marshal(normalized)
+		if err != nil {
+			return fmt.Errorf("marshal upcoming match: %w", err)
+		}
+
+		member := fmt.Sprintf("%019d", id)
+		members = append(members, redis.Z{Score: float64(normalized.KickoffUTC.Unix()), Member: member})
+		bodies[member] = data
+	}
+
+	pipe := c.redis.TxPipeline()
+	pipe.Del(ctx, setKey, setKey+":matches")
+	if len(members) > 0 {
+		pipe.ZAdd(ctx, setKey, members...)
+		pipe.Expire(ctx, setKey, ttl)
+		pipe.HSet(ctx, setKey+":matches", bodies)
+		pipe.Expire(ctx, setKey+":matches", ttl)
+	}
+	// meta goes last: readers treat an index without meta as missing
+	pipe.Set(ctx, setKey+":meta", meta, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis set upcoming index: %w", err)
+	}
+
+	return nil
+}
+
+// InvalidateUpcoming bumps the generation. Indexes of older generations are
+// no longer read and expire on their own.
+func (c *ListCache) InvalidateUpcoming(ctx context.Context) error {
+	if err := c.redis.Incr(ctx, upcomingGenerationKey).Err(); err != nil {
+		return fmt.Errorf("redis bump upcoming generation: %w", err)
+	}
+
+	return nil
+}
+
+func upcomingKey(generation int64, key string) string {
+	return fmt.Sprintf("upcoming:%d:%s", generation, key)
+}
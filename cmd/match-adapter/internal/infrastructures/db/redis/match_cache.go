@@ -58,3 +58,26 @@ func (c *MatchCache) Set(ctx context.Context, match models.Match, ttl time.Durat
 
 	return nil
 }
+
+func (c *MatchCache) SetNotFound(ctx context.Context, id models.MatchID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("match:notfound:%s", id)
+	if err := c.redis.Set(ctx, key, "1", ttl).Err(); err != nil {
+		return fmt.Errorf("redis set match not found: %w", err)
+	}
+
+	return nil
+}
+
+func (c *MatchCache) IsNotFound(ctx context.Context, id models.MatchID) (bool, error) {
+	key := fmt.Sprintf("match:notfound:%s", id)
+	n, err := c.redis.Exists(ctx, key).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis check match not found: %w", err)
+	}
+
+	return n > 0, nil
+}
@@ -0,0 +1,505 @@
+// Package repotest is the contract test suite every match repository driver
+// has to pass.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+)
+
+// Repository is everything cmd/main needs from the storage.
+type Repository interface {
+	ports.MatchRepository
+	ports.StadiumRepository
+	ports.MatchOverrideRepository
+	ports.CityDictionary
+	ports.SourceResponseArchive
+	GetByIDWithUpdatedAt(ctx context.Context, id models.MatchID) (models.Match, time.Time, error)
+	GetUnresolvedCities(ctx context.Context) ([]models.UnresolvedCity, error)
+}
+
+// Run runs the suite. open must return an empty repository with the
+// dictionaries of the migrations.
+func Run(t *testing.T, open func(t *testing.T) Repository) {
+	tests := map[string]func(t *testing.T, repo Repository){
+		"UpsertAndGet":     testUpsertAndGet,
+		"GetByIDs":         testGetByIDs,
+		"ListMatches":      testListMatches,
+		"ListCursor":       testListCursor,
+		"Clubs":            testClubs,
+		"Stadiums":         testStadiums,
+		"CityAliases":      testCityAliases,
+		"UnresolvedCities": testUnresolvedCities,
+		"Overrides":        testOverrides,
+		"SourceValues":     testSourceValues,
+		"SourceResponses":  testSourceResponses,
+	}
+
+	names := make([]string, 0, len(tests))
+	for name := range tests {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			tests[name](t, open(t))
+		})
+	}
+}
+
+var base = time.Date(2031, 8, 2, 16, 30, 0, 0, time.UTC)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func mustUpsert(t *testing.T, repo Repository, matches ...models.Match) {
+	t.Helper()
+	for _, m := range matches {
+		if _, err := repo.Upsert(context.Background(), m); err != nil {
+			t.Fatalf("upsert %s: %v", m.ID, err)
+		}
+	}
+}
+
+func ids(matches []models.Match) []models.MatchID {
+	out := make([]models.MatchID, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, m.ID)
+	}
+	return out
+}
+
+func testUpsertAndGet(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	match := models.Match{
+		ID:               "7001",
+		HomeTeam:         "3",
+		AwayTeam:         "4",
+		City:             "Санкт-Петербург",
+		Stadium:          "Газпром Арена",
+		StadiumID:        "gazprom-arena",
+		DestinationIATA:  "LED",
+		TicketsLink:      "https://tickets.example/7001",
+		KickoffUTC:       base,
+		KickoffTentative: true,
+	}
+	mustUpsert(t, repo, match)
+
+	got, updated, err := repo.GetByIDWithUpdatedAt(ctx, "7001")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if updated.IsZero() {
+		t.Fatal("expected updated_at to be set")
+	}
+	if !got.KickoffUTC.Equal(base) {
+		t.Fatalf("unexpected kickoff %v", got.KickoffUTC)
+	}
+	got.KickoffUTC = match.KickoffUTC
+	want := match
+	want.Competition = models.CompetitionPremierLeague
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+
+	match.HomeScore, match.AwayScore = intPtr(2), intPtr(1)
+	mustUpsert(t, repo, match)
+	match.HomeScore, match.AwayScore = nil, nil
+	match.KickoffUTC = base.Add(time.Hour)
+	mustUpsert(t, repo, match)
+	stored, err := repo.Upsert(ctx, match)
+	if err != nil {
+		t.Fatalf("upsert: %v", err)
+	}
+	if stored.KickoffRevision != 1 || stored.HomeScore == nil || *stored.HomeScore != 2 {
+		t.Fatalf("upsert must return the stored revision and score, got %+v", stored)
+	}
+
+	got, err = repo.GetByID(ctx, "7001")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.KickoffRevision != 1 {
+		t.Fatalf("expected one kickoff change, got revision %d", got.KickoffRevision)
+	}
+	if got.HomeScore == nil || *got.HomeScore != 2 || got.AwayScore == nil || *got.AwayScore != 1 {
+		t.Fatalf("expected stored score to be kept, got %v %v", got.HomeScore, got.AwayScore)
+	}
+
+	if _, err := repo.GetByID(ctx, "7999"); !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+}
+
+func testGetByIDs(t *testing.T, repo Repository) {
+	mustUpsert(t, repo,
+		models.Match{ID: "7101", KickoffUTC: base},
+		models.Match{ID: "7102", KickoffUTC: base},
+		models.Match{ID: "7103", KickoffUTC: base},
+	)
+
+	got, err := repo.GetByIDs(context.Background(), []models.MatchID{"7103", "7199", "7101"})
+	if err != nil {
+		t.Fatalf("get by ids: %v", err)
+	}
+	gotIDs := ids(got)
+	slices.Sort(gotIDs)
+	if !reflect.DeepEqual(gotIDs, []models.MatchID{"7101", "7103"}) {
+		t.Fatalf("unexpected matches %v", gotIDs)
+	}
+}
+
+func listFixture() []models.Match {
+	return []models.Match{
+		{ID: "7201", KickoffUTC: base, Competition: "rpl", HomeTeam: "3", AwayTeam: "4", City: "Kazan", DestinationIATA: "KZN"},
+		{ID: "7203", KickoffUTC: base.Add(24 * time.Hour), Competition: "cup", HomeTeam: "4", AwayTeam: "1", City: "Moscow", DestinationIATA: "MOW"},
+		{ID: "7202", KickoffUTC: base.Add(24 * time.Hour), Competition: "rpl", HomeTeam: "1", AwayTeam: "3", City: "Kazan", DestinationIATA: "KZN"},
+		{ID: "7204", KickoffUTC: base.Add(48 * time.Hour), Competition: "rpl", HomeTeam: "2", AwayTeam: "5", City: "Moscow", DestinationIATA: "MOW"},
+	}
+}
+
+func testListMatches(t *testing.T, repo Repository) {
+	mustUpsert(t, repo, listFixture()...)
+
+	cases := map[string]struct {
+		filter models.MatchFilter
+		want   []models.MatchID
+	}{
+		"ordered by kickoff then id": {
+			filter: models.MatchFilter{FromUTC: base},
+			want:   []models.MatchID{"7201", "7202", "7203", "7204"},
+		},
+		"desc": {
+			filter: models.MatchFilter{FromUTC: base, Order: models.SortDesc},
+			want:   []models.MatchID{"7204", "7203", "7202", "7201"},
+		},
+		"range": {
+			filter: models.MatchFilter{FromUTC: base.Add(time.Hour), ToUTC: base.Add(24 * time.Hour)},
+			want:   []models.MatchID{"7202", "7203"},
+		},
+		"limit": {
+			filter: models.MatchFilter{FromUTC: base, Limit: 2},
+			want:   []models.MatchID{"7201", "7202"},
+		},
+		"competition": {
+			filter: models.MatchFilter{FromUTC: base, Competition: " cup "},
+			want:   []models.MatchID{"7203"},
+		},
+		"destination": {
+			filter: models.MatchFilter{FromUTC: base, DestinationIATA: "kzn"},
+			want:   []models.MatchID{"7201", "7202"},
+		},
+		"city ignores case": {
+			filter: models.MatchFilter{FromUTC: base, City: "MOSCOW"},
+			want:   []models.MatchID{"7203", "7204"},
+		},
+		"club any side": {
+			filter: models.MatchFilter{FromUTC: base, ClubIDs: []string{"3", " "}},
+			want:   []models.MatchID{"7201", "7202"},
+		},
+		"club home": {
+			filter: models.MatchFilter{FromUTC: base, ClubIDs: []string{"4", "2"}, ClubSide: models.ClubSideHome},
+			want:   []models.MatchID{"7203", "7204"},
+		},
+		"club away": {
+			filter: models.MatchFilter{FromUTC: base, ClubIDs: []string{"4"}, ClubSide: models.ClubSideAway},
+			want:   []models.MatchID{"7201"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.filter.Limit == 0 {
+				tc.filter.Limit = 10
+			}
+			got, err := repo.ListMatches(context.Background(), tc.filter)
+			if err != nil {
+				t.Fatalf("list matches: %v", err)
+			}
+			if !reflect.DeepEqual(ids(got), tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, ids(got))
+			}
+		})
+	}
+}
+
+func testListCursor(t *testing.T, repo Repository) {
+	mustUpsert(t, repo, listFixture()...)
+	ctx := context.Background()
+
+	got, err := repo.ListMatches(ctx, models.MatchFilter{
+		FromUTC: base,
+		Limit:   10,
+		After:   &models.MatchCursor{KickoffUTC: base.Add(24 * time.Hour), ID: "7202"},
+	})
+	if err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+	if want := []models.MatchID{"7203", "7204"}; !reflect.DeepEqual(ids(got), want) {
+		t.Fatalf("expected %v, got %v", want, ids(got))
+	}
+
+	got, err = repo.ListMatches(ctx, models.MatchFilter{
+		FromUTC: base,
+		Limit:   10,
+		Order:   models.SortDesc,
+		After:   &models.MatchCursor{KickoffUTC: base.Add(24 * time.Hour), ID: "7203"},
+	})
+	if err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+	if want := []models.MatchID{"7202", "7201"}; !reflect.DeepEqual(ids(got), want) {
+		t.Fatalf("expected %v, got %v", want, ids(got))
+	}
+
+	if _, err := repo.ListMatches(ctx, models.MatchFilter{After: &models.MatchCursor{ID: "x"}}); err == nil {
+		t.Fatal("expected an error for a bad cursor id")
+	}
+}
+
+func testSourceValues(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	overridden := models.Match{
+		ID:              "7501",
+		City:            "Самара",
+		DestinationIATA: "KUF",
+		KickoffUTC:      base.Add(-time.Hour),
+		Source: &models.MatchSource{
+			City:             "Санкт-Петербург",
+			Stadium:          "Газпром Арена",
+			StadiumID:        "gazprom-arena",
+			DestinationIATA:  "LED",
+			KickoffUTC:       base.Add(time.Hour),
+			KickoffTentative: true,
+		},
+	}
+	mustUpsert(t, repo, overridden, models.Match{ID: "7502", City: "Самара", DestinationIATA: "KUF", KickoffUTC: base})
+
+	got, err := repo.GetByID(ctx, "7501")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Source == nil || !got.Source.Equal(*overridden.Source) {
+		t.Fatalf("expected source values %+v, got %+v", overridden.Source, got.Source)
+	}
+	plain, err := repo.GetByID(ctx, "7502")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if plain.Source != nil {
+		t.Fatalf("expected no source values for a match without overrides, got %+v", plain.Source)
+	}
+
+	// lists filter and order by the stored values, not the source ones
+	listed, err := repo.ListMatches(ctx, models.MatchFilter{FromUTC: base.Add(-2 * time.Hour), DestinationIATA: "KUF", Limit: 10})
+	if err != nil {
+		t.Fatalf("list matches: %v", err)
+	}
+	if want := []models.MatchID{"7501", "7502"}; !reflect.DeepEqual(ids(listed), want) {
+		t.Fatalf("expected %v, got %v", want, ids(listed))
+	}
+
+	got = got.WithoutOverrides()
+	mustUpsert(t, repo, got)
+	reverted, err := repo.GetByID(ctx, "7501")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if reverted.Source != nil || reverted.City != "Санкт-Петербург" || reverted.StadiumID != "gazprom-arena" || !reverted.KickoffTentative {
+		t.Fatalf("expected the source values to be restored, got %+v", reverted)
+	}
+}
+
+func testClubs(t *testing.T, repo Repository) {
+	clubs, err := repo.GetClubs(context.Background())
+	if err != nil {
+		t.Fatalf("get clubs: %v", err)
+	}
+
+	idx := slices.IndexFunc(clubs, func(c models.Club) bool { return c.ID == "3" })
+	if idx < 0 {
+		t.Fatalf("expected seeded club 3, got %+v", clubs)
+	}
+	want := models.Club{ID: "3", NameRU: "Зенит", NameEN: "Zenit", Logo: "3.svg", City: "Санкт-Петербург", CityEN: "Saint Petersburg", AirportIATA: "LED"}
+	if clubs[idx] != want {
+		t.Fatalf("expected %+v, got %+v", want, clubs[idx])
+	}
+}
+
+func testStadiums(t *testing.T, repo Repository) {
+	ctx := context.Background()
+
+	id, err := repo.ResolveStadiumID(ctx, "«Газпром  Арена»")
+	if err != nil || id != "gazprom-arena" {
+		t.Fatalf("expected gazprom-arena, got %q %v", id, err)
+	}
+	if _, err := repo.ResolveStadiumID(ctx, "Стадион на Луне"); !errors.Is(err, derr.ErrStadiumNotFound) {
+		t.Fatalf("expected ErrStadiumNotFound, got %v", err)
+	}
+
+	stadiums, err := repo.GetStadiums(ctx, []string{"lukoil-arena", "unknown"})
+	if err != nil {
+		t.Fatalf("get stadiums: %v", err)
+	}
+	if len(stadiums) != 1 {
+		t.Fatalf("expected one stadium, got %+v", stadiums)
+	}
+	stadium := stadiums["lukoil-arena"]
+	if stadium.Name != "Лукойл Арена" || stadium.NameEN != "Lukoil Arena" || stadium.CityEN != "Moscow" || stadium.Timezone != "Europe/Moscow" {
+		t.Fatalf("unexpected stadium %+v", stadium)
+	}
+	var airports []string
+	for _, a := range stadium.Airports {
+		airports = append(airports, a.IATA)
+	}
+	if !reflect.DeepEqual(airports, []string{"SVO", "VKO", "DME"}) || stadium.Airports[0].TransferTime != 40*time.Minute {
+		t.Fatalf("unexpected airports %+v", stadium.Airports)
+	}
+}
+
+func testCityAliases(t *testing.T, repo Repository) {
+	aliases, err := repo.GetCityAliases(context.Background())
+	if err != nil {
+		t.Fatalf("get city aliases: %v", err)
+	}
+
+	byAlias := make(map[string]models.City, len(aliases))
+	for _, a := range aliases {
+		byAlias[a.Alias] = a.City
+	}
+	if city := byAlias["Kazan"]; city.ID != "kazan" || city.Name != "Казань" || city.NameEN != "Kazan" || city.IATA != "KZN" {
+		t.Fatalf("unexpected city for Kazan: %+v", city)
+	}
+	if city := byAlias["Москва"]; city.IATA != "MOW" {
+		t.Fatalf("expected city names to be aliases, got %+v", city)
+	}
+}
+
+func testUnresolvedCities(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	for _, id := range []models.MatchID{"7301", "7302", "bad"} {
+		if err := repo.RecordUnresolvedCity(ctx, " Атлантида ", id); err != nil {
+			t.Fatalf("record unresolved city: %v", err)
+		}
+	}
+	if err := repo.RecordUnresolvedCity(ctx, " ", "7303"); err != nil {
+		t.Fatalf("record empty city: %v", err)
+	}
+
+	cities, err := repo.GetUnresolvedCities(ctx)
+	if err != nil {
+		t.Fatalf("get unresolved cities: %v", err)
+	}
+	if len(cities) != 1 {
+		t.Fatalf("expected one city, got %+v", cities)
+	}
+	if c := cities[0]; c.Name != "Атлантида" || c.Occurrences != 3 || c.LastMatchID != "7302" || c.FirstSeen.After(c.LastSeen) {
+		t.Fatalf("unexpected city %+v", c)
+	}
+}
+
+func testOverrides(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	override := models.MatchOverride{
+		MatchID:   "7401",
+		Field:     models.OverrideCity,
+		Value:     "Казань",
+		Author:    "ops",
+		Reason:    "moved",
+		ExpiresAt: base,
+	}
+
+	saved, err := repo.UpsertMatchOverride(ctx, override)
+	if err != nil {
+		t.Fatalf("upsert override: %v", err)
+	}
+	if saved.CreatedAt.IsZero() || saved.UpdatedAt.IsZero() {
+		t.Fatalf("expected timestamps, got %+v", saved)
+	}
+
+	override.Value = "Самара"
+	override.ExpiresAt = time.Time{}
+	updated, err := repo.UpsertMatchOverride(ctx, override)
+	if err != nil {
+		t.Fatalf("update override: %v", err)
+	}
+	if !updated.CreatedAt.Equal(saved.CreatedAt) {
+		t.Fatalf("expected created_at to be kept, got %v and %v", saved.CreatedAt, updated.CreatedAt)
+	}
+
+	other := models.MatchOverride{MatchID: "7402", Field: models.OverrideTicketsLink, Value: "https://t.example", Author: "ops", Reason: "r"}
+	if _, err := repo.UpsertMatchOverride(ctx, other); err != nil {
+		t.Fatalf("upsert override: %v", err)
+	}
+
+	listed, err := repo.ListMatchOverrides(ctx, []models.MatchID{"7401"})
+	if err != nil {
+		t.Fatalf("list overrides: %v", err)
+	}
+	if len(listed) != 1 || listed[0].Value != "Самара" || !listed[0].ExpiresAt.IsZero() {
+		t.Fatalf("unexpected overrides %+v", listed)
+	}
+
+	all, err := repo.ListMatchOverrides(ctx, nil)
+	if err != nil {
+		t.Fatalf("list overrides: %v", err)
+	}
+	if len(all) != 2 || all[0].MatchID != "7401" || all[1].MatchID != "7402" {
+		t.Fatalf("unexpected overrides %+v", all)
+	}
+
+	if err := repo.DeleteMatchOverride(ctx, "7401", models.OverrideCity); err != nil {
+		t.Fatalf("delete override: %v", err)
+	}
+	if err := repo.DeleteMatchOverride(ctx, "7401", models.OverrideCity); !errors.Is(err, derr.ErrOverrideNotFound) {
+		t.Fatalf("expected ErrOverrideNotFound, got %v", err)
+	}
+}
+
+func testSourceResponses(t *testing.T, repo Repository) {
+	ctx := context.Background()
+	request := []byte(`{"id":7501}`)
+	for i, body := range []string{"old", "new"} {
+		err := repo.StoreSourceResponse(ctx, models.SourceResponse{
+			Source:      "premierliga",
+			Endpoint:    "/api/match",
+			RequestBody: request,
+			StatusCode:  200,
+			Body:        []byte(body),
+			FetchedAt:   base.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("store source response: %v", err)
+		}
+	}
+
+	latest, err := repo.LatestSourceResponse(ctx, "premierliga", "/api/match", request)
+	if err != nil {
+		t.Fatalf("latest source response: %v", err)
+	}
+	if string(latest.Body) != "new" || string(latest.RequestBody) != string(request) || latest.StatusCode != 200 {
+		t.Fatalf("unexpected response %+v", latest)
+	}
+
+	if _, err := repo.LatestSourceResponse(ctx, "premierliga", "/api/match", []byte(`{"id":7502}`)); !errors.Is(err, derr.ErrSourceResponseNotFound) {
+		t.Fatalf("expected ErrSourceResponseNotFound, got %v", err)
+	}
+
+	removed, err := repo.PurgeSourceResponses(ctx, base.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if removed != 1 {
+		t.Fatalf("expected one purged response, got %d", removed)
+	}
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor_ContinuesCallerTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	const parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	interceptor := UnaryServerInterceptor("test/grpc")
+	info := &grpc.UnaryServerInfo{FullMethod: "/match.v1.MatchAdapterService/GetMatch"}
+
+	for _, tc := range []struct {
+		err    error
+		failed bool
+	}{
+		{err: nil},
+		{err: status.Error(codes.NotFound, "match not found")},
+		{err: status.Error(codes.Internal, "internal error"), failed: true},
+	} {
+		md := metadata.Pairs("traceparent", "00-"+parentTraceID+"-00f067aa0ba902b7-01")
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		var handlerTraceID string
+		_, _ = interceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			handlerTraceID = TraceID(ctx)
+			return nil, tc.err
+		})
+
+		if handlerTraceID != parentTraceID {
+			t.Fatalf("expected handler trace %s, got %q", parentTraceID, handlerTraceID)
+		}
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		if span.Name() != info.FullMethod || span.SpanKind() != trace.SpanKindServer {
+			t.Fatalf("unexpected span %q kind %v", span.Name(), span.SpanKind())
+		}
+		if failed := span.Status().Code == otelcodes.Error; failed != tc.failed {
+			t.Fatalf("%v: expected failed span %v, got %v", status.Code(tc.err), tc.failed, failed)
+		}
+	}
+}
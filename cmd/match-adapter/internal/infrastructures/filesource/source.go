@@ -0,0 +1,282 @@
+// Package filesource serves matches from a schedule file, for when the league
+// API is unavailable and for offline development.
+package filesource
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+// Row is one fixture as written in the schedule file. Kickoff must carry a UTC
+// offset: a bare local time is ambiguous across Russian time zones.
+type Row struct {
+	MatchID          string `json:"match_id"`
+	Kickoff          string `json:"kickoff"`
+	HomeClubID       string `json:"home_club_id"`
+	AwayClubID       string `json:"away_club_id"`
+	Stadium          string `json:"stadium"`
+	City             string `json:"city"`
+	TicketsLink      string `json:"tickets_link"`
+	KickoffConfirmed *bool  `json:"kickoff_confirmed,omitempty"`
+}
+
+var csvColumns = []string{"match_id", "kickoff", "home_club_id", "away_club_id", "stadium", "city", "tickets_link"}
+
+// Source implements ports.MatchSource over a schedule loaded once at start.
+type Source struct {
+	matches map[models.MatchID]models.Match
+	order   []models.MatchID
+}
+
+// Load reads a .json or .csv schedule and validates every row.
+func Load(path string) (*Source, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open schedule: %w", err)
+	}
+	defer f.Close()
+
+	var rows []Row
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		rows, err = ParseJSON(f)
+	case ".csv":
+		rows, err = ParseCSV(f)
+	default:
+		return nil, fmt.Errorf("unsupported schedule format %q, expected .json or .csv", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse schedule %s: %w", path, err)
+	}
+
+	source, err := NewSource(rows)
+	if err != nil {
+		return nil, fmt.Errorf("validate schedule %s: %w", path, err)
+	}
+	return source, nil
+}
+
+// ParseJSON accepts either a list of rows or an object with a "matches" list.
+func ParseJSON(r io.Reader) ([]Row, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	if err := json.Unmarshal(raw, &rows); err == nil {
+		return rows, nil
+	}
+
+	var envelope struct {
+		Matches []Row `json:"matches"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+	return envelope.Matches, nil
+}
+
+// ParseCSV expects a header row; columns may come in any order and
+// kickoff_confirmed is optional.
+func ParseCSV(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvColumns {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	var rows []Row
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		get := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := Row{
+			MatchID:     get("match_id"),
+			Kickoff:     get("kickoff"),
+			HomeClubID:  get("home_club_id"),
+			AwayClubID:  get("away_club_id"),
+			Stadium:     get("stadium"),
+			City:        get("city"),
+			TicketsLink: get("tickets_link"),
+		}
+		if v := get("kickoff_confirmed"); v != "" {
+			confirmed, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: kickoff_confirmed must be a boolean", line)
+			}
+			row.KickoffConfirmed = &confirmed
+		}
+		rows = append(rows, row)
+	}
+}
+
+// NewSource validates rows and reports every invalid one, not just the first.
+func NewSource(rows []Row) (*Source, error) {
+	s := &Source{matches: make(map[models.MatchID]models.Match, len(rows))}
+
+	var errs []error
+	for i, row := range rows {
+		match, err := toMatch(row)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i+1, err))
+			continue
+		}
+		if _, ok := s.matches[match.ID]; ok {
+			errs = append(errs, fmt.Errorf("row %d: duplicate match_id %s", i+1, match.ID))
+			continue
+		}
+		s.matches[match.ID] = match
+		s.order = append(s.order, match.ID)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if len(s.order) == 0 {
+		return nil, errors.New("schedule is empty")
+	}
+
+	sort.Slice(s.order, func(i, j int) bool {
+		a, b := s.matches[s.order[i]], s.matches[s.order[j]]
+		if !a.KickoffUTC.Equal(b.KickoffUTC) {
+			return a.KickoffUTC.Before(b.KickoffUTC)
+		}
+		return s.order[i] < s.order[j]
+	})
+
+	return s, nil
+}
+
+func toMatch(row Row) (models.Match, error) {
+	id, err := strconv.ParseInt(strings.TrimSpace(row.MatchID), 10, 64)
+	if err != nil || id <= 0 {
+		return models.Match{}, fmt.Errorf("match_id %q must be a positive integer", row.MatchID)
+	}
+
+	kickoff, err := time.Parse(time.RFC3339, strings.TrimSpace(row.Kickoff))
+	if err != nil {
+		return models.Match{}, fmt.Errorf("kickoff %q must be RFC3339 with an offset, e.g. 2026-03-07T19:30:00+03:00", row.Kickoff)
+	}
+
+	home, away := strings.TrimSpace(row.HomeClubID), strings.TrimSpace(row.AwayClubID)
+	if home == "" || away == "" {
+		return models.Match{}, errors.New("home_club_id and away_club_id are required")
+	}
+	if home == away {
+		return models.Match{}, fmt.Errorf("home and away club are the same: %s", home)
+	}
+
+	link := strings.TrimSpace(row.TicketsLink)
+	if link != "" {
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return models.Match{}, fmt.Errorf("tickets_link %q must be an http(s) url", row.TicketsLink)
+		}
+	}
+
+	confirmed := true
+	if row.KickoffConfirmed != nil {
+		confirmed = *row.KickoffConfirmed
+	}
+
+	return models.Match{
+		ID:               models.MatchID(strconv.FormatInt(id, 10)),
+		HomeTeam:         home,
+		AwayTeam:         away,
+		City:             strings.TrimSpace(row.City),
+		Stadium:          strings.TrimSpace(row.Stadium),
+		TicketsLink:      link,
+		KickoffUTC:       kickoff.UTC(),
+		KickoffTentative: !confirmed,
+	}, nil
+}
+
+func (s *Source) FetchByID(_ context.Context, id models.MatchID) (models.Match, error) {
+	match, ok := s.matches[id]
+	if !ok {
+		return models.Match{}, derr.ErrMatchNotFound
+	}
+	return match, nil
+}
+
+func (s *Source) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error) {
+	upcoming, err := s.FetchUpcoming(ctx, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]models.MatchID, 0, len(upcoming))
+	for _, m := range upcoming {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+
+// FetchUpcoming lists up to limit matches between from and to in kickoff order.
+func (s *Source) FetchUpcoming(_ context.Context, from time.Time, to time.Time, limit int) ([]models.MatchKickoff, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	upcoming := make([]models.MatchKickoff, 0, min(limit, len(s.order)))
+	for _, id := range s.order {
+		kickoff := s.matches[id].KickoffUTC
+		if kickoff.Before(from) || kickoff.After(to) {
+			continue
+		}
+		upcoming = append(upcoming, models.MatchKickoff{ID: id, KickoffUTC: kickoff})
+		if len(upcoming) >= limit {
+			break
+		}
+	}
+	return upcoming, nil
+}
+
+// Range returns the first and the last kickoff of the schedule.
+func (s *Source) Range() (time.Time, time.Time) {
+	return s.matches[s.order[0]].KickoffUTC, s.matches[s.order[len(s.order)-1]].KickoffUTC
+}
+
+// Len is the number of matches in the schedule.
+func (s *Source) Len() int {
+	return len(s.order)
+}
@@ -0,0 +1,114 @@
+package filesource
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+)
+
+func TestParseCSV(t *testing.T) {
+	rows, err := ParseCSV(strings.NewReader(
+		"home_club_id,away_club_id,match_id,kickoff,stadium,city,tickets_link,kickoff_confirmed\n" +
+			"3,11,2,2026-03-07T19:30:00+03:00,Газпром Арена,Санкт-Петербург,https://tickets.example,\n" +
+			"1,584,1,2026-03-07T00:00:00+03:00,Лукойл Арена,Москва,,false\n",
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := NewSource(rows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids, err := source.FetchUpcomingIDs(context.Background(), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Fatalf("expected ids ordered by kickoff, got %v", ids)
+	}
+
+	match, err := source.FetchByID(context.Background(), "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !match.KickoffUTC.Equal(time.Date(2026, 3, 7, 16, 30, 0, 0, time.UTC)) || match.HomeTeam != "3" || match.City != "Санкт-Петербург" || match.KickoffTentative {
+		t.Fatalf("unexpected match: %+v", match)
+	}
+
+	match, _ = source.FetchByID(context.Background(), "1")
+	if !match.KickoffTentative {
+		t.Fatalf("expected tentative kickoff: %+v", match)
+	}
+
+	if _, err := source.FetchByID(context.Background(), "3"); !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestParseCSV_MissingColumn(t *testing.T) {
+	if _, err := ParseCSV(strings.NewReader("match_id,kickoff\n1,2026-03-07T19:30:00+03:00\n")); err == nil {
+		t.Fatal("expected missing column error")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	for _, raw := range []string{
+		`[{"match_id":"1","kickoff":"2026-03-07T19:30:00+03:00","home_club_id":"3","away_club_id":"11"}]`,
+		`{"matches":[{"match_id":"1","kickoff":"2026-03-07T19:30:00+03:00","home_club_id":"3","away_club_id":"11"}]}`,
+	} {
+		rows, err := ParseJSON(strings.NewReader(raw))
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", raw, err)
+		}
+		if len(rows) != 1 || rows[0].MatchID != "1" || rows[0].AwayClubID != "11" {
+			t.Fatalf("unexpected rows for %s: %+v", raw, rows)
+		}
+	}
+}
+
+func TestNewSource_Validation(t *testing.T) {
+	valid := Row{MatchID: "1", Kickoff: "2026-03-07T19:30:00+03:00", HomeClubID: "3", AwayClubID: "11"}
+
+	cases := map[string]func(r *Row){
+		"bad id":           func(r *Row) { r.MatchID = "abc" },
+		"kickoff offset":   func(r *Row) { r.Kickoff = "2026-03-07T19:30:00" },
+		"missing club":     func(r *Row) { r.AwayClubID = "" },
+		"same clubs":       func(r *Row) { r.AwayClubID = r.HomeClubID },
+		"bad tickets link": func(r *Row) { r.TicketsLink = "tickets.example" },
+	}
+	for name, mutate := range cases {
+		row := valid
+		mutate(&row)
+		if _, err := NewSource([]Row{row}); err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+
+	_, err := NewSource([]Row{valid, valid, {MatchID: "2"}})
+	if err == nil || !strings.Contains(err.Error(), "row 2: duplicate") || !strings.Contains(err.Error(), "row 3:") {
+		t.Fatalf("expected every invalid row to be reported, got %v", err)
+	}
+}
+
+func TestLoad_Example(t *testing.T) {
+	source, err := Load("../../../config/schedule.example.csv")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.Len() != 8 {
+		t.Fatalf("expected 8 matches, got %d", source.Len())
+	}
+
+	first, last := source.Range()
+	ids, err := source.FetchUpcomingIDs(context.Background(), first, last, 3)
+	if err != nil || len(ids) != 3 || ids[0] != models.MatchID("900001") {
+		t.Fatalf("unexpected ids: %v %v", ids, err)
+	}
+}
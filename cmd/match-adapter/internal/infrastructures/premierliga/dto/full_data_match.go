@@ -14,4 +14,6 @@ type GetFullDataMatchResponse struct {
 	Stadium     string `json:"stadium"`
 	ClubHome    *int64 `json:"clubH"`
 	ClubAway    *int64 `json:"clubA"`
+	GoalHome    *int   `json:"goalH"`
+	GoalAway    *int   `json:"goalA"`
 }
@@ -13,7 +13,11 @@ import (
 	"time"
 
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga/dto"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/metrics"
+	"go.uber.org/zap"
 )
 
 type Client struct {
@@ -21,6 +25,11 @@ type Client struct {
 	httpClient  *http.Client
 	maxAttempts int
 	baseBackoff time.Duration
+
+	log     *zap.Logger
+	archive ports.SourceResponseArchive
+	source  string
+	replay  bool
 }
 
 const (
@@ -32,7 +41,18 @@ const (
 	matchesPath       = "/api/getMatches"
 )
 
-const maxErrorBodyBytes = 4096
+const (
+	maxErrorBodyBytes = 4096
+	maxBodyBytes      = 32 << 20
+)
+
+// archivedEndpoints are the responses the match pipeline is built from; the
+// rest of the API is only used by hand.
+var archivedEndpoints = map[string]struct{}{
+	fullDataMatchPath: {},
+	matchesPath:       {},
+	tournamentsPath:   {},
+}
 
 type StatusError struct {
 	StatusCode int
@@ -66,6 +86,25 @@ func NewClient(baseURL string, httpClient *http.Client, maxAttempts int, baseBac
 	}
 }
 
+// WithArchive stores raw responses of the archived endpoints under the source
+// name. Archive failures are logged and never fail the request.
+func (c *Client) WithArchive(log *zap.Logger, archive ports.SourceResponseArchive, source string) *Client {
+	if log == nil {
+		log = zap.NewNop()
+	}
+	c.log = log
+	c.archive = archive
+	c.source = source
+	return c
+}
+
+// WithReplay serves the latest archived response for the same request body
+// instead of calling the API. It needs an archive set with WithArchive.
+func (c *Client) WithReplay() *Client {
+	c.replay = true
+	return c
+}
+
 func (c *Client) GetFullDataMatch(ctx context.Context, id int64) (dto.GetFullDataMatchResponse, error) {
 	reqBody := dto.GetFullDataMatchRequest{ID: id}
 	var resp dto.GetFullDataMatchResponse
@@ -129,7 +168,9 @@ func (c *Client) postWithRetry(ctx context.Context, endpointPath string, reqBody
 
 	var lastErr error
 	for attempt := 1; attempt <= c.maxAttempts; attempt++ {
+		start := time.Now()
 		err := c.postOnce(ctx, endpointPath, payload, out, notFoundErr)
+		observeCall(endpointPath, start, err, notFoundErr)
 		if err == nil {
 			return nil
 		}
@@ -150,6 +191,7 @@ func (c *Client) postWithRetry(ctx context.Context, endpointPath string, reqBody
 			return ctx.Err()
 		case <-timer.C:
 		}
+		metrics.SourceRetries.WithLabelValues(endpointPath).Inc()
 	}
 
 	if lastErr == nil {
@@ -159,59 +201,140 @@ func (c *Client) postWithRetry(ctx context.Context, endpointPath string, reqBody
 	return lastErr
 }
 
-func (c *Client) postOnce(ctx context.Context, endpointPath string, payload []byte, out any, notFoundErr error) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpointURL(endpointPath), bytes.NewReader(payload))
-	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+// observeCall records one attempt; not found is a valid answer of the source.
+func observeCall(endpointPath string, start time.Time, err, notFoundErr error) {
+	result := metrics.ResultOK
+	switch {
+	case err == nil:
+	case errors.Is(err, context.DeadlineExceeded):
+		result = metrics.ResultTimeout
+	case errors.Is(err, context.Canceled):
+		result = metrics.ResultCanceled
+	case notFoundErr != nil && errors.Is(err, notFoundErr):
+		result = metrics.ResultNotFound
+	case errors.Is(err, derr.ErrSourceUnavailable):
+		result = metrics.ResultUnavailable
+	default:
+		result = metrics.ResultError
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	metrics.SourceRequests.WithLabelValues(endpointPath, result).Inc()
+	metrics.SourceDuration.WithLabelValues(endpointPath).Observe(time.Since(start).Seconds())
+}
+
+func (c *Client) postOnce(ctx context.Context, endpointPath string, payload []byte, out any, notFoundErr error) error {
+	statusCode, status, body, err := c.fetch(ctx, endpointPath, payload)
 	if err != nil {
-		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-			return err
-		}
-		return fmt.Errorf("%w: do request: %v", derr.ErrSourceUnavailable, err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		body := readBodySafe(resp.Body)
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
 		statusErr := &StatusError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       body,
+			StatusCode: statusCode,
+			Status:     status,
+			Body:       errorBody(body),
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
+		if statusCode == http.StatusNotFound {
 			if notFoundErr != nil {
 				return notFoundErr
 			}
 			return fmt.Errorf("%w: %v", derr.ErrSourceUnavailable, statusErr)
 		}
-		if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		if statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests {
 			return fmt.Errorf("%w: %v", derr.ErrSourceUnavailable, statusErr)
 		}
 		return statusErr
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+	if err := json.Unmarshal(body, out); err != nil {
 		return fmt.Errorf("decode response: %w", err)
 	}
 
 	return nil
 }
 
-func readBodySafe(body io.Reader) string {
-	if body == nil {
-		return ""
+func (c *Client) fetch(ctx context.Context, endpointPath string, payload []byte) (int, string, []byte, error) {
+	if c.replay {
+		return c.fetchArchived(ctx, endpointPath, payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpointURL(endpointPath), bytes.NewReader(payload))
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, "", nil, err
+		}
+		return 0, "", nil, fmt.Errorf("%w: do request: %v", derr.ErrSourceUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, "", nil, err
+		}
+		return 0, "", nil, fmt.Errorf("%w: read response: %v", derr.ErrSourceUnavailable, err)
+	}
+
+	c.store(ctx, endpointPath, payload, resp.StatusCode, body)
+
+	return resp.StatusCode, resp.Status, body, nil
+}
+
+func (c *Client) fetchArchived(ctx context.Context, endpointPath string, payload []byte) (int, string, []byte, error) {
+	if c.archive == nil {
+		return 0, "", nil, errors.New("replay requires an archive")
+	}
+
+	archived, err := c.archive.LatestSourceResponse(ctx, c.source, endpointPath, payload)
+	if err != nil {
+		if errors.Is(err, derr.ErrSourceResponseNotFound) {
+			return 0, "", nil, fmt.Errorf("%w: no archived response for %s %s", derr.ErrSourceUnavailable, endpointPath, payload)
+		}
+		return 0, "", nil, fmt.Errorf("load archived response: %w", err)
+	}
+
+	status := fmt.Sprintf("%d %s", archived.StatusCode, http.StatusText(archived.StatusCode))
+	return archived.StatusCode, status, archived.Body, nil
+}
+
+func (c *Client) store(ctx context.Context, endpointPath string, payload []byte, statusCode int, body []byte) {
+	if c.archive == nil {
+		return
+	}
+	if _, ok := archivedEndpoints[endpointPath]; !ok {
+		return
 	}
 
-	raw, err := io.ReadAll(io.LimitReader(body, maxErrorBodyBytes))
+	err := c.archive.StoreSourceResponse(ctx, models.SourceResponse{
+		Source:      c.source,
+		Endpoint:    endpointPath,
+		RequestBody: payload,
+		StatusCode:  statusCode,
+		Body:        body,
+		FetchedAt:   time.Now().UTC(),
+	})
 	if err != nil {
-		return ""
+		c.log.Warn(
+			"failed to archive source response",
+			zap.String("source", c.source),
+			zap.String("endpoint", endpointPath),
+			zap.Error(err),
+		)
+	}
+}
+
+func errorBody(body []byte) string {
+	if len(body) > maxErrorBodyBytes {
+		body = body[:maxErrorBodyBytes]
 	}
-	return strings.TrimSpace(string(raw))
+	return strings.TrimSpace(string(body))
 }
 
 func (c *Client) endpointURL(path string) string {
@@ -12,6 +12,9 @@ import (
 	"time"
 
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/lib/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestGetFullDataMatch_404OnFullDataEndpointMapsToNotFound(t *testing.T) {
@@ -101,3 +104,107 @@ func TestGetHistoryGames(t *testing.T) {
 		t.Fatalf("unexpected history response: %+v", history)
 	}
 }
+
+type archiveMock struct {
+	stored []models.SourceResponse
+}
+
+func (m *archiveMock) StoreSourceResponse(_ context.Context, resp models.SourceResponse) error {
+	m.stored = append(m.stored, resp)
+	return nil
+}
+
+func (m *archiveMock) LatestSourceResponse(_ context.Context, source, endpoint string, requestBody []byte) (models.SourceResponse, error) {
+	for i := len(m.stored) - 1; i >= 0; i-- {
+		r := m.stored[i]
+		if r.Source == source && r.Endpoint == endpoint && string(r.RequestBody) == string(requestBody) {
+			return r, nil
+		}
+	}
+	return models.SourceResponse{}, derr.ErrSourceResponseNotFound
+}
+
+func (m *archiveMock) PurgeSourceResponses(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestClient_ArchivesAndReplaysResponses(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/api/getFullDataMatch":
+			_, _ = w.Write([]byte(`{"id":16114,"date":"2026-02-27T19:30:00Z","city":"Москва"}`))
+		case "/api/getClubs":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	archive := &archiveMock{}
+	live := NewClient(srv.URL, srv.Client(), 1, time.Millisecond).WithArchive(nil, archive, "premierliga")
+	if _, err := live.GetFullDataMatch(context.Background(), 16114); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := live.GetClubs(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(archive.stored) != 1 {
+		t.Fatalf("expected only getFullDataMatch to be archived, got %d responses", len(archive.stored))
+	}
+	rec := archive.stored[0]
+	if rec.Endpoint != "/api/getFullDataMatch" || rec.StatusCode != http.StatusOK || string(rec.RequestBody) != `{"id":16114}` {
+		t.Fatalf("unexpected archived response: %+v", rec)
+	}
+
+	replay := NewClient(srv.URL, srv.Client(), 1, time.Millisecond).WithArchive(nil, archive, "premierliga").WithReplay()
+	resp, err := replay.GetFullDataMatch(context.Background(), 16114)
+	if err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if resp.ID != 16114 || resp.City != "Москва" {
+		t.Fatalf("unexpected replayed response: %+v", resp)
+	}
+	if calls != 2 {
+		t.Fatalf("expected replay not to call the API, got %d calls", calls)
+	}
+
+	if _, err := replay.GetFullDataMatch(context.Background(), 1); !errors.Is(err, derr.ErrSourceUnavailable) {
+		t.Fatalf("expected ErrSourceUnavailable for a request missing in the archive, got %v", err)
+	}
+}
+
+func TestGetFullDataMatch_RecordsAttemptsAndRetries(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	unavailable := testutil.ToFloat64(metrics.SourceRequests.WithLabelValues(fullDataMatchPath, metrics.ResultUnavailable))
+	notFound := testutil.ToFloat64(metrics.SourceRequests.WithLabelValues(fullDataMatchPath, metrics.ResultNotFound))
+	retries := testutil.ToFloat64(metrics.SourceRetries.WithLabelValues(fullDataMatchPath))
+
+	c := NewClient(srv.URL, srv.Client(), 3, time.Millisecond)
+	if _, err := c.GetFullDataMatch(context.Background(), 16114); !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+
+	if got := testutil.ToFloat64(metrics.SourceRequests.WithLabelValues(fullDataMatchPath, metrics.ResultUnavailable)) - unavailable; got != 1 {
+		t.Fatalf("expected 1 unavailable attempt, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.SourceRequests.WithLabelValues(fullDataMatchPath, metrics.ResultNotFound)) - notFound; got != 1 {
+		t.Fatalf("expected 1 not found attempt, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.SourceRetries.WithLabelValues(fullDataMatchPath)) - retries; got != 1 {
+		t.Fatalf("expected 1 retry, got %v", got)
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func ToDomainMatch(resp dto.GetFullDataMatchResponse) (models.Match, error) {
-	kickoff, err := parseKickoff(resp.Date)
+	kickoff, confirmed, err := parseKickoff(resp.Date)
 	if err != nil {
 		return models.Match{}, fmt.Errorf("parse kickoff datetime: %w", err)
 	}
@@ -20,10 +20,14 @@ func ToDomainMatch(resp dto.GetFullDataMatchResponse) (models.Match, error) {
 		ID:          models.MatchID(fmt.Sprintf("%d", resp.ID)),
 		HomeTeam:    clubIDToString(resp.ClubHome),
 		AwayTeam:    clubIDToString(resp.ClubAway),
-		City:        normalizeCity(resp.City),
+		City:        strings.TrimSpace(resp.City),
 		Stadium:     resp.Stadium,
 		TicketsLink: resp.TicketsLink,
 		KickoffUTC:  kickoff.UTC(),
+		HomeScore:   resp.GoalHome,
+		AwayScore:   resp.GoalAway,
+
+		KickoffTentative: !confirmed,
 	}, nil
 }
 
@@ -34,15 +38,13 @@ func clubIDToString(id *int64) string {
 	return strconv.FormatInt(*id, 10)
 }
 
-func parseKickoff(value string) (time.Time, error) {
+// parseKickoff also reports whether the time is real. Premierliga publishes
+// rounds before the schedule is set either as a bare date or at local midnight,
+// and no league match kicks off at 00:00 Moscow time.
+func parseKickoff(value string) (time.Time, bool, error) {
 	value = strings.TrimSpace(value)
 	if value == "" {
-		return time.Time{}, fmt.Errorf("unsupported datetime format: %q", value)
-	}
-
-	// RFC3339 values include explicit timezone and can be parsed directly.
-	if t, err := time.Parse(time.RFC3339, value); err == nil {
-		return t, nil
+		return time.Time{}, false, fmt.Errorf("unsupported datetime format: %q", value)
 	}
 
 	// Premierliga returns local kickoff time in formats without timezone.
@@ -51,6 +53,11 @@ func parseKickoff(value string) (time.Time, error) {
 		loc = moscow
 	}
 
+	// RFC3339 values include explicit timezone and can be parsed directly.
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, !isPlaceholderTime(t.In(loc)), nil
+	}
+
 	localLayouts := []string{
 		"2006-01-02UTC15:04:05",
 		"2006-01-02 15:04:05",
@@ -60,22 +67,17 @@ func parseKickoff(value string) (time.Time, error) {
 	for _, layout := range localLayouts {
 		t, err := time.ParseInLocation(layout, value, loc)
 		if err == nil {
-			return t, nil
+			return t, !isPlaceholderTime(t), nil
 		}
 	}
 
-	return time.Time{}, fmt.Errorf("unsupported datetime format: %q", value)
+	if t, err := time.ParseInLocation("2006-01-02", value, loc); err == nil {
+		return t, false, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("unsupported datetime format: %q", value)
 }
 
-func normalizeCity(city string) string {
-	switch city {
-	case "\u0421\u0430\u043d\u043a\u0442-\u041f\u0435\u0442\u0435\u0440\u0431\u0443\u0440\u0433":
-		return "Saint Petersburg"
-	case "\u041c\u043e\u0441\u043a\u0432\u0430":
-		return "Moscow"
-	case "\u041a\u0430\u043b\u0438\u043d\u0438\u043d\u0433\u0440\u0430\u0434":
-		return "Kaliningrad"
-	default:
-		return city
-	}
+func isPlaceholderTime(local time.Time) bool {
+	return local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0
 }
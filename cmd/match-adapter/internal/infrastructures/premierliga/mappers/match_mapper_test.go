@@ -1,19 +1,20 @@
 package mappers
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga/dto"
 )
 
-func TestToDomainMatch_NormalizesCityAndClubIDs(t *testing.T) {
+func TestToDomainMatch_MapsCityAndClubIDs(t *testing.T) {
 	homeID := int64(10)
 	awayID := int64(20)
 	resp := dto.GetFullDataMatchResponse{
 		ID:          1,
 		Date:        "2026-02-07T15:30:00Z",
-		City:        "\u041c\u043e\u0441\u043a\u0432\u0430",
+		City:        " \u041c\u043e\u0441\u043a\u0432\u0430 ",
 		Stadium:     "Luzhniki",
 		TicketsLink: "https://tickets.test/match/1",
 		ClubHome:    &homeID,
@@ -24,8 +25,9 @@ func TestToDomainMatch_NormalizesCityAndClubIDs(t *testing.T) {
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if match.City != "Moscow" {
-		t.Fatalf("expected normalized city Moscow, got %q", match.City)
+	// city names are resolved against the city dictionary by the service
+	if match.City != "\u041c\u043e\u0441\u043a\u0432\u0430" {
+		t.Fatalf("expected trimmed source city, got %q", match.City)
 	}
 	if match.HomeTeam != "10" || match.AwayTeam != "20" {
 		t.Fatalf("expected club ids 10/20, got %s/%s", match.HomeTeam, match.AwayTeam)
@@ -64,3 +66,47 @@ func TestToDomainMatch_PremierligaLocalTimeConvertedToUTC(t *testing.T) {
 		t.Fatalf("unexpected kickoff: got %v, want %v", match.KickoffUTC, want)
 	}
 }
+
+func TestToDomainMatch_MapsScores(t *testing.T) {
+	var resp dto.GetFullDataMatchResponse
+	if err := json.Unmarshal([]byte(`{"id":1,"date":"2025-08-02T16:00:00Z","goalH":2,"goalA":0}`), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	match, err := ToDomainMatch(resp)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if match.HomeScore == nil || match.AwayScore == nil || *match.HomeScore != 2 || *match.AwayScore != 0 {
+		t.Fatalf("expected score 2:0, got %v:%v", match.HomeScore, match.AwayScore)
+	}
+
+	resp = dto.GetFullDataMatchResponse{ID: 2, Date: "2026-08-02T16:00:00Z"}
+	match, err = ToDomainMatch(resp)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if match.HomeScore != nil || match.AwayScore != nil {
+		t.Fatalf("expected no score for unplayed match, got %v:%v", match.HomeScore, match.AwayScore)
+	}
+}
+
+func TestToDomainMatch_KickoffConfirmation(t *testing.T) {
+	cases := map[string]bool{
+		"2026-03-07UTC19:30:00": false,
+		"2026-03-07T16:30:00Z":  false,
+		"2026-03-07UTC00:00:00": true,
+		"2026-03-06T21:00:00Z":  true,
+		"2026-03-07":            true,
+	}
+
+	for date, want := range cases {
+		match, err := ToDomainMatch(dto.GetFullDataMatchResponse{ID: 1, Date: date})
+		if err != nil {
+			t.Fatalf("ToDomainMatch(%q): unexpected error %v", date, err)
+		}
+		if match.KickoffTentative != want {
+			t.Fatalf("ToDomainMatch(%q): kickoff tentative %v, want %v", date, match.KickoffTentative, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
@@ -15,13 +16,36 @@ import (
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga/mappers"
 )
 
+const (
+	defaultTournamentType = 1
+	defaultMaxTournaments = 3
+	matchIDRangesTTL      = 6 * time.Hour
+)
+
 type Source struct {
-	client *client.Client
+	client         *client.Client
+	tournamentType int64
+	maxTournaments int
+
+	mu         sync.Mutex
+	idRanges   []models.MatchIDRange
+	idRangesAt time.Time
 }
 
-func NewSource(client *client.Client) *Source {
+// NewSource creates a premierliga source. tournamentType selects the tournament
+// family on the API (1 is the Premier League); zero values fall back to defaults.
+func NewSource(client *client.Client, tournamentType int64, maxTournaments int) *Source {
+	if tournamentType <= 0 {
+		tournamentType = defaultTournamentType
+	}
+	if maxTournaments <= 0 {
+		maxTournaments = defaultMaxTournaments
+	}
+
 	return &Source{
-		client: client,
+		client:         client,
+		tournamentType: tournamentType,
+		maxTournaments: maxTournaments,
 	}
 }
 
@@ -51,7 +75,21 @@ func (s *Source) FetchByID(ctx context.Context, id models.MatchID) (models.Match
 }
 
 func (s *Source) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error) {
-	tournaments, err := s.client.GetTournaments(ctx, dto.GetTournamentsRequest{Type: 1})
+	upcoming, err := s.FetchUpcoming(ctx, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]models.MatchID, 0, len(upcoming))
+	for _, m := range upcoming {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+
+// FetchUpcoming lists up to limit matches between from and to in kickoff order.
+func (s *Source) FetchUpcoming(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchKickoff, error) {
+	tournaments, err := s.client.GetTournaments(ctx, dto.GetTournamentsRequest{Type: s.tournamentType})
 	if err != nil {
 		if errors.Is(err, derr.ErrSourceUnavailable) {
 			return nil, fmt.Errorf("get tournaments: %w", derr.ErrSourceUnavailable)
@@ -66,13 +104,8 @@ func (s *Source) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.T
 	fromUTC := from.UTC()
 	toUTC := to.UTC()
 
-	type eventCandidate struct {
-		id      models.MatchID
-		kickoff time.Time
-	}
-
-	selected := selectTournamentsForRange(tournaments, fromUTC, toUTC)
-	candidates := make([]eventCandidate, 0, limit)
+	selected := selectTournamentsForRange(tournaments, fromUTC, toUTC, s.maxTournaments)
+	candidates := make([]models.MatchKickoff, 0, limit)
 	seen := make(map[models.MatchID]struct{}, limit)
 	var lastErr error
 	var loaded bool
@@ -108,9 +141,9 @@ func (s *Source) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.T
 					continue
 				}
 				seen[id] = struct{}{}
-				candidates = append(candidates, eventCandidate{
-					id:      id,
-					kickoff: kickoffUTC,
+				candidates = append(candidates, models.MatchKickoff{
+					ID:         id,
+					KickoffUTC: kickoffUTC,
 				})
 			}
 		}
@@ -121,31 +154,107 @@ func (s *Source) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.T
 	}
 
 	sort.Slice(candidates, func(i, j int) bool {
-		return candidates[i].kickoff.Before(candidates[j].kickoff)
+		return candidates[i].KickoffUTC.Before(candidates[j].KickoffUTC)
 	})
 
 	if len(candidates) > limit {
 		candidates = candidates[:limit]
 	}
 
-	ids := make([]models.MatchID, 0, len(candidates))
-	for _, c := range candidates {
-		ids = append(ids, c.id)
+	return candidates, nil
+}
+
+// MatchIDRanges returns the span of match ids of each of the latest
+// tournaments. The result is cached since it changes only when the API
+// publishes a new season; a stale list is kept while the API is down.
+func (s *Source) MatchIDRanges(ctx context.Context) ([]models.MatchIDRange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.idRanges != nil && time.Since(s.idRangesAt) < matchIDRangesTTL {
+		return s.idRanges, nil
 	}
 
-	return ids, nil
+	ranges, err := s.loadMatchIDRanges(ctx)
+	if err != nil {
+		if s.idRanges != nil {
+			return s.idRanges, nil
+		}
+		return nil, err
+	}
+
+	s.idRanges = ranges
+	s.idRangesAt = time.Now()
+	return ranges, nil
+}
+
+func (s *Source) loadMatchIDRanges(ctx context.Context) ([]models.MatchIDRange, error) {
+	tournaments, err := s.client.GetTournaments(ctx, dto.GetTournamentsRequest{Type: s.tournamentType})
+	if err != nil {
+		return nil, fmt.Errorf("get tournaments: %w", err)
+	}
+
+	sort.Slice(tournaments, func(i, j int) bool {
+		return tournaments[i].ID > tournaments[j].ID
+	})
+
+	ranges := make([]models.MatchIDRange, 0, s.maxTournaments)
+	var lastErr error
+	var loaded bool
+	for _, tournament := range tournaments {
+		if tournament.ID <= 0 {
+			continue
+		}
+		if len(ranges) >= s.maxTournaments {
+			break
+		}
+
+		stageItems, err := s.client.GetMatches(ctx, dto.GetMatchesRequest{Tournament: tournament.ID})
+		if err != nil {
+			lastErr = fmt.Errorf("get matches for tournament %d: %w", tournament.ID, err)
+			continue
+		}
+		loaded = true
+
+		var r models.MatchIDRange
+		for _, stage := range stageItems {
+			for _, match := range stage.Matches {
+				if match.ID <= 0 {
+					continue
+				}
+				if r.From == 0 || match.ID < r.From {
+					r.From = match.ID
+				}
+				if match.ID > r.To {
+					r.To = match.ID
+				}
+			}
+		}
+		if r.To > 0 {
+			ranges = append(ranges, r)
+		}
+	}
+
+	if !loaded && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return ranges, nil
 }
 
-func selectTournamentsForRange(tournaments []dto.Tournament, from, to time.Time) []dto.Tournament {
+func selectTournamentsForRange(tournaments []dto.Tournament, from, to time.Time, max int) []dto.Tournament {
 	if len(tournaments) == 0 {
 		return nil
 	}
+	if max <= 0 {
+		max = defaultMaxTournaments
+	}
 
 	sort.Slice(tournaments, func(i, j int) bool {
 		return tournaments[i].ID > tournaments[j].ID
 	})
 
-	selected := make([]dto.Tournament, 0, 3)
+	selected := make([]dto.Tournament, 0, max)
 	for _, t := range tournaments {
 		if t.ID <= 0 {
 			continue
@@ -153,7 +262,7 @@ func selectTournamentsForRange(tournaments []dto.Tournament, from, to time.Time)
 
 		if tournamentOverlapsRange(t, from, to) {
 			selected = append(selected, t)
-			if len(selected) >= 3 {
+			if len(selected) >= max {
 				break
 			}
 		}
@@ -168,7 +277,7 @@ func selectTournamentsForRange(tournaments []dto.Tournament, from, to time.Time)
 			continue
 		}
 		selected = append(selected, t)
-		if len(selected) >= 2 {
+		if len(selected) >= min(2, max) {
 			break
 		}
 	}
@@ -206,6 +315,7 @@ func parseKickoff(value string) (time.Time, error) {
 		"2006-01-02UTC15:04:05",
 		"2006-01-02 15:04:05",
 		"2006-01-02T15:04:05",
+		"2006-01-02",
 	}
 
 	for _, layout := range layouts {
@@ -52,7 +52,7 @@ func TestSource_FetchUpcomingIDs_FiltersSortsAndDeduplicates(t *testing.T) {
 	defer srv.Close()
 
 	client := plclient.NewClient(srv.URL, srv.Client(), 1, 100*time.Millisecond)
-	source := NewSource(client)
+	source := NewSource(client, 0, 0)
 
 	from := time.Date(2026, 2, 26, 0, 0, 0, 0, time.UTC)
 	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
@@ -76,7 +76,7 @@ func TestSource_FetchUpcomingIDs_Unavailable(t *testing.T) {
 	defer srv.Close()
 
 	client := plclient.NewClient(srv.URL, srv.Client(), 1, 100*time.Millisecond)
-	source := NewSource(client)
+	source := NewSource(client, 0, 0)
 
 	_, err := source.FetchUpcomingIDs(context.Background(), time.Now().UTC(), time.Now().UTC().Add(24*time.Hour), 10)
 	if !errors.Is(err, derr.ErrSourceUnavailable) {
@@ -113,7 +113,7 @@ func TestSource_FetchUpcomingIDs_UsesLatestTournamentFallback(t *testing.T) {
 	defer srv.Close()
 
 	client := plclient.NewClient(srv.URL, srv.Client(), 1, 100*time.Millisecond)
-	source := NewSource(client)
+	source := NewSource(client, 0, 0)
 
 	from := time.Date(2026, 2, 26, 0, 0, 0, 0, time.UTC)
 	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
@@ -126,3 +126,104 @@ func TestSource_FetchUpcomingIDs_UsesLatestTournamentFallback(t *testing.T) {
 		t.Fatalf("unexpected ids: %v", ids)
 	}
 }
+
+func TestSource_FetchUpcomingIDs_UsesConfiguredTournamentType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/getTournaments":
+			var req struct {
+				Type int64 `json:"type"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if req.Type != 2 {
+				t.Fatalf("expected tournament type 2, got %d", req.Type)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"id": 801, "name": "Cup", "dateFrom": "2025-07-01", "dateTo": "2026-05-31"},
+			})
+		case "/api/getMatches":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{
+					"stage": 1,
+					"matches": []map[string]any{
+						{"id": 9, "date": "2026-02-27UTC19:30:00"},
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := plclient.NewClient(srv.URL, srv.Client(), 1, 100*time.Millisecond)
+	source := NewSource(client, 2, 1)
+
+	from := time.Date(2026, 2, 26, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ids, err := source.FetchUpcomingIDs(context.Background(), from, to, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "9" {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+}
+
+func TestSource_MatchIDRanges_LatestTournamentsCached(t *testing.T) {
+	var matchesCalls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/getTournaments":
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"id": 719, "name": "RPL", "dateFrom": "2024-07-01", "dateTo": "2025-05-31"},
+				{"id": 722, "name": "RPL", "dateFrom": "2025-07-01", "dateTo": "2026-05-31"},
+				{"id": 715, "name": "RPL", "dateFrom": "2023-07-01", "dateTo": "2024-05-31"},
+			})
+		case "/api/getMatches":
+			matchesCalls++
+			var req struct {
+				Tournament int64 `json:"tournament"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			base := (req.Tournament - 700) * 1000
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"stage": 1, "matches": []map[string]any{{"id": base + 5}, {"id": base + 1}}},
+				{"stage": 2, "matches": []map[string]any{{"id": base + 240}, {"id": 0}}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := plclient.NewClient(srv.URL, srv.Client(), 1, 100*time.Millisecond)
+	source := NewSource(client, 0, 2)
+
+	ranges, err := source.MatchIDRanges(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(ranges) != 2 {
+		t.Fatalf("expected 2 ranges, got %+v", ranges)
+	}
+	if ranges[0].From != 22001 || ranges[0].To != 22240 || ranges[1].From != 19001 || ranges[1].To != 19240 {
+		t.Fatalf("unexpected ranges %+v", ranges)
+	}
+
+	if _, err := source.MatchIDRanges(context.Background()); err != nil {
+		t.Fatalf("expected no error on cached call, got %v", err)
+	}
+	if matchesCalls != 2 {
+		t.Fatalf("expected ranges to be cached, got %d getMatches calls", matchesCalls)
+	}
+}
@@ -0,0 +1,240 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/ports"
+	"go.uber.org/zap"
+)
+
+// Source is a match source that also tells the kickoffs of the upcoming
+// matches it lists, so that the registry can merge several sources in order.
+type Source interface {
+	ports.MatchSource
+	FetchUpcoming(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchKickoff, error)
+}
+
+// Entry is a single configured match source. Source-local match ids are shifted
+// by IDOffset so that every source owns its own range of public ids, which
+// ends at the next offset.
+type Entry struct {
+	Name        string
+	Competition string
+	IDOffset    int64
+	Source      Source
+
+	idLimit int64
+}
+
+// globalID shifts a local id into the range of e. An id that would run into
+// the range of the next source is rejected rather than handed to it.
+func (e Entry) globalID(local int64) (int64, bool) {
+	if local < 0 || local >= e.idLimit-e.IDOffset {
+		return 0, false
+	}
+	return local + e.IDOffset, true
+}
+
+// Registry fans requests out to the configured sources and implements ports.MatchSource.
+type Registry struct {
+	log     *zap.Logger
+	entries []Entry
+}
+
+func NewRegistry(log *zap.Logger, entries ...Entry) (*Registry, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("no match sources configured")
+	}
+
+	sorted := make([]Entry, 0, len(entries))
+	offsets := make(map[int64]string, len(entries))
+	for _, e := range entries {
+		e.Name = strings.TrimSpace(e.Name)
+		e.Competition = strings.TrimSpace(e.Competition)
+		if e.Source == nil {
+			return nil, fmt.Errorf("source %q: nil source", e.Name)
+		}
+		if e.Competition == "" {
+			return nil, fmt.Errorf("source %q: empty competition", e.Name)
+		}
+		if e.IDOffset < 0 {
+			return nil, fmt.Errorf("source %q: negative id offset %d", e.Name, e.IDOffset)
+		}
+		if other, ok := offsets[e.IDOffset]; ok {
+			return nil, fmt.Errorf("source %q: id offset %d already used by %q", e.Name, e.IDOffset, other)
+		}
+		offsets[e.IDOffset] = e.Name
+		sorted = append(sorted, e)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].IDOffset > sorted[j].IDOffset
+	})
+	for i := range sorted {
+		sorted[i].idLimit = math.MaxInt64
+		if i > 0 {
+			sorted[i].idLimit = sorted[i-1].IDOffset
+		}
+	}
+
+	return &Registry{
+		log:     log,
+		entries: sorted,
+	}, nil
+}
+
+func (r *Registry) FetchByID(ctx context.Context, id models.MatchID) (models.Match, error) {
+	globalID, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("parse match id %q: %w", id, err)
+	}
+
+	entry, ok := r.entryForID(globalID)
+	if !ok {
+		return models.Match{}, derr.ErrMatchNotFound
+	}
+
+	localID := models.MatchID(strconv.FormatInt(globalID-entry.IDOffset, 10))
+	match, err := entry.Source.FetchByID(ctx, localID)
+	if err != nil {
+		return models.Match{}, fmt.Errorf("source %s: %w", entry.Name, err)
+	}
+
+	match.ID = id
+	if match.Competition == "" {
+		match.Competition = entry.Competition
+	}
+
+	return match, nil
+}
+
+// FetchUpcomingIDs asks every source for up to limit ids and keeps the limit
+// earliest kickoffs of all of them. A failing source is skipped as long as at
+// least one other source answers.
+func (r *Registry) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error) {
+	var (
+		upcoming []models.MatchKickoff
+		lastErr  error
+		loaded   bool
+	)
+
+	for _, entry := range r.entries {
+		local, err := entry.Source.FetchUpcoming(ctx, from, to, limit)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return nil, err
+			}
+			lastErr = fmt.Errorf("source %s: %w", entry.Name, err)
+			r.log.Warn(
+				"failed to fetch upcoming ids from source",
+				zap.String("source", entry.Name),
+				zap.String("competition", entry.Competition),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		loaded = true
+		for _, m := range local {
+			n, err := strconv.ParseInt(string(m.ID), 10, 64)
+			if err != nil {
+				r.log.Warn(
+					"source returned non-numeric match id",
+					zap.String("source", entry.Name),
+					zap.String("match_id", string(m.ID)),
+				)
+				continue
+			}
+			id, ok := entry.globalID(n)
+			if !ok {
+				r.log.Error(
+					"source returned match id outside of its range",
+					zap.String("source", entry.Name),
+					zap.String("match_id", string(m.ID)),
+					zap.Int64("id_limit", entry.idLimit-entry.IDOffset),
+				)
+				continue
+			}
+			upcoming = append(upcoming, models.MatchKickoff{
+				ID:         models.MatchID(strconv.FormatInt(id, 10)),
+				KickoffUTC: m.KickoffUTC,
+			})
+		}
+	}
+
+	if !loaded && lastErr != nil {
+		return nil, lastErr
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].KickoffUTC.Before(upcoming[j].KickoffUTC)
+	})
+	if limit > 0 && len(upcoming) > limit {
+		upcoming = upcoming[:limit]
+	}
+
+	ids := make([]models.MatchID, 0, len(upcoming))
+	for _, m := range upcoming {
+		ids = append(ids, m.ID)
+	}
+	return ids, nil
+}
+
+// MatchIDRanger is implemented by sources that can list the ids of the
+// tournaments they serve.
+type MatchIDRanger interface {
+	MatchIDRanges(ctx context.Context) ([]models.MatchIDRange, error)
+}
+
+// IsKnownMatchID reports whether id belongs to a tournament of its source.
+// Sources that don't list their ranges accept every id they own.
+func (r *Registry) IsKnownMatchID(ctx context.Context, id models.MatchID) (bool, error) {
+	globalID, err := strconv.ParseInt(string(id), 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	entry, ok := r.entryForID(globalID)
+	if !ok {
+		return false, nil
+	}
+
+	ranger, ok := entry.Source.(MatchIDRanger)
+	if !ok {
+		return true, nil
+	}
+
+	ranges, err := ranger.MatchIDRanges(ctx)
+	if err != nil {
+		return false, fmt.Errorf("source %s: match id ranges: %w", entry.Name, err)
+	}
+
+	localID := globalID - entry.IDOffset
+	for _, rng := range ranges {
+		if rng.Contains(localID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *Registry) entryForID(id int64) (Entry, bool) {
+	if id < 0 {
+		return Entry{}, false
+	}
+	for _, e := range r.entries {
+		if id >= e.IDOffset && id < e.idLimit {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
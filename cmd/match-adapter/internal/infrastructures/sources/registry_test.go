@@ -0,0 +1,223 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type sourceMock struct {
+	matches   map[models.MatchID]models.Match
+	upcoming  []models.MatchKickoff
+	idsErr    error
+	requested []models.MatchID
+}
+
+func (m *sourceMock) FetchByID(_ context.Context, id models.MatchID) (models.Match, error) {
+	m.requested = append(m.requested, id)
+	match, ok := m.matches[id]
+	if !ok {
+		return models.Match{}, derr.ErrMatchNotFound
+	}
+	return match, nil
+}
+
+func (m *sourceMock) FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error) {
+	upcoming, err := m.FetchUpcoming(ctx, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]models.MatchID, 0, len(upcoming))
+	for _, u := range upcoming {
+		ids = append(ids, u.ID)
+	}
+	return ids, nil
+}
+
+func (m *sourceMock) FetchUpcoming(_ context.Context, _ time.Time, _ time.Time, limit int) ([]models.MatchKickoff, error) {
+	if m.idsErr != nil {
+		return nil, m.idsErr
+	}
+	return m.upcoming[:min(limit, len(m.upcoming))], nil
+}
+
+func TestRegistry_FetchByID_RoutesByOffset(t *testing.T) {
+	rpl := &sourceMock{matches: map[models.MatchID]models.Match{"42": {ID: "42", City: "Moscow"}}}
+	cup := &sourceMock{matches: map[models.MatchID]models.Match{"42": {ID: "42", City: "Kazan"}}}
+
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: rpl},
+		Entry{Name: "cup", Competition: models.CompetitionRussianCup, IDOffset: 1_000_000_000, Source: cup},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	got, err := registry.FetchByID(context.Background(), "1000000042")
+	if err != nil {
+		t.Fatalf("fetch cup match: %v", err)
+	}
+	if got.ID != "1000000042" || got.City != "Kazan" || got.Competition != models.CompetitionRussianCup {
+		t.Fatalf("unexpected cup match: %+v", got)
+	}
+	if len(cup.requested) != 1 || cup.requested[0] != "42" {
+		t.Fatalf("expected cup source to receive local id 42, got %v", cup.requested)
+	}
+
+	got, err = registry.FetchByID(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("fetch rpl match: %v", err)
+	}
+	if got.City != "Moscow" || got.Competition != models.CompetitionPremierLeague {
+		t.Fatalf("unexpected rpl match: %+v", got)
+	}
+}
+
+func TestRegistry_FetchByID_NotFoundKeepsSentinel(t *testing.T) {
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: &sourceMock{}},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	_, err = registry.FetchByID(context.Background(), "7")
+	if !errors.Is(err, derr.ErrMatchNotFound) {
+		t.Fatalf("expected ErrMatchNotFound, got %v", err)
+	}
+}
+
+type rangedSourceMock struct {
+	sourceMock
+	ranges []models.MatchIDRange
+}
+
+func (m *rangedSourceMock) MatchIDRanges(_ context.Context) ([]models.MatchIDRange, error) {
+	return m.ranges, nil
+}
+
+func TestRegistry_IsKnownMatchID(t *testing.T) {
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: &rangedSourceMock{
+			ranges: []models.MatchIDRange{{From: 16000, To: 16240}},
+		}},
+		Entry{Name: "schedule", Competition: "cup", IDOffset: 1_000_000, Source: &sourceMock{}},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	cases := map[models.MatchID]bool{
+		"16114":    true,
+		"16241":    false,
+		"15999":    false,
+		"1000042":  true,
+		"-5":       false,
+		"not-a-id": false,
+	}
+	for id, want := range cases {
+		got, err := registry.IsKnownMatchID(context.Background(), id)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", id, err)
+		}
+		if got != want {
+			t.Fatalf("%s: expected %v, got %v", id, want, got)
+		}
+	}
+}
+
+func TestRegistry_FetchUpcomingIDs_MergesByKickoffAndSkipsFailedSource(t *testing.T) {
+	day := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	at := func(id models.MatchID, hours int) models.MatchKickoff {
+		return models.MatchKickoff{ID: id, KickoffUTC: day.Add(time.Duration(hours) * time.Hour)}
+	}
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: &sourceMock{upcoming: []models.MatchKickoff{at("1", 12), at("2", 15), at("3", 40)}}},
+		Entry{Name: "cup", Competition: models.CompetitionRussianCup, IDOffset: 1_000_000_000, Source: &sourceMock{upcoming: []models.MatchKickoff{at("5", 13), at("6", 20)}}},
+		Entry{Name: "fnl", Competition: models.CompetitionFNL, IDOffset: 2_000_000_000, Source: &sourceMock{idsErr: derr.ErrSourceUnavailable}},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	ids, err := registry.FetchUpcomingIDs(context.Background(), day, day.AddDate(0, 0, 7), 4)
+	if err != nil {
+		t.Fatalf("fetch upcoming ids: %v", err)
+	}
+
+	want := []models.MatchID{"1", "1000000005", "2", "1000000006"}
+	if len(ids) != len(want) {
+		t.Fatalf("expected %v, got %v", want, ids)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, ids)
+		}
+	}
+}
+
+func TestRegistry_RangesEndAtNextOffset(t *testing.T) {
+	kickoff := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	rpl := &sourceMock{
+		matches: map[models.MatchID]models.Match{"999999999": {City: "Moscow"}},
+		upcoming: []models.MatchKickoff{
+			{ID: "999999999", KickoffUTC: kickoff},
+			{ID: "1000000000", KickoffUTC: kickoff},
+		},
+	}
+	cup := &sourceMock{
+		matches:  map[models.MatchID]models.Match{"0": {City: "Kazan"}},
+		upcoming: []models.MatchKickoff{{ID: "7", KickoffUTC: kickoff.Add(time.Hour)}},
+	}
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: rpl},
+		Entry{Name: "cup", Competition: models.CompetitionRussianCup, IDOffset: 1_000_000_000, Source: cup},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	ids, err := registry.FetchUpcomingIDs(context.Background(), time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("fetch upcoming ids: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "999999999" || ids[1] != "1000000007" {
+		t.Fatalf("expected the base id past its range to be dropped, got %v", ids)
+	}
+
+	for id, city := range map[models.MatchID]string{"999999999": "Moscow", "1000000000": "Kazan"} {
+		got, err := registry.FetchByID(context.Background(), id)
+		if err != nil || got.City != city {
+			t.Fatalf("FetchByID(%s) = %+v, %v, want %s", id, got, err, city)
+		}
+	}
+}
+
+func TestRegistry_FetchUpcomingIDs_AllSourcesFailed(t *testing.T) {
+	registry, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "premierliga", Competition: models.CompetitionPremierLeague, Source: &sourceMock{idsErr: derr.ErrSourceUnavailable}},
+	)
+	if err != nil {
+		t.Fatalf("new registry: %v", err)
+	}
+
+	_, err = registry.FetchUpcomingIDs(context.Background(), time.Now(), time.Now().Add(time.Hour), 10)
+	if !errors.Is(err, derr.ErrSourceUnavailable) {
+		t.Fatalf("expected ErrSourceUnavailable, got %v", err)
+	}
+}
+
+func TestNewRegistry_RejectsDuplicateOffsets(t *testing.T) {
+	_, err := NewRegistry(zap.NewNop(),
+		Entry{Name: "a", Competition: models.CompetitionPremierLeague, Source: &sourceMock{}},
+		Entry{Name: "b", Competition: models.CompetitionRussianCup, Source: &sourceMock{}},
+	)
+	if err == nil {
+		t.Fatal("expected error for duplicate id offsets")
+	}
+}
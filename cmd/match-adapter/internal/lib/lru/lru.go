@@ -0,0 +1,97 @@
+// Package lru is a small in-process cache with a size bound and entry TTL.
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type Cache[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	order *list.List
+	items map[K]*list.Element
+	now   func() time.Time
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+func New[K comparable, V any](size int, ttl time.Duration) *Cache[K, V] {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &Cache[K, V]{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[K]*list.Element, size),
+		now:   time.Now,
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	el, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	if !c.now().Before(e.expiresAt) {
+		c.order.Remove(el)
+		delete(c.items, key)
+		return zero, false
+	}
+
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[K, V]).key)
+	}
+}
+
+func (c *Cache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.order.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.items = make(map[K]*list.Element, c.size)
+}
@@ -0,0 +1,57 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](2, time.Minute)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	c.Add("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %d %v", v, ok)
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Fatalf("expected c=3, got %d %v", v, ok)
+	}
+}
+
+func TestCache_ExpiresEntries(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := New[string, int](4, 30*time.Second)
+	c.now = func() time.Time { return now }
+
+	c.Add("a", 1)
+	now = now.Add(29 * time.Second)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a before ttl")
+	}
+	now = now.Add(time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to expire")
+	}
+}
+
+func TestCache_RemoveAndPurge(t *testing.T) {
+	c := New[string, int](4, time.Minute)
+	c.Add("a", 1)
+	c.Add("b", 2)
+
+	c.Remove("a")
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to be removed")
+	}
+	c.Purge()
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("expected cache to be empty after purge")
+	}
+}
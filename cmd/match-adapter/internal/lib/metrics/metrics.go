@@ -0,0 +1,55 @@
+// Package metrics holds the Prometheus collectors of match-adapter. They live
+// in the default registry, which Handler serves.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	SourceRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "premierliga_requests_total",
+		Help: "Premierliga API calls by endpoint and result, every retry attempt is counted.",
+	}, []string{"endpoint", "result"})
+	SourceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "premierliga_request_duration_seconds",
+		Help:    "Latency of Premierliga API calls.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint"})
+	SourceRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "premierliga_retries_total",
+		Help: "Premierliga API calls repeated after a temporary failure.",
+	}, []string{"endpoint"})
+
+	SyncRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "match_sync_runs_total",
+		Help: "Upcoming matches sync runs by result.",
+	}, []string{"result"})
+	SyncDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "match_sync_duration_seconds",
+		Help:    "Duration of upcoming matches sync runs.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600},
+	})
+	SyncMatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "match_sync_matches_total",
+		Help: "Matches handled by sync runs, saved or failed.",
+	}, []string{"result"})
+)
+
+// Source request results.
+const (
+	ResultOK          = "ok"
+	ResultNotFound    = "not_found"
+	ResultUnavailable = "unavailable"
+	ResultError       = "error"
+	ResultTimeout     = "timeout"
+	ResultCanceled    = "canceled"
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
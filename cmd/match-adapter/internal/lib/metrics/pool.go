@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+// RegisterRedisPool exposes the connection pool of a Redis client.
+func RegisterRedisPool(client *redis.Client) {
+	stat := func(value func(*redis.PoolStats) uint32) func() float64 {
+		return func() float64 { return float64(value(client.PoolStats())) }
+	}
+
+	prometheus.MustRegister(
+		counterFunc("redis_pool_hits_total", "Times a free connection was found in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Hits })),
+		counterFunc("redis_pool_misses_total", "Times a free connection was not found in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Misses })),
+		counterFunc("redis_pool_timeouts_total", "Times a wait for a connection timed out.",
+			stat(func(s *redis.PoolStats) uint32 { return s.Timeouts })),
+		counterFunc("redis_pool_stale_connections_total", "Stale connections removed from the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.StaleConns })),
+		gaugeFunc("redis_pool_total_connections", "Connections in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.TotalConns })),
+		gaugeFunc("redis_pool_idle_connections", "Idle connections in the pool.",
+			stat(func(s *redis.PoolStats) uint32 { return s.IdleConns })),
+	)
+}
+
+// RegisterPostgresPool exposes a pgx connection pool.
+func RegisterPostgresPool(pool func() *pgxpool.Stat) {
+	stat := func(value func(*pgxpool.Stat) float64) func() float64 {
+		return func() float64 { return value(pool()) }
+	}
+
+	prometheus.MustRegister(
+		gaugeFunc("postgres_pool_total_connections", "Connections in the pool.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) })),
+		gaugeFunc("postgres_pool_acquired_connections", "Connections in use.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) })),
+		gaugeFunc("postgres_pool_idle_connections", "Idle connections in the pool.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) })),
+		gaugeFunc("postgres_pool_max_connections", "Maximum size of the pool.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) })),
+		counterFunc("postgres_pool_acquires_total", "Connections acquired from the pool.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) })),
+		counterFunc("postgres_pool_empty_acquires_total", "Acquires that waited because the pool was empty.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) })),
+		counterFunc("postgres_pool_canceled_acquires_total", "Acquires canceled by the context.",
+			stat(func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) })),
+		counterFunc("postgres_pool_acquire_wait_seconds_total", "Time spent acquiring connections.",
+			stat(func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() })),
+	)
+}
+
+func gaugeFunc(name, help string, value func() float64) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, value)
+}
+
+func counterFunc(name, help string, value func() float64) prometheus.Collector {
+	return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, value)
+}
@@ -0,0 +1,82 @@
+// Package ratelimit keeps one token bucket per key in memory.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+// Keyed refills every bucket at perMinute tokens a minute up to burst. Buckets
+// that have been idle long enough to be full again are dropped.
+type Keyed struct {
+	mu        sync.Mutex
+	rate      float64 // tokens per second
+	burst     float64
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func NewKeyed(perMinute int, burst int) *Keyed {
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return &Keyed{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (k *Keyed) Allow(key string) bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	k.sweep(now)
+
+	b, ok := k.buckets[key]
+	if !ok {
+		b = &bucket{tokens: k.burst, last: now}
+		k.buckets[key] = b
+	}
+
+	b.tokens = k.refill(b, now)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+func (k *Keyed) refill(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return b.tokens
+	}
+	return min(k.burst, b.tokens+elapsed*k.rate)
+}
+
+func (k *Keyed) sweep(now time.Time) {
+	if now.Sub(k.lastSweep) < sweepInterval {
+		return
+	}
+	k.lastSweep = now
+
+	for key, b := range k.buckets {
+		if k.refill(b, now) >= k.burst {
+			delete(k.buckets, key)
+		}
+	}
+}
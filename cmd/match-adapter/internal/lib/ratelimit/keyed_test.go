@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeyed_BurstThenRefill(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewKeyed(60, 2)
+	l.now = func() time.Time { return now }
+
+	if !l.Allow("a") || !l.Allow("a") {
+		t.Fatal("expected burst of 2 to be allowed")
+	}
+	if l.Allow("a") {
+		t.Fatal("expected third call to be denied")
+	}
+	if !l.Allow("b") {
+		t.Fatal("expected other key to have its own bucket")
+	}
+
+	now = now.Add(time.Second)
+	if !l.Allow("a") {
+		t.Fatal("expected one token after a second")
+	}
+	if l.Allow("a") {
+		t.Fatal("expected bucket to be empty again")
+	}
+}
+
+func TestKeyed_SweepDropsFullBuckets(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewKeyed(60, 5)
+	l.now = func() time.Time { return now }
+
+	l.Allow("a")
+	l.Allow("b")
+	now = now.Add(2 * time.Minute)
+	l.Allow("c")
+
+	if _, ok := l.buckets["a"]; ok {
+		t.Fatal("expected idle bucket to be dropped")
+	}
+	if len(l.buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(l.buckets))
+	}
+}
+
+func TestKeyed_ZeroRateNeverRefills(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewKeyed(0, 1)
+	l.now = func() time.Time { return now }
+
+	if !l.Allow("a") {
+		t.Fatal("expected first call to be allowed")
+	}
+	now = now.Add(time.Hour)
+	if l.Allow("a") {
+		t.Fatal("expected no refill with zero rate")
+	}
+}
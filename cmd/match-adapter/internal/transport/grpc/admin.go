@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/application/service"
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type adminAPI struct {
+	matchv1.UnimplementedMatchAdminServiceServer
+	log     *zap.Logger
+	service *service.MatchService
+}
+
+// RegisterAdmin exposes override management. It has no authentication of its
+// own and must only be reachable from the internal network.
+func RegisterAdmin(gRPCServer *grpc.Server, log *zap.Logger, matchService *service.MatchService) {
+	matchv1.RegisterMatchAdminServiceServer(gRPCServer, &adminAPI{log: log, service: matchService})
+}
+
+func (s *adminAPI) SetMatchOverride(ctx context.Context, req *matchv1.SetMatchOverrideRequest) (*matchv1.SetMatchOverrideResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+	if req.GetMatchId() <= 0 {
+		return nil, invalidArgument("match_id", "match_id must be positive")
+	}
+	field, err := overrideFieldFromProto(req.GetField())
+	if err != nil {
+		return nil, err
+	}
+
+	override := models.MatchOverride{
+		MatchID: models.MatchID(strconv.FormatInt(req.GetMatchId(), 10)),
+		Field:   field,
+		Value:   req.GetValue(),
+		Author:  req.GetAuthor(),
+		Reason:  req.GetReason(),
+	}
+	if req.GetExpiresAt() != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			return nil, invalidArgument("expires_at", "expires_at is invalid")
+		}
+		override.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	saved, match, err := s.service.SetMatchOverride(ctx, override)
+	if err != nil {
+		s.log.Error("SetMatchOverride failed", zap.Int64("match_id", req.GetMatchId()), zap.String("field", string(field)), zap.Error(err))
+		return nil, mapOverrideError(err)
+	}
+
+	return &matchv1.SetMatchOverrideResponse{
+		Override: toProtoOverride(saved),
+		Match:    toProtoMatch(req.GetMatchId(), match),
+	}, nil
+}
+
+func (s *adminAPI) DeleteMatchOverride(ctx context.Context, req *matchv1.DeleteMatchOverrideRequest) (*matchv1.DeleteMatchOverrideResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+	if req.GetMatchId() <= 0 {
+		return nil, invalidArgument("match_id", "match_id must be positive")
+	}
+	field, err := overrideFieldFromProto(req.GetField())
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := s.service.DeleteMatchOverride(ctx, models.MatchID(strconv.FormatInt(req.GetMatchId(), 10)), field)
+	if err != nil {
+		s.log.Error("DeleteMatchOverride failed", zap.Int64("match_id", req.GetMatchId()), zap.String("field", string(field)), zap.Error(err))
+		return nil, mapOverrideError(err)
+	}
+
+	return &matchv1.DeleteMatchOverrideResponse{Match: toProtoMatch(req.GetMatchId(), match)}, nil
+}
+
+func (s *adminAPI) ListMatchOverrides(ctx context.Context, req *matchv1.ListMatchOverridesRequest) (*matchv1.ListMatchOverridesResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+	if req.GetMatchId() < 0 {
+		return nil, invalidArgument("match_id", "match_id must not be negative")
+	}
+
+	var id models.MatchID
+	if req.GetMatchId() > 0 {
+		id = models.MatchID(strconv.FormatInt(req.GetMatchId(), 10))
+	}
+
+	overrides, err := s.service.ListMatchOverrides(ctx, id, req.GetIncludeExpired())
+	if err != nil {
+		s.log.Error("ListMatchOverrides failed", zap.Int64("match_id", req.GetMatchId()), zap.Error(err))
+		return nil, mapOverrideError(err)
+	}
+
+	resp := &matchv1.ListMatchOverridesResponse{Overrides: make([]*matchv1.MatchOverride, 0, len(overrides))}
+	for _, o := range overrides {
+		resp.Overrides = append(resp.Overrides, toProtoOverride(o))
+	}
+	return resp, nil
+}
+
+var overrideFields = map[matchv1.OverrideField]models.OverrideField{
+	matchv1.OverrideField_OVERRIDE_FIELD_DESTINATION_IATA: models.OverrideDestinationIATA,
+	matchv1.OverrideField_OVERRIDE_FIELD_CITY:             models.OverrideCity,
+	matchv1.OverrideField_OVERRIDE_FIELD_STADIUM:          models.OverrideStadium,
+	matchv1.OverrideField_OVERRIDE_FIELD_KICKOFF_UTC:      models.OverrideKickoffUTC,
+	matchv1.OverrideField_OVERRIDE_FIELD_TICKETS_LINK:     models.OverrideTicketsLink,
+}
+
+func overrideFieldFromProto(field matchv1.OverrideField) (models.OverrideField, error) {
+	out, ok := overrideFields[field]
+	if !ok {
+		return "", invalidArgument("field", "field is invalid")
+	}
+	return out, nil
+}
+
+func toProtoOverrideField(field models.OverrideField) matchv1.OverrideField {
+	for proto, f := range overrideFields {
+		if f == field {
+			return proto
+		}
+	}
+	return matchv1.OverrideField_OVERRIDE_FIELD_UNSPECIFIED
+}
+
+func toProtoOverride(o models.MatchOverride) *matchv1.MatchOverride {
+	matchID, _ := strconv.ParseInt(string(o.MatchID), 10, 64)
+	out := &matchv1.MatchOverride{
+		MatchId:   matchID,
+		Field:     toProtoOverrideField(o.Field),
+		Value:     o.Value,
+		Author:    o.Author,
+		Reason:    o.Reason,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+		UpdatedAt: timestamppb.New(o.UpdatedAt),
+		Active:    o.Active(time.Now()),
+	}
+	if !o.ExpiresAt.IsZero() {
+		out.ExpiresAt = timestamppb.New(o.ExpiresAt)
+	}
+	return out
+}
+
+func mapOverrideError(err error) error {
+	switch {
+	case errors.Is(err, derr.ErrInvalidOverride):
+		// the service wraps the validation error once with its op name
+		return invalidArgument("", errors.Unwrap(err).Error())
+	case errors.Is(err, derr.ErrOverrideNotFound):
+		return statusError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND, "match override not found")
+	default:
+		return mapGetMatchError(err)
+	}
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"fmt"
+	"testing"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOverrideFieldFromProto(t *testing.T) {
+	for proto := range overrideFields {
+		field, err := overrideFieldFromProto(proto)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", proto, err)
+		}
+		if toProtoOverrideField(field) != proto {
+			t.Fatalf("field %s does not round trip", proto)
+		}
+	}
+
+	if _, err := overrideFieldFromProto(matchv1.OverrideField_OVERRIDE_FIELD_UNSPECIFIED); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestMapOverrideError(t *testing.T) {
+	err := mapOverrideError(fmt.Errorf("service.SetMatchOverride: %w", fmt.Errorf("%w: value is required", derr.ErrInvalidOverride)))
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "invalid match override: value is required" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status.Code(mapOverrideError(derr.ErrOverrideNotFound)) != codes.NotFound {
+		t.Fatal("expected not found")
+	}
+	if status.Code(mapOverrideError(derr.ErrMatchNotFound)) != codes.NotFound {
+		t.Fatal("expected not found for unknown match")
+	}
+}
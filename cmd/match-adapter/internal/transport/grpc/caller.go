@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIPMetadataKey carries the address of the end user. The gateway sets
+// it and services in between pass it on, so limits apply per user and not
+// per calling service.
+const ClientIPMetadataKey = "x-client-ip"
+
+// ParseTrustedPeers reads the addresses and CIDRs of the services allowed to
+// pass the end user address on.
+func ParseTrustedPeers(values []string) ([]netip.Prefix, error) {
+	const op = "grpc.ParseTrustedPeers"
+
+	peers := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if strings.Contains(v, "/") {
+			prefix, err := netip.ParsePrefix(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			peers = append(peers, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		addr = addr.Unmap()
+		peers = append(peers, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return peers, nil
+}
+
+// callerFromContext takes the end user address from metadata only when the
+// call comes from a trusted peer. Anyone else is limited by its own address,
+// otherwise a direct caller could pick a new x-client-ip for every request.
+func callerFromContext(ctx context.Context, trusted []netip.Prefix) string {
+	host := peerHost(ctx)
+	if !isTrustedPeer(host, trusted) {
+		return host
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get(ClientIPMetadataKey) {
+			if v = strings.TrimSpace(v); v != "" {
+				return v
+			}
+		}
+	}
+	return host
+}
+
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func isTrustedPeer(host string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the ErrorInfo domain shared by the fan-avia services.
+const errorDomain = "fan-avia"
+
+// statusError attaches the reason as google.rpc.ErrorInfo, so callers don't
+// have to match on messages.
+func statusError(code codes.Code, reason commonv1.ErrorReason, message string) error {
+	return withDetails(status.New(code, message), errorInfo(reason))
+}
+
+// invalidArgument reports a bad request field with google.rpc.BadRequest.
+// An empty field means the request as a whole.
+func invalidArgument(field, message string) error {
+	details := []protoadapt.MessageV1{errorInfo(commonv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT)}
+	if field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: message}},
+		})
+	}
+	return withDetails(status.New(codes.InvalidArgument, message), details...)
+}
+
+func internalError() error {
+	return statusError(codes.Internal, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "internal error")
+}
+
+func errorInfo(reason commonv1.ErrorReason) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason.String(), Domain: errorDomain}
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvalidArgument_Details(t *testing.T) {
+	err := invalidArgument("match_id", "match_id must be positive")
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument || st.Message() != "match_id must be positive" {
+		t.Fatalf("unexpected status: %s %q", st.Code(), st.Message())
+	}
+	if reason := errorReason(err); reason != "ERROR_REASON_INVALID_ARGUMENT" {
+		t.Fatalf("expected INVALID_ARGUMENT, got %q", reason)
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = append(violations, br.GetFieldViolations()...)
+		}
+	}
+	if len(violations) != 1 || violations[0].GetField() != "match_id" {
+		t.Fatalf("unexpected field violations: %v", violations)
+	}
+
+	if details := status.Convert(invalidArgument("", "request is required")).Details(); len(details) != 1 {
+		t.Fatalf("expected only ErrorInfo without a field, got %v", details)
+	}
+}
+
+func errorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == errorDomain {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/application/service"
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
 	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -20,24 +23,25 @@ import (
 
 type serverAPI struct {
 	matchv1.UnimplementedMatchAdapterServiceServer
-	log     *zap.Logger
-	service *service.MatchService
+	log          *zap.Logger
+	service      *service.MatchService
+	trustedPeers []netip.Prefix
 }
 
-func Register(gRPCServer *grpc.Server, log *zap.Logger, matchService *service.MatchService) {
-	matchv1.RegisterMatchAdapterServiceServer(gRPCServer, &serverAPI{log: log, service: matchService})
+func Register(gRPCServer *grpc.Server, log *zap.Logger, matchService *service.MatchService, trustedPeers []netip.Prefix) {
+	matchv1.RegisterMatchAdapterServiceServer(gRPCServer, &serverAPI{log: log, service: matchService, trustedPeers: trustedPeers})
 }
 
 func (s *serverAPI) GetMatch(ctx context.Context, req *matchv1.GetMatchRequest) (*matchv1.GetMatchResponse, error) {
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("", "request is required")
 	}
 	if req.GetMatchId() <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "match_id must be positive")
+		return nil, invalidArgument("match_id", "match_id must be positive")
 	}
 
 	id := models.MatchID(fmt.Sprintf("%d", req.GetMatchId()))
-	m, err := s.service.GetMatch(ctx, id)
+	m, err := s.service.GetMatch(service.WithCaller(ctx, callerFromContext(ctx, s.trustedPeers)), id)
 	if err != nil {
 		s.log.Error("GetMatch failed", zap.Int64("match_id", req.GetMatchId()), zap.Error(err))
 		return nil, mapGetMatchError(err)
@@ -46,103 +50,504 @@ func (s *serverAPI) GetMatch(ctx context.Context, req *matchv1.GetMatchRequest)
 	matchID, err := strconv.ParseInt(string(m.ID), 10, 64)
 	if err != nil {
 		s.log.Error("failed to parse match id", zap.String("match_id", string(m.ID)), zap.Error(err))
-		return nil, status.Error(codes.Internal, "invalid match id in storage")
+		return nil, statusError(codes.Internal, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "invalid match id in storage")
 	}
 
-	return &matchv1.GetMatchResponse{Match: toProtoMatch(matchID, m)}, nil
+	match := toProtoMatch(matchID, m)
+	if req.GetIncludeClubs() {
+		if err := s.attachClubs(ctx, []*matchv1.Match{match}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &matchv1.GetMatchResponse{Match: match}, nil
+}
+
+const maxGetMatchesIDs = 100
+
+func (s *serverAPI) GetMatches(ctx context.Context, req *matchv1.GetMatchesRequest) (*matchv1.GetMatchesResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+
+	ids, err := matchIDsFromRequest(req.GetMatchIds())
+	if err != nil {
+		return nil, err
+	}
+
+	matches, failed, err := s.service.GetMatches(service.WithCaller(ctx, callerFromContext(ctx, s.trustedPeers)), ids)
+	if err != nil {
+		s.log.Error("GetMatches failed", zap.Int("ids", len(ids)), zap.Error(err))
+		return nil, mapGetMatchError(err)
+	}
+
+	protoMatches, err := s.toProtoMatches(matches)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeClubs() {
+		if err := s.attachClubs(ctx, protoMatches); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &matchv1.GetMatchesResponse{
+		Matches: protoMatches,
+		Errors:  make([]*matchv1.MatchError, 0, len(failed)),
+	}
+	for _, id := range ids {
+		matchErr, ok := failed[id]
+		if !ok {
+			continue
+		}
+		matchID, _ := strconv.ParseInt(string(id), 10, 64)
+		if !errors.Is(matchErr, derr.ErrMatchNotFound) {
+			s.log.Error("GetMatches failed for match", zap.Int64("match_id", matchID), zap.Error(matchErr))
+		}
+		resp.Errors = append(resp.Errors, toProtoMatchError(matchID, matchErr))
+	}
+
+	return resp, nil
+}
+
+// matchIDsFromRequest validates ids and drops duplicates keeping the order.
+func matchIDsFromRequest(raw []int64) ([]models.MatchID, error) {
+	if len(raw) == 0 {
+		return nil, invalidArgument("match_ids", "match_ids must not be empty")
+	}
+	if len(raw) > maxGetMatchesIDs {
+		return nil, invalidArgument("match_ids", fmt.Sprintf("match_ids must have at most %d ids", maxGetMatchesIDs))
+	}
+
+	ids := make([]models.MatchID, 0, len(raw))
+	seen := make(map[int64]struct{}, len(raw))
+	for _, id := range raw {
+		if id <= 0 {
+			return nil, invalidArgument("match_ids", "match_ids must be positive")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, models.MatchID(strconv.FormatInt(id, 10)))
+	}
+	return ids, nil
+}
+
+func toProtoMatchError(matchID int64, err error) *matchv1.MatchError {
+	st := status.Convert(mapGetMatchError(err))
+
+	reason := matchv1.MatchErrorReason_MATCH_ERROR_REASON_INTERNAL
+	switch st.Code() {
+	case codes.NotFound:
+		reason = matchv1.MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND
+	case codes.Unavailable:
+		reason = matchv1.MatchErrorReason_MATCH_ERROR_REASON_SOURCE_UNAVAILABLE
+	case codes.ResourceExhausted:
+		reason = matchv1.MatchErrorReason_MATCH_ERROR_REASON_RATE_LIMITED
+	}
+
+	return &matchv1.MatchError{MatchId: matchID, Reason: reason, Message: st.Message()}
+}
+
+// attachClubs fills home_club and away_club from the clubs table, loaded once
+// for all matches.
+func (s *serverAPI) attachClubs(ctx context.Context, matches []*matchv1.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	clubs, err := s.service.GetClubs(ctx)
+	if err != nil {
+		s.log.Error("failed to load clubs for matches", zap.Error(err))
+		return internalError()
+	}
+
+	index := make(map[string]models.Club, len(clubs))
+	for _, club := range clubs {
+		index[club.ID] = club
+	}
+
+	for _, m := range matches {
+		if club, ok := index[m.GetClubHomeId()]; ok {
+			m.HomeClub = toProtoClub(club)
+		}
+		if club, ok := index[m.GetClubAwayId()]; ok {
+			m.AwayClub = toProtoClub(club)
+		}
+	}
+	return nil
 }
 
 func (s *serverAPI) GetUpcomingMatches(ctx context.Context, req *matchv1.GetUpcomingMatchesRequest) (*matchv1.GetUpcomingMatchesResponse, error) {
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("", "request is required")
+	}
+
+	filter, err := upcomingFilterFromRequest(req)
+	if err != nil {
+		return nil, err
 	}
 
-	limit := int(req.GetLimit())
-	if limit <= 0 {
-		limit = 10
+	matches, nextCursor, err := s.service.GetUpcomingMatches(ctx, filter, req.GetCursor())
+	if err != nil {
+		return nil, s.mapListError("GetUpcomingMatches", filter, err)
 	}
-	clubID := strings.TrimSpace(req.GetClubId())
-	if clubID != "" {
-		parsed, err := strconv.ParseInt(clubID, 10, 64)
-		if err != nil || parsed <= 0 {
-			return nil, status.Error(codes.InvalidArgument, "club_id must be a positive integer")
+
+	protoMatches, err := s.toProtoMatches(matches)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeClubs() {
+		if err := s.attachClubs(ctx, protoMatches); err != nil {
+			return nil, err
 		}
-		clubID = strconv.FormatInt(parsed, 10)
 	}
 
-	matches, err := s.service.GetUpcomingMatches(ctx, limit, clubID)
+	return &matchv1.GetUpcomingMatchesResponse{Matches: protoMatches, NextCursor: nextCursor}, nil
+}
+
+func (s *serverAPI) ListMatches(ctx context.Context, req *matchv1.ListMatchesRequest) (*matchv1.ListMatchesResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+
+	filter, err := listFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, nextCursor, err := s.service.ListMatches(ctx, filter, req.GetCursor())
 	if err != nil {
-		s.log.Error("GetUpcomingMatches failed", zap.Int("limit", limit), zap.String("club_id", clubID), zap.Error(err))
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, s.mapListError("ListMatches", filter, err)
 	}
 
-	resp := &matchv1.GetUpcomingMatchesResponse{
-		Matches: make([]*matchv1.Match, 0, len(matches)),
+	protoMatches, err := s.toProtoMatches(matches)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeClubs() {
+		if err := s.attachClubs(ctx, protoMatches); err != nil {
+			return nil, err
+		}
+	}
+
+	return &matchv1.ListMatchesResponse{Matches: protoMatches, NextCursor: nextCursor}, nil
+}
+
+func (s *serverAPI) GetPastMatches(ctx context.Context, req *matchv1.GetPastMatchesRequest) (*matchv1.GetPastMatchesResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
 	}
+
+	filter, err := pastFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, nextCursor, err := s.service.GetPastMatches(ctx, filter, req.GetCursor())
+	if err != nil {
+		return nil, s.mapListError("GetPastMatches", filter, err)
+	}
+
+	protoMatches, err := s.toProtoMatches(matches)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetIncludeClubs() {
+		if err := s.attachClubs(ctx, protoMatches); err != nil {
+			return nil, err
+		}
+	}
+
+	return &matchv1.GetPastMatchesResponse{Matches: protoMatches, NextCursor: nextCursor}, nil
+}
+
+func (s *serverAPI) mapListError(method string, filter models.MatchFilter, err error) error {
+	if errors.Is(err, derr.ErrInvalidCursor) {
+		return invalidArgument("cursor", "cursor is invalid")
+	}
+	s.log.Error(
+		method+" failed",
+		zap.Int("limit", filter.Limit),
+		zap.Strings("club_ids", filter.ClubIDs),
+		zap.String("competition", filter.Competition),
+		zap.Error(err),
+	)
+	return internalError()
+}
+
+func (s *serverAPI) toProtoMatches(matches []models.Match) ([]*matchv1.Match, error) {
+	out := make([]*matchv1.Match, 0, len(matches))
 	for _, m := range matches {
 		matchID, err := strconv.ParseInt(string(m.ID), 10, 64)
 		if err != nil {
 			s.log.Error("failed to parse match id", zap.String("match_id", string(m.ID)), zap.Error(err))
-			return nil, status.Error(codes.Internal, "invalid match id in storage")
+			return nil, statusError(codes.Internal, commonv1.ErrorReason_ERROR_REASON_INTERNAL, "invalid match id in storage")
 		}
-		resp.Matches = append(resp.Matches, toProtoMatch(matchID, m))
+		out = append(out, toProtoMatch(matchID, m))
 	}
+	return out, nil
+}
 
-	return resp, nil
+type filterParams struct {
+	limit           int32
+	fromUTC         *timestamppb.Timestamp
+	toUTC           *timestamppb.Timestamp
+	clubIDs         []string
+	clubSide        matchv1.ClubSide
+	competition     string
+	destinationIATA string
+	city            string
+}
+
+func upcomingFilterFromRequest(req *matchv1.GetUpcomingMatchesRequest) (models.MatchFilter, error) {
+	return buildMatchFilter(filterParams{
+		limit:           req.GetLimit(),
+		fromUTC:         req.GetFromUtc(),
+		toUTC:           req.GetToUtc(),
+		clubIDs:         append([]string{req.GetClubId()}, req.GetClubIds()...),
+		clubSide:        req.GetClubSide(),
+		competition:     req.GetCompetition(),
+		destinationIATA: req.GetDestinationIata(),
+		city:            req.GetCity(),
+	})
+}
+
+func listFilterFromRequest(req *matchv1.ListMatchesRequest) (models.MatchFilter, error) {
+	filter, err := buildMatchFilter(filterParams{
+		limit:           req.GetLimit(),
+		fromUTC:         req.GetFromUtc(),
+		toUTC:           req.GetToUtc(),
+		clubIDs:         req.GetClubIds(),
+		clubSide:        req.GetClubSide(),
+		competition:     req.GetCompetition(),
+		destinationIATA: req.GetDestinationIata(),
+		city:            req.GetCity(),
+	})
+	if err != nil {
+		return models.MatchFilter{}, err
+	}
+
+	switch req.GetOrder() {
+	case matchv1.SortOrder_SORT_ORDER_UNSPECIFIED, matchv1.SortOrder_SORT_ORDER_ASC:
+		filter.Order = models.SortAsc
+	case matchv1.SortOrder_SORT_ORDER_DESC:
+		filter.Order = models.SortDesc
+	default:
+		return models.MatchFilter{}, invalidArgument("order", "order is invalid")
+	}
+
+	return filter, nil
+}
+
+func pastFilterFromRequest(req *matchv1.GetPastMatchesRequest) (models.MatchFilter, error) {
+	return buildMatchFilter(filterParams{
+		limit:       req.GetLimit(),
+		fromUTC:     req.GetFromUtc(),
+		toUTC:       req.GetToUtc(),
+		clubIDs:     req.GetClubIds(),
+		clubSide:    req.GetClubSide(),
+		competition: req.GetCompetition(),
+	})
+}
+
+func buildMatchFilter(p filterParams) (models.MatchFilter, error) {
+	filter := models.MatchFilter{
+		Limit:       int(p.limit),
+		Competition: strings.ToLower(strings.TrimSpace(p.competition)),
+		City:        strings.TrimSpace(p.city),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 10
+	}
+
+	seen := make(map[string]struct{}, len(p.clubIDs))
+	for _, raw := range p.clubIDs {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed <= 0 {
+			return models.MatchFilter{}, invalidArgument("club_ids", "club_id must be a positive integer")
+		}
+		id := strconv.FormatInt(parsed, 10)
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		filter.ClubIDs = append(filter.ClubIDs, id)
+	}
+
+	switch p.clubSide {
+	case matchv1.ClubSide_CLUB_SIDE_UNSPECIFIED:
+		filter.ClubSide = models.ClubSideAny
+	case matchv1.ClubSide_CLUB_SIDE_HOME:
+		filter.ClubSide = models.ClubSideHome
+	case matchv1.ClubSide_CLUB_SIDE_AWAY:
+		filter.ClubSide = models.ClubSideAway
+	default:
+		return models.MatchFilter{}, invalidArgument("club_side", "club_side is invalid")
+	}
+
+	if iata := strings.ToUpper(strings.TrimSpace(p.destinationIATA)); iata != "" {
+		if !isIATACode(iata) {
+			return models.MatchFilter{}, invalidArgument("destination_iata", "destination_iata must be 3 latin letters")
+		}
+		filter.DestinationIATA = iata
+	}
+
+	if p.fromUTC != nil {
+		if err := p.fromUTC.CheckValid(); err != nil {
+			return models.MatchFilter{}, invalidArgument("from_utc", "from_utc is invalid")
+		}
+		filter.FromUTC = p.fromUTC.AsTime()
+	}
+	if p.toUTC != nil {
+		if err := p.toUTC.CheckValid(); err != nil {
+			return models.MatchFilter{}, invalidArgument("to_utc", "to_utc is invalid")
+		}
+		filter.ToUTC = p.toUTC.AsTime()
+	}
+	if !filter.FromUTC.IsZero() && !filter.ToUTC.IsZero() && filter.ToUTC.Before(filter.FromUTC) {
+		return models.MatchFilter{}, invalidArgument("to_utc", "to_utc must not be before from_utc")
+	}
+
+	return filter, nil
+}
+
+func isIATACode(value string) bool {
+	if len(value) != 3 {
+		return false
+	}
+	for _, ch := range value {
+		if ch < 'A' || ch > 'Z' {
+			return false
+		}
+	}
+	return true
 }
 
 func (s *serverAPI) GetClubs(ctx context.Context, req *matchv1.GetClubsRequest) (*matchv1.GetClubsResponse, error) {
 	if req == nil {
-		return nil, status.Error(codes.InvalidArgument, "request is required")
+		return nil, invalidArgument("", "request is required")
 	}
 
 	clubs, err := s.service.GetClubs(ctx)
 	if err != nil {
 		s.log.Error("GetClubs failed", zap.Error(err))
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, internalError()
 	}
 
 	resp := &matchv1.GetClubsResponse{
 		Clubs: make([]*matchv1.Club, 0, len(clubs)),
 	}
 	for _, club := range clubs {
-		resp.Clubs = append(resp.Clubs, &matchv1.Club{
-			ClubId:      club.ID,
-			NameRu:      club.NameRU,
-			NameEn:      club.NameEN,
-			Logo:        club.Logo,
-			City:        club.City,
-			AirportIata: club.AirportIATA,
-		})
+		resp.Clubs = append(resp.Clubs, toProtoClub(club))
 	}
 
 	return resp, nil
 }
 
+func toProtoClub(club models.Club) *matchv1.Club {
+	return &matchv1.Club{
+		ClubId:      club.ID,
+		NameRu:      club.NameRU,
+		NameEn:      club.NameEN,
+		Logo:        club.Logo,
+		City:        club.City,
+		CityEn:      club.CityEN,
+		AirportIata: club.AirportIATA,
+	}
+}
+
+func (s *serverAPI) GetStadium(ctx context.Context, req *matchv1.GetStadiumRequest) (*matchv1.GetStadiumResponse, error) {
+	if req == nil {
+		return nil, invalidArgument("", "request is required")
+	}
+	stadiumID := strings.TrimSpace(req.GetStadiumId())
+	if stadiumID == "" {
+		return nil, invalidArgument("stadium_id", "stadium_id is required")
+	}
+
+	stadium, err := s.service.GetStadium(ctx, stadiumID)
+	if err != nil {
+		if errors.Is(err, derr.ErrStadiumNotFound) {
+			return nil, statusError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_STADIUM_NOT_FOUND, "stadium not found")
+		}
+		s.log.Error("GetStadium failed", zap.String("stadium_id", stadiumID), zap.Error(err))
+		return nil, internalError()
+	}
+
+	return &matchv1.GetStadiumResponse{Stadium: toProtoStadium(stadium)}, nil
+}
+
+func toProtoStadium(stadium models.Stadium) *matchv1.Stadium {
+	out := &matchv1.Stadium{
+		StadiumId: stadium.ID,
+		Name:      stadium.Name,
+		NameEn:    stadium.NameEN,
+		City:      stadium.City,
+		CityEn:    stadium.CityEN,
+		Latitude:  stadium.Latitude,
+		Longitude: stadium.Longitude,
+		Timezone:  stadium.Timezone,
+		Airports:  make([]*matchv1.StadiumAirport, 0, len(stadium.Airports)),
+	}
+	for _, airport := range stadium.Airports {
+		out.Airports = append(out.Airports, &matchv1.StadiumAirport{
+			Iata:            airport.IATA,
+			TransferMinutes: int32(airport.TransferTime / time.Minute),
+		})
+	}
+	return out
+}
+
 func toProtoMatch(matchID int64, m models.Match) *matchv1.Match {
-	return &matchv1.Match{
+	out := &matchv1.Match{
 		MatchId:                matchID,
 		KickoffUtc:             timestamppb.New(m.KickoffUTC),
 		City:                   m.City,
+		CityEn:                 m.CityEN,
 		Stadium:                m.Stadium,
 		DestinationAirportIata: m.DestinationIATA,
 		ClubHomeId:             m.HomeTeam,
 		ClubAwayId:             m.AwayTeam,
 		TicketsLink:            m.TicketsLink,
+		Competition:            m.Competition,
+		KickoffRevision:        int32(m.KickoffRevision),
+		HomeScore:              toProtoScore(m.HomeScore),
+		AwayScore:              toProtoScore(m.AwayScore),
+		KickoffTentative:       m.KickoffTentative,
+	}
+	if m.Venue != nil {
+		out.Venue = toProtoStadium(*m.Venue)
+	}
+	return out
+}
+
+func toProtoScore(score *int) *int32 {
+	if score == nil {
+		return nil
 	}
+	v := int32(*score)
+	return &v
 }
 
 func mapGetMatchError(err error) error {
 	switch {
 	case errors.Is(err, derr.ErrMatchNotFound):
-		return status.Error(codes.NotFound, "match not found")
+		return statusError(codes.NotFound, commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND, "match not found")
 	case errors.Is(err, derr.ErrSourceUnavailable):
-		return status.Error(codes.Unavailable, "match source unavailable")
+		return statusError(codes.Unavailable, commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE, "match source unavailable")
+	case errors.Is(err, derr.ErrSourceFallbackLimited):
+		return statusError(codes.ResourceExhausted, commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED, "too many lookups of unknown matches")
 	case errors.Is(err, context.DeadlineExceeded):
-		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		return statusError(codes.DeadlineExceeded, commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT, "deadline exceeded")
 	case errors.Is(err, context.Canceled):
-		return status.Error(codes.Canceled, "request canceled")
+		return statusError(codes.Canceled, commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED, "request canceled")
 	default:
-		return status.Error(codes.Internal, "internal error")
+		return internalError()
 	}
 }
@@ -3,24 +3,35 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	commonv1 "github.com/ozzus/fan-avia/protos/gen/go/common/v1"
+	matchv1 "github.com/ozzus/fan-avia/protos/gen/go/match/v1"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestMapGetMatchError(t *testing.T) {
 	tests := []struct {
-		name string
-		err  error
-		code codes.Code
+		name   string
+		err    error
+		code   codes.Code
+		reason commonv1.ErrorReason
 	}{
-		{name: "not_found", err: derr.ErrMatchNotFound, code: codes.NotFound},
-		{name: "unavailable", err: derr.ErrSourceUnavailable, code: codes.Unavailable},
-		{name: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
-		{name: "canceled", err: context.Canceled, code: codes.Canceled},
-		{name: "internal", err: errors.New("boom"), code: codes.Internal},
+		{name: "not_found", err: derr.ErrMatchNotFound, code: codes.NotFound, reason: commonv1.ErrorReason_ERROR_REASON_MATCH_NOT_FOUND},
+		{name: "unavailable", err: derr.ErrSourceUnavailable, code: codes.Unavailable, reason: commonv1.ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE},
+		{name: "limited", err: derr.ErrSourceFallbackLimited, code: codes.ResourceExhausted, reason: commonv1.ErrorReason_ERROR_REASON_RATE_LIMITED},
+		{name: "deadline", err: context.DeadlineExceeded, code: codes.DeadlineExceeded, reason: commonv1.ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT},
+		{name: "canceled", err: context.Canceled, code: codes.Canceled, reason: commonv1.ErrorReason_ERROR_REASON_REQUEST_CANCELED},
+		{name: "internal", err: errors.New("boom"), code: codes.Internal, reason: commonv1.ErrorReason_ERROR_REASON_INTERNAL},
 	}
 
 	for _, tt := range tests {
@@ -29,6 +40,200 @@ func TestMapGetMatchError(t *testing.T) {
 			if status.Code(got) != tt.code {
 				t.Fatalf("expected code %s, got %s", tt.code, status.Code(got))
 			}
+			if reason := errorReason(got); reason != tt.reason.String() {
+				t.Fatalf("expected reason %s, got %q", tt.reason, reason)
+			}
 		})
 	}
 }
+
+func TestUpcomingFilterFromRequest(t *testing.T) {
+	from := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	to := from.Add(72 * time.Hour)
+
+	filter, err := upcomingFilterFromRequest(&matchv1.GetUpcomingMatchesRequest{
+		Limit:           20,
+		ClubId:          "04",
+		ClubIds:         []string{"4", "3"},
+		ClubSide:        matchv1.ClubSide_CLUB_SIDE_HOME,
+		Competition:     " RPL ",
+		DestinationIata: "kzn",
+		City:            " Kazan ",
+		FromUtc:         timestamppb.New(from),
+		ToUtc:           timestamppb.New(to),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filter.ClubIDs) != 2 || filter.ClubIDs[0] != "4" || filter.ClubIDs[1] != "3" {
+		t.Fatalf("unexpected club ids: %v", filter.ClubIDs)
+	}
+	if filter.ClubSide != models.ClubSideHome || filter.Competition != "rpl" || filter.DestinationIATA != "KZN" || filter.City != "Kazan" {
+		t.Fatalf("unexpected filter: %+v", filter)
+	}
+	if !filter.FromUTC.Equal(from) || !filter.ToUTC.Equal(to) {
+		t.Fatalf("unexpected range: %s - %s", filter.FromUTC, filter.ToUTC)
+	}
+}
+
+func TestUpcomingFilterFromRequest_InvalidArgument(t *testing.T) {
+	from := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		req  *matchv1.GetUpcomingMatchesRequest
+	}{
+		{name: "bad_club_id", req: &matchv1.GetUpcomingMatchesRequest{ClubIds: []string{"abc"}}},
+		{name: "bad_iata", req: &matchv1.GetUpcomingMatchesRequest{DestinationIata: "KAZAN"}},
+		{name: "bad_side", req: &matchv1.GetUpcomingMatchesRequest{ClubSide: matchv1.ClubSide(42)}},
+		{name: "reversed_range", req: &matchv1.GetUpcomingMatchesRequest{
+			FromUtc: timestamppb.New(from),
+			ToUtc:   timestamppb.New(from.Add(-time.Hour)),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := upcomingFilterFromRequest(tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPastFilterFromRequest(t *testing.T) {
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	filter, err := pastFilterFromRequest(&matchv1.GetPastMatchesRequest{
+		ClubIds: []string{"3"},
+		FromUtc: timestamppb.New(from),
+		ToUtc:   timestamppb.New(to),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !filter.FromUTC.Equal(from) || !filter.ToUTC.Equal(to) {
+		t.Fatalf("unexpected range: %s - %s", filter.FromUTC, filter.ToUTC)
+	}
+
+	_, err = pastFilterFromRequest(&matchv1.GetPastMatchesRequest{FromUtc: timestamppb.New(to), ToUtc: timestamppb.New(from)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a reversed range, got %v", err)
+	}
+}
+
+func TestToProtoMatch_Venue(t *testing.T) {
+	m := models.Match{
+		ID:        "16114",
+		StadiumID: "rostec-arena",
+		Venue: &models.Stadium{
+			ID:       "rostec-arena",
+			Timezone: "Europe/Kaliningrad",
+			Airports: []models.StadiumAirport{{IATA: "KGD", TransferTime: 35 * time.Minute}},
+		},
+	}
+
+	got := toProtoMatch(16114, m).GetVenue()
+	if got.GetTimezone() != "Europe/Kaliningrad" {
+		t.Fatalf("unexpected venue timezone: %q", got.GetTimezone())
+	}
+	if len(got.GetAirports()) != 1 || got.GetAirports()[0].GetTransferMinutes() != 35 {
+		t.Fatalf("unexpected venue airports: %+v", got.GetAirports())
+	}
+
+	if toProtoMatch(1, models.Match{ID: "1"}).GetVenue() != nil {
+		t.Fatal("expected no venue for unmapped stadium")
+	}
+}
+
+func TestCallerFromContext(t *testing.T) {
+	trusted, err := ParseTrustedPeers([]string{"10.0.0.0/24", " 192.0.2.10 ", ""})
+	if err != nil {
+		t.Fatalf("parse trusted peers: %v", err)
+	}
+
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 51234},
+	})
+	if got := callerFromContext(peerCtx, trusted); got != "10.0.0.5" {
+		t.Fatalf("expected peer ip, got %q", got)
+	}
+
+	mdCtx := metadata.NewIncomingContext(peerCtx, metadata.Pairs(ClientIPMetadataKey, " 203.0.113.7 "))
+	if got := callerFromContext(mdCtx, trusted); got != "203.0.113.7" {
+		t.Fatalf("expected forwarded client ip, got %q", got)
+	}
+
+	untrustedCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.9"), Port: 51234},
+	})
+	untrustedCtx = metadata.NewIncomingContext(untrustedCtx, metadata.Pairs(ClientIPMetadataKey, "203.0.113.7"))
+	if got := callerFromContext(untrustedCtx, trusted); got != "198.51.100.9" {
+		t.Fatalf("expected untrusted peer to be limited by its own ip, got %q", got)
+	}
+
+	if got := callerFromContext(context.Background(), trusted); got != "" {
+		t.Fatalf("expected empty caller, got %q", got)
+	}
+}
+
+func TestParseTrustedPeers(t *testing.T) {
+	peers, err := ParseTrustedPeers([]string{"::ffff:192.0.2.10", "10.1.2.3/8"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(peers) != 2 || peers[0].String() != "192.0.2.10/32" || peers[1].String() != "10.0.0.0/8" {
+		t.Fatalf("unexpected peers: %v", peers)
+	}
+
+	for _, bad := range []string{"gateway", "10.0.0.0/33"} {
+		if _, err := ParseTrustedPeers([]string{bad}); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestMatchIDsFromRequest(t *testing.T) {
+	ids, err := matchIDsFromRequest([]int64{16114, 7, 16114, 9})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(ids) != 3 || ids[0] != "16114" || ids[1] != "7" || ids[2] != "9" {
+		t.Fatalf("expected deduplicated ids in order, got %v", ids)
+	}
+
+	tooMany := make([]int64, maxGetMatchesIDs+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+	for name, raw := range map[string][]int64{
+		"empty":    nil,
+		"negative": {1, -2},
+		"too_many": tooMany,
+	} {
+		if _, err := matchIDsFromRequest(raw); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestToProtoMatchError(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason matchv1.MatchErrorReason
+	}{
+		{err: fmt.Errorf("wrap: %w", derr.ErrMatchNotFound), reason: matchv1.MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND},
+		{err: derr.ErrSourceUnavailable, reason: matchv1.MatchErrorReason_MATCH_ERROR_REASON_SOURCE_UNAVAILABLE},
+		{err: derr.ErrSourceFallbackLimited, reason: matchv1.MatchErrorReason_MATCH_ERROR_REASON_RATE_LIMITED},
+		{err: errors.New("boom"), reason: matchv1.MatchErrorReason_MATCH_ERROR_REASON_INTERNAL},
+	}
+
+	for _, tt := range tests {
+		got := toProtoMatchError(42, tt.err)
+		if got.GetMatchId() != 42 || got.GetReason() != tt.reason || got.GetMessage() == "" {
+			t.Fatalf("%v: unexpected match error %+v", tt.err, got)
+		}
+	}
+}
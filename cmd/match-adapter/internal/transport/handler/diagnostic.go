@@ -20,17 +20,31 @@ const defaultDiagnosticTimeout = 5 * time.Second
 
 type dbMatchReader interface {
 	GetByIDWithUpdatedAt(ctx context.Context, id models.MatchID) (models.Match, time.Time, error)
+	ListMatches(ctx context.Context, filter models.MatchFilter) ([]models.Match, error)
+	ListMatchOverrides(ctx context.Context, ids []models.MatchID) ([]models.MatchOverride, error)
 }
 
 type sourceMatchReader interface {
 	GetFullDataMatch(ctx context.Context, id int64) (dto.GetFullDataMatchResponse, error)
 }
 
+type unresolvedCityReader interface {
+	GetUnresolvedCities(ctx context.Context) ([]models.UnresolvedCity, error)
+}
+
+type cityResolver interface {
+	ResolveCity(ctx context.Context, name string) (models.City, error)
+}
+
 type DiagnosticHandler struct {
-	log     *zap.Logger
-	db      dbMatchReader
-	source  sourceMatchReader
-	timeout time.Duration
+	log       *zap.Logger
+	db        dbMatchReader
+	source    sourceMatchReader
+	upcoming  sourceMatchLister
+	refresher matchRefresher
+	cities    unresolvedCityReader
+	resolver  cityResolver
+	timeout   time.Duration
 }
 
 type debugMatch struct {
@@ -51,6 +65,35 @@ type debugComparison struct {
 	DatabaseOnly   []string `json:"database_only,omitempty"`
 	HasSourceMatch bool     `json:"has_source_match"`
 	HasDBMatch     bool     `json:"has_db_match"`
+	// OverriddenFields differ from the source because of a manual override.
+	// They are compared using the source value the database keeps next to the
+	// overridden one.
+	OverriddenFields []string `json:"overridden_fields,omitempty"`
+}
+
+type debugOverride struct {
+	Field        string `json:"field"`
+	Value        string `json:"value"`
+	Author       string `json:"author"`
+	Reason       string `json:"reason"`
+	ExpiresAtUTC string `json:"expires_at_utc,omitempty"`
+	UpdatedAtUTC string `json:"updated_at_utc"`
+	Active       bool   `json:"active"`
+}
+
+type debugCity struct {
+	CityID string `json:"city_id"`
+	Name   string `json:"name"`
+	IATA   string `json:"iata"`
+}
+
+type debugUnresolvedCity struct {
+	City         string     `json:"city"`
+	Occurrences  int        `json:"occurrences"`
+	LastMatchID  string     `json:"last_match_id,omitempty"`
+	FirstSeenUTC string     `json:"first_seen_utc"`
+	LastSeenUTC  string     `json:"last_seen_utc"`
+	ResolvesTo   *debugCity `json:"resolves_to,omitempty"`
 }
 
 type debugMatchResponse struct {
@@ -59,13 +102,19 @@ type debugMatchResponse struct {
 	SourceRaw    *dto.GetFullDataMatchResponse `json:"source_raw,omitempty"`
 	SourceMapped *debugMatch                   `json:"source_mapped,omitempty"`
 	SourceError  string                        `json:"source_error,omitempty"`
-	DBMapped     *debugMatch                   `json:"db_mapped,omitempty"`
-	DBUpdatedUTC string                        `json:"db_updated_utc,omitempty"`
-	DBError      string                        `json:"db_error,omitempty"`
-	Comparison   *debugComparison              `json:"comparison,omitempty"`
+	// SourceOverridden is the source match with active overrides applied, set
+	// only when an override changes it.
+	SourceOverridden *debugMatch      `json:"source_overridden,omitempty"`
+	Overrides        []debugOverride  `json:"overrides,omitempty"`
+	DBMapped         *debugMatch      `json:"db_mapped,omitempty"`
+	DBUpdatedUTC     string           `json:"db_updated_utc,omitempty"`
+	DBError          string           `json:"db_error,omitempty"`
+	Comparison       *debugComparison `json:"comparison,omitempty"`
 }
 
-func NewDiagnosticHandler(log *zap.Logger, db dbMatchReader, source sourceMatchReader, timeout time.Duration) http.Handler {
+// NewDiagnosticHandler builds the debug mux. upcoming lists and fetches matches
+// across all configured sources, refresher applies source values on repair.
+func NewDiagnosticHandler(log *zap.Logger, db dbMatchReader, source sourceMatchReader, upcoming sourceMatchLister, refresher matchRefresher, cities unresolvedCityReader, resolver cityResolver, timeout time.Duration) http.Handler {
 	if log == nil {
 		log = zap.NewNop()
 	}
@@ -74,15 +123,21 @@ func NewDiagnosticHandler(log *zap.Logger, db dbMatchReader, source sourceMatchR
 	}
 
 	h := &DiagnosticHandler{
-		log:     log,
-		db:      db,
-		source:  source,
-		timeout: timeout,
+		log:       log,
+		db:        db,
+		source:    source,
+		upcoming:  upcoming,
+		refresher: refresher,
+		cities:    cities,
+		resolver:  resolver,
+		timeout:   timeout,
 	}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/debug/healthz", h.healthz)
 	mux.HandleFunc("/debug/match", h.getMatchSnapshot)
+	mux.HandleFunc("/debug/unresolved-cities", h.getUnresolvedCities)
+	mux.HandleFunc("/debug/reconcile", h.reconcile)
 	return mux
 }
 
@@ -130,6 +185,7 @@ func (h *DiagnosticHandler) getMatchSnapshot(w http.ResponseWriter, r *http.Requ
 		if mapErr != nil {
 			resp.SourceError = mapErr.Error()
 		} else {
+			h.resolveCity(ctx, &mapped)
 			sourceMatch = &mapped
 			resp.SourceMapped = toDebugMatch(mapped)
 		}
@@ -149,15 +205,140 @@ func (h *DiagnosticHandler) getMatchSnapshot(w http.ResponseWriter, r *http.Requ
 		resp.DBUpdatedUTC = updatedAt.UTC().Format(time.RFC3339)
 	}
 
+	overrides, err := h.db.ListMatchOverrides(ctx, []models.MatchID{models.MatchID(idRaw)})
+	if err != nil {
+		h.log.Warn("failed to load match overrides", zap.String("match_id", idRaw), zap.Error(err))
+	}
+	now := time.Now().UTC()
+	for _, o := range overrides {
+		resp.Overrides = append(resp.Overrides, toDebugOverride(o, now))
+	}
+
+	var overridden []string
+	if sourceMatch != nil {
+		var applied models.Match
+		applied, overridden = h.applyOverrides(ctx, *sourceMatch, overrides, now)
+		if len(overridden) > 0 {
+			resp.SourceOverridden = toDebugMatch(applied)
+		}
+	}
+
 	resp.Comparison = buildComparison(sourceMatch, dbMatch)
+	resp.Comparison.OverriddenFields = overridden
 	writeDiagnosticJSON(w, http.StatusOK, resp)
 }
 
+// getUnresolvedCities lists source cities missing from the city dictionary.
+// resolves_to is set for cities that got an alias after they were recorded.
+func (h *DiagnosticHandler) getUnresolvedCities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeDiagnosticError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if h.cities == nil {
+		writeDiagnosticError(w, http.StatusNotImplemented, "city report is not configured")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	cities, err := h.cities.GetUnresolvedCities(ctx)
+	if err != nil {
+		h.log.Error("failed to load unresolved cities", zap.Error(err))
+		writeDiagnosticError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	out := make([]debugUnresolvedCity, 0, len(cities))
+	for _, city := range cities {
+		item := debugUnresolvedCity{
+			City:         city.Name,
+			Occurrences:  city.Occurrences,
+			LastMatchID:  string(city.LastMatchID),
+			FirstSeenUTC: city.FirstSeen.UTC().Format(time.RFC3339),
+			LastSeenUTC:  city.LastSeen.UTC().Format(time.RFC3339),
+		}
+		if h.resolver != nil {
+			if resolved, err := h.resolver.ResolveCity(ctx, city.Name); err == nil {
+				item.ResolvesTo = &debugCity{CityID: resolved.ID, Name: resolved.Name, IATA: resolved.IATA}
+			}
+		}
+		out = append(out, item)
+	}
+
+	writeDiagnosticJSON(w, http.StatusOK, map[string]any{"cities": out})
+}
+
+// resolveCity brings the source city to the canonical name stored in the database,
+// so that the comparison does not report every city as changed.
+func (h *DiagnosticHandler) resolveCity(ctx context.Context, match *models.Match) {
+	if h.resolver == nil || strings.TrimSpace(match.City) == "" {
+		return
+	}
+
+	city, err := h.resolver.ResolveCity(ctx, match.City)
+	if err != nil {
+		return
+	}
+	if city.Name != "" {
+		match.City = city.Name
+	}
+	match.DestinationIATA = city.IATA
+}
+
+// applyOverrides returns the source match as the sync would store it. The
+// overridden city is resolved like a source city, unless the destination is
+// overridden too.
+func (h *DiagnosticHandler) applyOverrides(ctx context.Context, match models.Match, overrides []models.MatchOverride, now time.Time) (models.Match, []string) {
+	changed := models.ApplyOverrides(&match, overrides, now)
+	if len(changed) == 0 {
+		return match, nil
+	}
+
+	fields := make([]string, 0, len(changed))
+	for _, field := range changed {
+		fields = append(fields, string(field))
+		if field != models.OverrideCity {
+			continue
+		}
+		h.resolveCity(ctx, &match)
+		for _, o := range overrides {
+			if o.Field == models.OverrideDestinationIATA {
+				models.ApplyOverrides(&match, []models.MatchOverride{o}, now)
+			}
+		}
+	}
+
+	return match, fields
+}
+
+func toDebugOverride(o models.MatchOverride, now time.Time) debugOverride {
+	out := debugOverride{
+		Field:        string(o.Field),
+		Value:        o.Value,
+		Author:       o.Author,
+		Reason:       o.Reason,
+		UpdatedAtUTC: o.UpdatedAt.UTC().Format(time.RFC3339),
+		Active:       o.Active(now),
+	}
+	if !o.ExpiresAt.IsZero() {
+		out.ExpiresAtUTC = o.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+// buildComparison compares the source with the source values stored for the
+// match, so active overrides don't show up as differences.
 func buildComparison(sourceMatch *models.Match, dbMatch *models.Match) *debugComparison {
 	cmp := &debugComparison{
 		HasSourceMatch: sourceMatch != nil,
 		HasDBMatch:     dbMatch != nil,
 	}
+	if dbMatch != nil {
+		stored := dbMatch.WithoutOverrides()
+		dbMatch = &stored
+	}
 
 	if sourceMatch == nil || dbMatch == nil {
 		cmp.Equal = false
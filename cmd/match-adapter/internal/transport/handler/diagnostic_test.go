@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
 	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/infrastructures/premierliga/dto"
 	"go.uber.org/zap"
@@ -15,6 +16,8 @@ import (
 
 type diagnosticRepoMock struct {
 	match     models.Match
+	matches   []models.Match
+	overrides []models.MatchOverride
 	updatedAt time.Time
 	err       error
 }
@@ -23,6 +26,14 @@ func (m *diagnosticRepoMock) GetByIDWithUpdatedAt(_ context.Context, _ models.Ma
 	return m.match, m.updatedAt, m.err
 }
 
+func (m *diagnosticRepoMock) ListMatches(_ context.Context, _ models.MatchFilter) ([]models.Match, error) {
+	return m.matches, m.err
+}
+
+func (m *diagnosticRepoMock) ListMatchOverrides(_ context.Context, _ []models.MatchID) ([]models.MatchOverride, error) {
+	return m.overrides, nil
+}
+
 type diagnosticSourceMock struct {
 	resp dto.GetFullDataMatchResponse
 	err  error
@@ -62,7 +73,7 @@ func TestDiagnosticHandler_GetMatchSnapshotSuccess(t *testing.T) {
 		},
 	}
 
-	h := NewDiagnosticHandler(zap.NewNop(), repo, source, 3*time.Second)
+	h := NewDiagnosticHandler(zap.NewNop(), repo, source, nil, nil, nil, nil, 3*time.Second)
 	req := httptest.NewRequest(http.MethodGet, "/debug/match?match_id=16114", nil)
 	rr := httptest.NewRecorder()
 
@@ -94,7 +105,7 @@ func TestDiagnosticHandler_GetMatchSnapshotSuccess(t *testing.T) {
 }
 
 func TestDiagnosticHandler_GetMatchSnapshotInvalidID(t *testing.T) {
-	h := NewDiagnosticHandler(zap.NewNop(), &diagnosticRepoMock{}, &diagnosticSourceMock{}, 2*time.Second)
+	h := NewDiagnosticHandler(zap.NewNop(), &diagnosticRepoMock{}, &diagnosticSourceMock{}, nil, nil, nil, nil, 2*time.Second)
 	req := httptest.NewRequest(http.MethodGet, "/debug/match?match_id=abc", nil)
 	rr := httptest.NewRecorder()
 
@@ -103,3 +114,56 @@ func TestDiagnosticHandler_GetMatchSnapshotInvalidID(t *testing.T) {
 		t.Fatalf("expected status 400, got %d", rr.Code)
 	}
 }
+
+type unresolvedCitiesMock struct {
+	cities []models.UnresolvedCity
+}
+
+func (m *unresolvedCitiesMock) GetUnresolvedCities(_ context.Context) ([]models.UnresolvedCity, error) {
+	return m.cities, nil
+}
+
+type cityResolverMock struct {
+	cities map[string]models.City
+}
+
+func (m *cityResolverMock) ResolveCity(_ context.Context, name string) (models.City, error) {
+	if city, ok := m.cities[name]; ok {
+		return city, nil
+	}
+	return models.City{}, derr.ErrCityIATANotFound
+}
+
+func TestDiagnosticHandler_GetUnresolvedCities(t *testing.T) {
+	seen := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	cities := &unresolvedCitiesMock{cities: []models.UnresolvedCity{
+		{Name: "Новогорск", Occurrences: 3, LastMatchID: "18000", FirstSeen: seen, LastSeen: seen},
+		{Name: "Химки", Occurrences: 1, FirstSeen: seen, LastSeen: seen},
+	}}
+	resolver := &cityResolverMock{cities: map[string]models.City{
+		"Химки": {ID: "khimki", Name: "Химки", IATA: "MOW"},
+	}}
+
+	h := NewDiagnosticHandler(zap.NewNop(), &diagnosticRepoMock{}, &diagnosticSourceMock{}, nil, nil, cities, resolver, time.Second)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/unresolved-cities", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rr.Code)
+	}
+
+	var body struct {
+		Cities []debugUnresolvedCity `json:"cities"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(body.Cities) != 2 {
+		t.Fatalf("expected 2 cities, got %d", len(body.Cities))
+	}
+	if body.Cities[0].ResolvesTo != nil || body.Cities[0].Occurrences != 3 || body.Cities[0].LastMatchID != "18000" {
+		t.Fatalf("unexpected first city: %+v", body.Cities[0])
+	}
+	if body.Cities[1].ResolvesTo == nil || body.Cities[1].ResolvesTo.IATA != "MOW" {
+		t.Fatalf("expected second city to resolve now, got %+v", body.Cities[1])
+	}
+}
@@ -0,0 +1,412 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReconcileRange = 30 * 24 * time.Hour
+	maxReconcileRange     = 180 * 24 * time.Hour
+	reconcilePageSize     = 500
+)
+
+const (
+	reconcileStatusChanged         = "changed"
+	reconcileStatusSourceOnly      = "source_only"
+	reconcileStatusMoved           = "moved"
+	reconcileStatusNotListed       = "not_listed"
+	reconcileStatusMissingAtSource = "missing_at_source"
+	reconcileStatusSourceError     = "source_error"
+)
+
+type sourceMatchLister interface {
+	FetchUpcomingIDs(ctx context.Context, from time.Time, to time.Time, limit int) ([]models.MatchID, error)
+	FetchByID(ctx context.Context, id models.MatchID) (models.Match, error)
+}
+
+type matchRefresher interface {
+	RefreshMatch(ctx context.Context, id models.MatchID) (models.Match, error)
+}
+
+type debugReconcileItem struct {
+	MatchID    string      `json:"match_id"`
+	Status     string      `json:"status"`
+	DiffFields []string    `json:"diff_fields,omitempty"`
+	Source     *debugMatch `json:"source,omitempty"`
+	// SourceOverridden and OverriddenFields are set for matches with active
+	// overrides; the diff is computed against the stored source values.
+	SourceOverridden *debugMatch `json:"source_overridden,omitempty"`
+	OverriddenFields []string    `json:"overridden_fields,omitempty"`
+	DB               *debugMatch `json:"db,omitempty"`
+	Error            string      `json:"error,omitempty"`
+	Repaired         bool        `json:"repaired,omitempty"`
+	RepairError      string      `json:"repair_error,omitempty"`
+}
+
+type debugReconcileSummary struct {
+	DBMatches     int `json:"db_matches"`
+	SourceMatches int `json:"source_matches"`
+	Equal         int `json:"equal"`
+	Changed       int `json:"changed"`
+	SourceOnly    int `json:"source_only"`
+	DBOnly        int `json:"db_only"`
+	Repaired      int `json:"repaired"`
+}
+
+type debugReconcileResponse struct {
+	FromUTC      string                `json:"from_utc"`
+	ToUTC        string                `json:"to_utc"`
+	CheckedAtUTC string                `json:"checked_at_utc"`
+	Repair       bool                  `json:"repair"`
+	Summary      debugReconcileSummary `json:"summary"`
+	Changed      []debugReconcileItem  `json:"changed"`
+	SourceOnly   []debugReconcileItem  `json:"source_only"`
+	DBOnly       []debugReconcileItem  `json:"db_only"`
+}
+
+// reconcile compares every stored match in [from, to] with the source. Matches
+// stored but no longer listed by the source are fetched by id to tell a
+// reschedule out of the range from a match the source dropped. GET is a
+// read-only report, repair=true writes and is only accepted on POST.
+func (h *DiagnosticHandler) reconcile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeDiagnosticError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if h.upcoming == nil {
+		writeDiagnosticError(w, http.StatusNotImplemented, "reconciliation is not configured")
+		return
+	}
+
+	query := r.URL.Query()
+	from, to, err := parseReconcileRange(query.Get("from"), query.Get("to"), time.Now().UTC())
+	if err != nil {
+		writeDiagnosticError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	repair := false
+	if raw := strings.TrimSpace(query.Get("repair")); raw != "" {
+		repair, err = strconv.ParseBool(raw)
+		if err != nil {
+			writeDiagnosticError(w, http.StatusBadRequest, "repair must be a boolean")
+			return
+		}
+	}
+	if repair && r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeDiagnosticError(w, http.StatusMethodNotAllowed, "repair requires POST")
+		return
+	}
+	if repair && h.refresher == nil {
+		writeDiagnosticError(w, http.StatusNotImplemented, "repair is not configured")
+		return
+	}
+
+	logger := h.log.With(
+		zap.Time("from_utc", from),
+		zap.Time("to_utc", to),
+		zap.Bool("repair", repair),
+	)
+
+	stored, err := h.listStoredMatches(r.Context(), from, to)
+	if err != nil {
+		logger.Error("reconcile: failed to list stored matches", zap.Error(err))
+		writeDiagnosticError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	listCtx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	sourceIDs, err := h.upcoming.FetchUpcomingIDs(listCtx, from, to, reconcilePageSize)
+	cancel()
+	if err != nil {
+		logger.Error("reconcile: failed to list source matches", zap.Error(err))
+		writeDiagnosticError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	resp := debugReconcileResponse{
+		FromUTC:      from.Format(time.RFC3339),
+		ToUTC:        to.Format(time.RFC3339),
+		CheckedAtUTC: time.Now().UTC().Format(time.RFC3339),
+		Repair:       repair,
+		Changed:      []debugReconcileItem{},
+		SourceOnly:   []debugReconcileItem{},
+		DBOnly:       []debugReconcileItem{},
+	}
+	resp.Summary.DBMatches = len(stored)
+	resp.Summary.SourceMatches = len(sourceIDs)
+
+	listed := make(map[models.MatchID]struct{}, len(sourceIDs))
+	for _, id := range sourceIDs {
+		listed[id] = struct{}{}
+	}
+
+	overrides := h.loadOverrides(r.Context(), logger, stored, sourceIDs)
+	now := time.Now().UTC()
+
+	for _, id := range sourceIDs {
+		dbMatch, ok := stored[id]
+		sourceMatch, err := h.fetchSourceMatch(r.Context(), id)
+
+		switch {
+		case err != nil:
+			item := debugReconcileItem{MatchID: string(id), Status: reconcileStatusSourceError, Error: err.Error()}
+			if ok {
+				item.DB = toDebugMatch(dbMatch)
+				resp.Changed = append(resp.Changed, item)
+			} else {
+				resp.SourceOnly = append(resp.SourceOnly, item)
+			}
+		case !ok:
+			item := debugReconcileItem{MatchID: string(id), Status: reconcileStatusSourceOnly, Source: toDebugMatch(sourceMatch)}
+			resp.SourceOnly = append(resp.SourceOnly, h.repairItem(r.Context(), logger, item, repair))
+		default:
+			item := debugReconcileItem{
+				MatchID: string(id),
+				Status:  reconcileStatusChanged,
+				Source:  toDebugMatch(sourceMatch),
+				DB:      toDebugMatch(dbMatch),
+			}
+			h.overrideItem(r.Context(), &item, sourceMatch, overrides[id], now)
+			cmp := buildComparison(&sourceMatch, &dbMatch)
+			if cmp.Equal {
+				resp.Summary.Equal++
+				continue
+			}
+			item.DiffFields = cmp.DiffFields
+			resp.Changed = append(resp.Changed, h.repairItem(r.Context(), logger, item, repair))
+		}
+	}
+
+	for _, id := range sortedMatchIDs(stored) {
+		if _, ok := listed[id]; ok {
+			continue
+		}
+
+		dbMatch := stored[id]
+		item := debugReconcileItem{MatchID: string(id), DB: toDebugMatch(dbMatch)}
+
+		sourceMatch, err := h.fetchSourceMatch(r.Context(), id)
+		switch {
+		case errors.Is(err, derr.ErrMatchNotFound):
+			item.Status = reconcileStatusMissingAtSource
+		case err != nil:
+			item.Status = reconcileStatusSourceError
+			item.Error = err.Error()
+		default:
+			item.Source = toDebugMatch(sourceMatch)
+			h.overrideItem(r.Context(), &item, sourceMatch, overrides[id], now)
+			cmp := buildComparison(&sourceMatch, &dbMatch)
+			item.DiffFields = cmp.DiffFields
+			item.Status = reconcileStatusNotListed
+			if sourceMatch.KickoffUTC.Before(from) || sourceMatch.KickoffUTC.After(to) {
+				item.Status = reconcileStatusMoved
+			}
+			if !cmp.Equal {
+				item = h.repairItem(r.Context(), logger, item, repair)
+			}
+		}
+		resp.DBOnly = append(resp.DBOnly, item)
+	}
+
+	resp.Summary.Changed = len(resp.Changed)
+	resp.Summary.SourceOnly = len(resp.SourceOnly)
+	resp.Summary.DBOnly = len(resp.DBOnly)
+	for _, items := range [][]debugReconcileItem{resp.Changed, resp.SourceOnly, resp.DBOnly} {
+		for _, item := range items {
+			if item.Repaired {
+				resp.Summary.Repaired++
+			}
+		}
+	}
+
+	logger.Info(
+		"reconciliation finished",
+		zap.Int("db_matches", resp.Summary.DBMatches),
+		zap.Int("source_matches", resp.Summary.SourceMatches),
+		zap.Int("changed", resp.Summary.Changed),
+		zap.Int("source_only", resp.Summary.SourceOnly),
+		zap.Int("db_only", resp.Summary.DBOnly),
+		zap.Int("repaired", resp.Summary.Repaired),
+	)
+
+	writeDiagnosticJSON(w, http.StatusOK, resp)
+}
+
+func (h *DiagnosticHandler) listStoredMatches(ctx context.Context, from, to time.Time) (map[models.MatchID]models.Match, error) {
+	stored := make(map[models.MatchID]models.Match)
+	filter := models.MatchFilter{FromUTC: from, ToUTC: to, Limit: reconcilePageSize}
+
+	for {
+		pageCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		page, err := h.db.ListMatches(pageCtx, filter)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range page {
+			stored[m.ID] = m
+		}
+		if len(page) < filter.Limit {
+			return stored, nil
+		}
+
+		last := page[len(page)-1]
+		filter.After = &models.MatchCursor{KickoffUTC: last.KickoffUTC, ID: last.ID}
+	}
+}
+
+// loadOverrides fetches overrides of every compared match at once. Without them
+// overridden fields show up as changes, which is reported but not fatal.
+func (h *DiagnosticHandler) loadOverrides(ctx context.Context, logger *zap.Logger, stored map[models.MatchID]models.Match, sourceIDs []models.MatchID) map[models.MatchID][]models.MatchOverride {
+	ids := make([]models.MatchID, 0, len(stored)+len(sourceIDs))
+	for id := range stored {
+		ids = append(ids, id)
+	}
+	for _, id := range sourceIDs {
+		if _, ok := stored[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	overrides, err := h.db.ListMatchOverrides(ctx, ids)
+	if err != nil {
+		logger.Warn("reconcile: failed to load match overrides", zap.Error(err))
+		return nil
+	}
+
+	byMatch := make(map[models.MatchID][]models.MatchOverride)
+	for _, o := range overrides {
+		byMatch[o.MatchID] = append(byMatch[o.MatchID], o)
+	}
+	return byMatch
+}
+
+// overrideItem shows the source match with the overrides applied. The diff
+// stays against the stored source values.
+func (h *DiagnosticHandler) overrideItem(ctx context.Context, item *debugReconcileItem, sourceMatch models.Match, overrides []models.MatchOverride, now time.Time) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	applied, fields := h.applyOverrides(ctx, sourceMatch, overrides, now)
+	if len(fields) == 0 {
+		return
+	}
+	item.SourceOverridden = toDebugMatch(applied)
+	item.OverriddenFields = fields
+}
+
+func (h *DiagnosticHandler) fetchSourceMatch(ctx context.Context, id models.MatchID) (models.Match, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	match, err := h.upcoming.FetchByID(ctx, id)
+	if err != nil {
+		return models.Match{}, err
+	}
+	h.resolveCity(ctx, &match)
+
+	return match, nil
+}
+
+// repairItem stores the source version through the regular sync path, so the
+// kickoff revision, caches and dictionaries are handled the same way.
+func (h *DiagnosticHandler) repairItem(ctx context.Context, logger *zap.Logger, item debugReconcileItem, repair bool) debugReconcileItem {
+	if !repair {
+		return item
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if _, err := h.refresher.RefreshMatch(ctx, models.MatchID(item.MatchID)); err != nil {
+		logger.Warn("reconcile: failed to repair match", zap.String("match_id", item.MatchID), zap.Error(err))
+		item.RepairError = err.Error()
+		return item
+	}
+
+	item.Repaired = true
+	return item
+}
+
+// parseReconcileRange accepts RFC3339 timestamps or plain dates; a plain "to"
+// date includes the whole day.
+func parseReconcileRange(fromRaw, toRaw string, now time.Time) (time.Time, time.Time, error) {
+	from := now
+	if fromRaw = strings.TrimSpace(fromRaw); fromRaw != "" {
+		parsed, _, err := parseReconcileTime(fromRaw)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("from must be RFC3339 or YYYY-MM-DD")
+		}
+		from = parsed
+	}
+
+	to := from.Add(defaultReconcileRange)
+	if toRaw = strings.TrimSpace(toRaw); toRaw != "" {
+		parsed, dateOnly, err := parseReconcileTime(toRaw)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("to must be RFC3339 or YYYY-MM-DD")
+		}
+		if dateOnly {
+			parsed = parsed.Add(24*time.Hour - time.Second)
+		}
+		to = parsed
+	}
+
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, errors.New("to must be after from")
+	}
+	if to.Sub(from) > maxReconcileRange {
+		return time.Time{}, time.Time{}, errors.New("range must not exceed 180 days")
+	}
+
+	return from.UTC(), to.UTC(), nil
+}
+
+func parseReconcileTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t, true, nil
+}
+
+func sortedMatchIDs(matches map[models.MatchID]models.Match) []models.MatchID {
+	ids := make([]models.MatchID, 0, len(matches))
+	for id := range matches {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := matches[ids[i]], matches[ids[j]]
+		if !a.KickoffUTC.Equal(b.KickoffUTC) {
+			return a.KickoffUTC.Before(b.KickoffUTC)
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
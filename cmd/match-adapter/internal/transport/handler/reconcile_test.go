@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	derr "github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/errors"
+	"github.com/ozzus/fan-avia/cmd/match-adapter/internal/domain/models"
+	"go.uber.org/zap"
+)
+
+type reconcileSourceMock struct {
+	ids     []models.MatchID
+	matches map[models.MatchID]models.Match
+}
+
+func (m *reconcileSourceMock) FetchUpcomingIDs(_ context.Context, _ time.Time, _ time.Time, _ int) ([]models.MatchID, error) {
+	return m.ids, nil
+}
+
+func (m *reconcileSourceMock) FetchByID(_ context.Context, id models.MatchID) (models.Match, error) {
+	if match, ok := m.matches[id]; ok {
+		return match, nil
+	}
+	return models.Match{}, derr.ErrMatchNotFound
+}
+
+type refresherMock struct {
+	refreshed []models.MatchID
+}
+
+func (m *refresherMock) RefreshMatch(_ context.Context, id models.MatchID) (models.Match, error) {
+	m.refreshed = append(m.refreshed, id)
+	return models.Match{ID: id}, nil
+}
+
+func TestDiagnosticHandler_Reconcile(t *testing.T) {
+	kickoff := time.Date(2026, 3, 7, 16, 0, 0, 0, time.UTC)
+	repo := &diagnosticRepoMock{matches: []models.Match{
+		{ID: "1", HomeTeam: "3", KickoffUTC: kickoff},
+		{ID: "2", HomeTeam: "5", KickoffUTC: kickoff},
+		{ID: "3", HomeTeam: "7", KickoffUTC: kickoff.Add(24 * time.Hour)},
+		{ID: "4", HomeTeam: "9", KickoffUTC: kickoff.Add(48 * time.Hour)},
+	}}
+	source := &reconcileSourceMock{
+		ids: []models.MatchID{"1", "2", "5"},
+		matches: map[models.MatchID]models.Match{
+			"1": {ID: "1", HomeTeam: "3", KickoffUTC: kickoff},
+			"2": {ID: "2", HomeTeam: "5", KickoffUTC: kickoff.Add(2 * time.Hour)},
+			"3": {ID: "3", HomeTeam: "7", KickoffUTC: kickoff.Add(60 * 24 * time.Hour)},
+			"5": {ID: "5", HomeTeam: "11", KickoffUTC: kickoff},
+		},
+	}
+	refresher := &refresherMock{}
+	h := NewDiagnosticHandler(zap.NewNop(), repo, &diagnosticSourceMock{}, source, refresher, nil, nil, time.Second)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/debug/reconcile?from=2026-03-01&to=2026-03-31&repair=true", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var body debugReconcileResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if body.Summary.Equal != 1 || body.Summary.Changed != 1 || body.Summary.SourceOnly != 1 || body.Summary.DBOnly != 2 {
+		t.Fatalf("unexpected summary: %+v", body.Summary)
+	}
+	if body.Changed[0].MatchID != "2" || len(body.Changed[0].DiffFields) != 1 || body.Changed[0].DiffFields[0] != "kickoff_utc" {
+		t.Fatalf("unexpected changed item: %+v", body.Changed[0])
+	}
+	if body.SourceOnly[0].MatchID != "5" || body.SourceOnly[0].Status != reconcileStatusSourceOnly {
+		t.Fatalf("unexpected source-only item: %+v", body.SourceOnly[0])
+	}
+	if body.DBOnly[0].MatchID != "3" || body.DBOnly[0].Status != reconcileStatusMoved {
+		t.Fatalf("unexpected db-only item: %+v", body.DBOnly[0])
+	}
+	if body.DBOnly[1].MatchID != "4" || body.DBOnly[1].Status != reconcileStatusMissingAtSource || body.DBOnly[1].Repaired {
+		t.Fatalf("unexpected db-only item: %+v", body.DBOnly[1])
+	}
+
+	want := []models.MatchID{"2", "5", "3"}
+	if len(refresher.refreshed) != len(want) {
+		t.Fatalf("expected repaired %v, got %v", want, refresher.refreshed)
+	}
+	for i := range want {
+		if refresher.refreshed[i] != want[i] {
+			t.Fatalf("expected repaired %v, got %v", want, refresher.refreshed)
+		}
+	}
+	if body.Summary.Repaired != 3 {
+		t.Fatalf("expected 3 repaired matches, got %d", body.Summary.Repaired)
+	}
+}
+
+func TestDiagnosticHandler_ReconcileRepairRequiresPost(t *testing.T) {
+	source := &reconcileSourceMock{ids: []models.MatchID{"5"}, matches: map[models.MatchID]models.Match{"5": {ID: "5"}}}
+	refresher := &refresherMock{}
+	h := NewDiagnosticHandler(zap.NewNop(), &diagnosticRepoMock{}, &diagnosticSourceMock{}, source, refresher, nil, nil, time.Second)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/reconcile?repair=true", nil))
+	if rr.Code != http.StatusMethodNotAllowed || rr.Header().Get("Allow") != http.MethodPost {
+		t.Fatalf("expected status 405 with Allow: POST, got %d %q", rr.Code, rr.Header().Get("Allow"))
+	}
+	if len(refresher.refreshed) != 0 {
+		t.Fatalf("expected no repair on GET, got %v", refresher.refreshed)
+	}
+}
+
+func TestParseReconcileRange(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := parseReconcileRange("", "", now)
+	if err != nil || !from.Equal(now) || !to.Equal(now.Add(defaultReconcileRange)) {
+		t.Fatalf("unexpected default range: %v %v %v", from, to, err)
+	}
+
+	from, to, err = parseReconcileRange("2026-03-01", "2026-03-07", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !from.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 3, 7, 23, 59, 59, 0, time.UTC)) {
+		t.Fatalf("unexpected date range: %v %v", from, to)
+	}
+
+	for _, tc := range [][2]string{{"2026-03-07", "2026-03-01"}, {"yesterday", ""}, {"2026-01-01", "2026-12-31"}} {
+		if _, _, err := parseReconcileRange(tc[0], tc[1], now); err == nil {
+			t.Fatalf("expected error for %v", tc)
+		}
+	}
+}
+
+func TestDiagnosticHandler_ReconcileComparesSourceValues(t *testing.T) {
+	kickoff := time.Date(2026, 3, 7, 16, 0, 0, 0, time.UTC)
+	repo := &diagnosticRepoMock{
+		matches: []models.Match{
+			{ID: "1", HomeTeam: "3", KickoffUTC: kickoff, TicketsLink: "https://tickets.example", Source: &models.MatchSource{KickoffUTC: kickoff, TicketsLink: "https://stale.example"}},
+			{ID: "2", HomeTeam: "5", KickoffUTC: kickoff, TicketsLink: "https://tickets.example", Source: &models.MatchSource{KickoffUTC: kickoff, TicketsLink: "https://old.example"}},
+		},
+		overrides: []models.MatchOverride{
+			{MatchID: "1", Field: models.OverrideTicketsLink, Value: "https://tickets.example"},
+			{MatchID: "2", Field: models.OverrideTicketsLink, Value: "https://tickets.example"},
+		},
+	}
+	source := &reconcileSourceMock{
+		ids: []models.MatchID{"1", "2"},
+		matches: map[models.MatchID]models.Match{
+			"1": {ID: "1", HomeTeam: "3", KickoffUTC: kickoff, TicketsLink: "https://stale.example"},
+			"2": {ID: "2", HomeTeam: "5", KickoffUTC: kickoff, TicketsLink: "https://stale.example"},
+		},
+	}
+	h := NewDiagnosticHandler(zap.NewNop(), repo, &diagnosticSourceMock{}, source, nil, nil, nil, time.Second)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/reconcile?from=2026-03-01&to=2026-03-31", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+
+	var body debugReconcileResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if body.Summary.Equal != 1 || body.Summary.Changed != 1 {
+		t.Fatalf("expected the stored source value to match, got %+v", body.Summary)
+	}
+	// the source of match 2 changed since the last sync
+	item := body.Changed[0]
+	if item.MatchID != "2" || len(item.DiffFields) != 1 || item.DiffFields[0] != "tickets_link" {
+		t.Fatalf("expected a tickets_link diff for match 2, got %+v", item)
+	}
+	if item.SourceOverridden == nil || len(item.OverriddenFields) != 1 || item.OverriddenFields[0] != "tickets_link" {
+		t.Fatalf("expected the overridden view to be reported, got %+v", item)
+	}
+}
@@ -249,6 +249,7 @@ const (
 	FareWindowLevel_FARE_WINDOW_LEVEL_STRICT      FareWindowLevel = 1
 	FareWindowLevel_FARE_WINDOW_LEVEL_SOFT_1      FareWindowLevel = 2
 	FareWindowLevel_FARE_WINDOW_LEVEL_SOFT_2      FareWindowLevel = 3
+	FareWindowLevel_FARE_WINDOW_LEVEL_WHOLE_DAY   FareWindowLevel = 4 // kickoff time is not confirmed, the whole match day is searched
 )
 
 // Enum value maps for FareWindowLevel.
@@ -258,12 +259,14 @@ var (
 		1: "FARE_WINDOW_LEVEL_STRICT",
 		2: "FARE_WINDOW_LEVEL_SOFT_1",
 		3: "FARE_WINDOW_LEVEL_SOFT_2",
+		4: "FARE_WINDOW_LEVEL_WHOLE_DAY",
 	}
 	FareWindowLevel_value = map[string]int32{
 		"FARE_WINDOW_LEVEL_UNSPECIFIED": 0,
 		"FARE_WINDOW_LEVEL_STRICT":      1,
 		"FARE_WINDOW_LEVEL_SOFT_1":      2,
 		"FARE_WINDOW_LEVEL_SOFT_2":      3,
+		"FARE_WINDOW_LEVEL_WHOLE_DAY":   4,
 	}
 )
 
@@ -695,6 +698,8 @@ type GetAirfareByMatchResponse struct {
 	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	TicketsLink   string                 `protobuf:"bytes,2,opt,name=tickets_link,json=ticketsLink,proto3" json:"tickets_link,omitempty"`
 	Slots         []*FareSlot            `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	FetchedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"` // when the prices were loaded from the source
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // when the cached prices are loaded again; unset without a cache
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -750,6 +755,20 @@ func (x *GetAirfareByMatchResponse) GetSlots() []*FareSlot {
 	return nil
 }
 
+func (x *GetAirfareByMatchResponse) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+func (x *GetAirfareByMatchResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type FareSlot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slot          FareSlotType           `protobuf:"varint,1,opt,name=slot,proto3,enum=airfare.v1.FareSlotType" json:"slot,omitempty"`
@@ -757,6 +776,7 @@ type FareSlot struct {
 	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (UTC)
 	Prices        []int64                `protobuf:"varint,4,rep,packed,name=prices,proto3" json:"prices,omitempty"`
 	WindowLevel   FareWindowLevel        `protobuf:"varint,5,opt,name=window_level,json=windowLevel,proto3,enum=airfare.v1.FareWindowLevel" json:"window_level,omitempty"`
+	Label         string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"` // filled by api-gateway in the language of the request
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -826,6 +846,13 @@ func (x *FareSlot) GetWindowLevel() FareWindowLevel {
 	return FareWindowLevel_FARE_WINDOW_LEVEL_UNSPECIFIED
 }
 
+func (x *FareSlot) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 var File_airfare_v1_airfare_provider_proto protoreflect.FileDescriptor
 
 const file_airfare_v1_airfare_provider_proto_rawDesc = "" +
@@ -860,17 +887,22 @@ const file_airfare_v1_airfare_provider_proto_rawDesc = "" +
 	"\x18GetAirfareByMatchRequest\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12\x1f\n" +
 	"\vorigin_iata\x18\x02 \x01(\tR\n" +
-	"originIata\"\x85\x01\n" +
+	"originIata\"\xfb\x01\n" +
 	"\x19GetAirfareByMatchResponse\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12!\n" +
 	"\ftickets_link\x18\x02 \x01(\tR\vticketsLink\x12*\n" +
-	"\x05slots\x18\x03 \x03(\v2\x14.airfare.v1.FareSlotR\x05slots\"\xdd\x01\n" +
+	"\x05slots\x18\x03 \x03(\v2\x14.airfare.v1.FareSlotR\x05slots\x129\n" +
+	"\n" +
+	"fetched_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tfetchedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xf3\x01\n" +
 	"\bFareSlot\x12,\n" +
 	"\x04slot\x18\x01 \x01(\x0e2\x18.airfare.v1.FareSlotTypeR\x04slot\x127\n" +
 	"\tdirection\x18\x02 \x01(\x0e2\x19.airfare.v1.FareDirectionR\tdirection\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12\x16\n" +
 	"\x06prices\x18\x04 \x03(\x03R\x06prices\x12>\n" +
-	"\fwindow_level\x18\x05 \x01(\x0e2\x1b.airfare.v1.FareWindowLevelR\vwindowLevel*T\n" +
+	"\fwindow_level\x18\x05 \x01(\x0e2\x1b.airfare.v1.FareWindowLevelR\vwindowLevel\x12\x14\n" +
+	"\x05label\x18\x06 \x01(\tR\x05label*T\n" +
 	"\tDirection\x12\x19\n" +
 	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12DIRECTION_OUTBOUND\x10\x01\x12\x14\n" +
@@ -894,12 +926,13 @@ const file_airfare_v1_airfare_provider_proto_rawDesc = "" +
 	"\x1aFARE_SLOT_OUT_D0_ARRIVE_BY\x10\x03\x12!\n" +
 	"\x1dFARE_SLOT_RET_D0_DEPART_AFTER\x10\x04\x12\x1a\n" +
 	"\x16FARE_SLOT_RET_D_PLUS_1\x10\x05\x12\x1a\n" +
-	"\x16FARE_SLOT_RET_D_PLUS_2\x10\x06*\x8e\x01\n" +
+	"\x16FARE_SLOT_RET_D_PLUS_2\x10\x06*\xaf\x01\n" +
 	"\x0fFareWindowLevel\x12!\n" +
 	"\x1dFARE_WINDOW_LEVEL_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18FARE_WINDOW_LEVEL_STRICT\x10\x01\x12\x1c\n" +
 	"\x18FARE_WINDOW_LEVEL_SOFT_1\x10\x02\x12\x1c\n" +
-	"\x18FARE_WINDOW_LEVEL_SOFT_2\x10\x032\xdc\x01\n" +
+	"\x18FARE_WINDOW_LEVEL_SOFT_2\x10\x03\x12\x1f\n" +
+	"\x1bFARE_WINDOW_LEVEL_WHOLE_DAY\x10\x042\xdc\x01\n" +
 	"\x16AirfareProviderService\x12`\n" +
 	"\x11GetPricesForRules\x12$.airfare.v1.GetPricesForRulesRequest\x1a%.airfare.v1.GetPricesForRulesResponse\x12`\n" +
 	"\x11GetAirfareByMatch\x12$.airfare.v1.GetAirfareByMatchRequest\x1a%.airfare.v1.GetAirfareByMatchResponseB>Z<github.com/ozzus/fan-avia/protos/gen/go/airfare/v1;airfarev1b\x06proto3"
@@ -946,18 +979,20 @@ var file_airfare_v1_airfare_provider_proto_depIdxs = []int32{
 	9,  // 7: airfare.v1.GetPricesForRulesResponse.results:type_name -> airfare.v1.RuleResult
 	10, // 8: airfare.v1.RuleResult.options:type_name -> airfare.v1.PriceOption
 	13, // 9: airfare.v1.GetAirfareByMatchResponse.slots:type_name -> airfare.v1.FareSlot
-	3,  // 10: airfare.v1.FareSlot.slot:type_name -> airfare.v1.FareSlotType
-	2,  // 11: airfare.v1.FareSlot.direction:type_name -> airfare.v1.FareDirection
-	4,  // 12: airfare.v1.FareSlot.window_level:type_name -> airfare.v1.FareWindowLevel
-	5,  // 13: airfare.v1.AirfareProviderService.GetPricesForRules:input_type -> airfare.v1.GetPricesForRulesRequest
-	11, // 14: airfare.v1.AirfareProviderService.GetAirfareByMatch:input_type -> airfare.v1.GetAirfareByMatchRequest
-	8,  // 15: airfare.v1.AirfareProviderService.GetPricesForRules:output_type -> airfare.v1.GetPricesForRulesResponse
-	12, // 16: airfare.v1.AirfareProviderService.GetAirfareByMatch:output_type -> airfare.v1.GetAirfareByMatchResponse
-	15, // [15:17] is the sub-list for method output_type
-	13, // [13:15] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	14, // 10: airfare.v1.GetAirfareByMatchResponse.fetched_at:type_name -> google.protobuf.Timestamp
+	14, // 11: airfare.v1.GetAirfareByMatchResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 12: airfare.v1.FareSlot.slot:type_name -> airfare.v1.FareSlotType
+	2,  // 13: airfare.v1.FareSlot.direction:type_name -> airfare.v1.FareDirection
+	4,  // 14: airfare.v1.FareSlot.window_level:type_name -> airfare.v1.FareWindowLevel
+	5,  // 15: airfare.v1.AirfareProviderService.GetPricesForRules:input_type -> airfare.v1.GetPricesForRulesRequest
+	11, // 16: airfare.v1.AirfareProviderService.GetAirfareByMatch:input_type -> airfare.v1.GetAirfareByMatchRequest
+	8,  // 17: airfare.v1.AirfareProviderService.GetPricesForRules:output_type -> airfare.v1.GetPricesForRulesResponse
+	12, // 18: airfare.v1.AirfareProviderService.GetAirfareByMatch:output_type -> airfare.v1.GetAirfareByMatchResponse
+	17, // [17:19] is the sub-list for method output_type
+	15, // [15:17] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_airfare_v1_airfare_provider_proto_init() }
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: common/v1/errors.proto
+
+package commonv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorReason is the stable machine-readable code of an error. Backends put
+// its name into google.rpc.ErrorInfo.reason with domain "fan-avia"; invalid
+// arguments also carry google.rpc.BadRequest with the offending fields. The
+// gateway passes the name without the ERROR_REASON_ prefix to HTTP clients as
+// the problem+json code.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED                ErrorReason = 0
+	ErrorReason_ERROR_REASON_INVALID_ARGUMENT           ErrorReason = 1
+	ErrorReason_ERROR_REASON_NOT_FOUND                  ErrorReason = 2 // unknown HTTP route
+	ErrorReason_ERROR_REASON_METHOD_NOT_ALLOWED         ErrorReason = 3
+	ErrorReason_ERROR_REASON_MATCH_NOT_FOUND            ErrorReason = 4
+	ErrorReason_ERROR_REASON_CLUB_NOT_FOUND             ErrorReason = 5
+	ErrorReason_ERROR_REASON_STADIUM_NOT_FOUND          ErrorReason = 6
+	ErrorReason_ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND   ErrorReason = 7
+	ErrorReason_ERROR_REASON_MATCH_DESTINATION_UNKNOWN  ErrorReason = 8 // the match city has no airport yet
+	ErrorReason_ERROR_REASON_ORIGIN_EQUALS_DESTINATION  ErrorReason = 9
+	ErrorReason_ERROR_REASON_AIRFARE_NOT_FOUND          ErrorReason = 10 // no offers for the match
+	ErrorReason_ERROR_REASON_MATCH_SOURCE_UNAVAILABLE   ErrorReason = 11
+	ErrorReason_ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE ErrorReason = 12
+	ErrorReason_ERROR_REASON_RATE_LIMITED               ErrorReason = 13
+	ErrorReason_ERROR_REASON_UPSTREAM_UNAVAILABLE       ErrorReason = 14 // a backend service can't be reached
+	ErrorReason_ERROR_REASON_UPSTREAM_TIMEOUT           ErrorReason = 15
+	ErrorReason_ERROR_REASON_REQUEST_CANCELED           ErrorReason = 16
+	ErrorReason_ERROR_REASON_INTERNAL                   ErrorReason = 17
+	ErrorReason_ERROR_REASON_UNAUTHENTICATED            ErrorReason = 18 // unknown or revoked API key
+	ErrorReason_ERROR_REASON_PERMISSION_DENIED          ErrorReason = 19 // the API key lacks the scope of the route
+	ErrorReason_ERROR_REASON_QUOTA_EXCEEDED             ErrorReason = 20 // daily quota of the API key or client address
+	ErrorReason_ERROR_REASON_API_KEY_NOT_FOUND          ErrorReason = 21
+	ErrorReason_ERROR_REASON_UPSTREAM_CIRCUIT_OPEN      ErrorReason = 22 // the gateway stopped calling a failing backend for a while
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_INVALID_ARGUMENT",
+		2:  "ERROR_REASON_NOT_FOUND",
+		3:  "ERROR_REASON_METHOD_NOT_ALLOWED",
+		4:  "ERROR_REASON_MATCH_NOT_FOUND",
+		5:  "ERROR_REASON_CLUB_NOT_FOUND",
+		6:  "ERROR_REASON_STADIUM_NOT_FOUND",
+		7:  "ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND",
+		8:  "ERROR_REASON_MATCH_DESTINATION_UNKNOWN",
+		9:  "ERROR_REASON_ORIGIN_EQUALS_DESTINATION",
+		10: "ERROR_REASON_AIRFARE_NOT_FOUND",
+		11: "ERROR_REASON_MATCH_SOURCE_UNAVAILABLE",
+		12: "ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE",
+		13: "ERROR_REASON_RATE_LIMITED",
+		14: "ERROR_REASON_UPSTREAM_UNAVAILABLE",
+		15: "ERROR_REASON_UPSTREAM_TIMEOUT",
+		16: "ERROR_REASON_REQUEST_CANCELED",
+		17: "ERROR_REASON_INTERNAL",
+		18: "ERROR_REASON_UNAUTHENTICATED",
+		19: "ERROR_REASON_PERMISSION_DENIED",
+		20: "ERROR_REASON_QUOTA_EXCEEDED",
+		21: "ERROR_REASON_API_KEY_NOT_FOUND",
+		22: "ERROR_REASON_UPSTREAM_CIRCUIT_OPEN",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":                0,
+		"ERROR_REASON_INVALID_ARGUMENT":           1,
+		"ERROR_REASON_NOT_FOUND":                  2,
+		"ERROR_REASON_METHOD_NOT_ALLOWED":         3,
+		"ERROR_REASON_MATCH_NOT_FOUND":            4,
+		"ERROR_REASON_CLUB_NOT_FOUND":             5,
+		"ERROR_REASON_STADIUM_NOT_FOUND":          6,
+		"ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND":   7,
+		"ERROR_REASON_MATCH_DESTINATION_UNKNOWN":  8,
+		"ERROR_REASON_ORIGIN_EQUALS_DESTINATION":  9,
+		"ERROR_REASON_AIRFARE_NOT_FOUND":          10,
+		"ERROR_REASON_MATCH_SOURCE_UNAVAILABLE":   11,
+		"ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE": 12,
+		"ERROR_REASON_RATE_LIMITED":               13,
+		"ERROR_REASON_UPSTREAM_UNAVAILABLE":       14,
+		"ERROR_REASON_UPSTREAM_TIMEOUT":           15,
+		"ERROR_REASON_REQUEST_CANCELED":           16,
+		"ERROR_REASON_INTERNAL":                   17,
+		"ERROR_REASON_UNAUTHENTICATED":            18,
+		"ERROR_REASON_PERMISSION_DENIED":          19,
+		"ERROR_REASON_QUOTA_EXCEEDED":             20,
+		"ERROR_REASON_API_KEY_NOT_FOUND":          21,
+		"ERROR_REASON_UPSTREAM_CIRCUIT_OPEN":      22,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_common_v1_errors_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_common_v1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+var File_common_v1_errors_proto protoreflect.FileDescriptor
+
+const file_common_v1_errors_proto_rawDesc = "" +
+	"\n" +
+	"\x16common/v1/errors.proto\x12\tcommon.v1*\xcf\x06\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dERROR_REASON_INVALID_ARGUMENT\x10\x01\x12\x1a\n" +
+	"\x16ERROR_REASON_NOT_FOUND\x10\x02\x12#\n" +
+	"\x1fERROR_REASON_METHOD_NOT_ALLOWED\x10\x03\x12 \n" +
+	"\x1cERROR_REASON_MATCH_NOT_FOUND\x10\x04\x12\x1f\n" +
+	"\x1bERROR_REASON_CLUB_NOT_FOUND\x10\x05\x12\"\n" +
+	"\x1eERROR_REASON_STADIUM_NOT_FOUND\x10\x06\x12)\n" +
+	"%ERROR_REASON_MATCH_OVERRIDE_NOT_FOUND\x10\a\x12*\n" +
+	"&ERROR_REASON_MATCH_DESTINATION_UNKNOWN\x10\b\x12*\n" +
+	"&ERROR_REASON_ORIGIN_EQUALS_DESTINATION\x10\t\x12\"\n" +
+	"\x1eERROR_REASON_AIRFARE_NOT_FOUND\x10\n" +
+	"\x12)\n" +
+	"%ERROR_REASON_MATCH_SOURCE_UNAVAILABLE\x10\v\x12+\n" +
+	"'ERROR_REASON_AIRFARE_SOURCE_UNAVAILABLE\x10\f\x12\x1d\n" +
+	"\x19ERROR_REASON_RATE_LIMITED\x10\r\x12%\n" +
+	"!ERROR_REASON_UPSTREAM_UNAVAILABLE\x10\x0e\x12!\n" +
+	"\x1dERROR_REASON_UPSTREAM_TIMEOUT\x10\x0f\x12!\n" +
+	"\x1dERROR_REASON_REQUEST_CANCELED\x10\x10\x12\x19\n" +
+	"\x15ERROR_REASON_INTERNAL\x10\x11\x12 \n" +
+	"\x1cERROR_REASON_UNAUTHENTICATED\x10\x12\x12\"\n" +
+	"\x1eERROR_REASON_PERMISSION_DENIED\x10\x13\x12\x1f\n" +
+	"\x1bERROR_REASON_QUOTA_EXCEEDED\x10\x14\x12\"\n" +
+	"\x1eERROR_REASON_API_KEY_NOT_FOUND\x10\x15\x12&\n" +
+	"\"ERROR_REASON_UPSTREAM_CIRCUIT_OPEN\x10\x16B<Z:github.com/ozzus/fan-avia/protos/gen/go/common/v1;commonv1b\x06proto3"
+
+var (
+	file_common_v1_errors_proto_rawDescOnce sync.Once
+	file_common_v1_errors_proto_rawDescData []byte
+)
+
+func file_common_v1_errors_proto_rawDescGZIP() []byte {
+	file_common_v1_errors_proto_rawDescOnce.Do(func() {
+		file_common_v1_errors_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_v1_errors_proto_rawDesc), len(file_common_v1_errors_proto_rawDesc)))
+	})
+	return file_common_v1_errors_proto_rawDescData
+}
+
+var file_common_v1_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_common_v1_errors_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: common.v1.ErrorReason
+}
+var file_common_v1_errors_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_common_v1_errors_proto_init() }
+func file_common_v1_errors_proto_init() {
+	if File_common_v1_errors_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_errors_proto_rawDesc), len(file_common_v1_errors_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_v1_errors_proto_goTypes,
+		DependencyIndexes: file_common_v1_errors_proto_depIdxs,
+		EnumInfos:         file_common_v1_errors_proto_enumTypes,
+	}.Build()
+	File_common_v1_errors_proto = out.File
+	file_common_v1_errors_proto_goTypes = nil
+	file_common_v1_errors_proto_depIdxs = nil
+}
@@ -22,9 +22,221 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MatchErrorReason int32
+
+const (
+	MatchErrorReason_MATCH_ERROR_REASON_UNSPECIFIED        MatchErrorReason = 0
+	MatchErrorReason_MATCH_ERROR_REASON_NOT_FOUND          MatchErrorReason = 1
+	MatchErrorReason_MATCH_ERROR_REASON_SOURCE_UNAVAILABLE MatchErrorReason = 2
+	MatchErrorReason_MATCH_ERROR_REASON_RATE_LIMITED       MatchErrorReason = 3
+	MatchErrorReason_MATCH_ERROR_REASON_INTERNAL           MatchErrorReason = 4
+)
+
+// Enum value maps for MatchErrorReason.
+var (
+	MatchErrorReason_name = map[int32]string{
+		0: "MATCH_ERROR_REASON_UNSPECIFIED",
+		1: "MATCH_ERROR_REASON_NOT_FOUND",
+		2: "MATCH_ERROR_REASON_SOURCE_UNAVAILABLE",
+		3: "MATCH_ERROR_REASON_RATE_LIMITED",
+		4: "MATCH_ERROR_REASON_INTERNAL",
+	}
+	MatchErrorReason_value = map[string]int32{
+		"MATCH_ERROR_REASON_UNSPECIFIED":        0,
+		"MATCH_ERROR_REASON_NOT_FOUND":          1,
+		"MATCH_ERROR_REASON_SOURCE_UNAVAILABLE": 2,
+		"MATCH_ERROR_REASON_RATE_LIMITED":       3,
+		"MATCH_ERROR_REASON_INTERNAL":           4,
+	}
+)
+
+func (x MatchErrorReason) Enum() *MatchErrorReason {
+	p := new(MatchErrorReason)
+	*p = x
+	return p
+}
+
+func (x MatchErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_match_v1_match_adapter_proto_enumTypes[0].Descriptor()
+}
+
+func (MatchErrorReason) Type() protoreflect.EnumType {
+	return &file_match_v1_match_adapter_proto_enumTypes[0]
+}
+
+func (x MatchErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchErrorReason.Descriptor instead.
+func (MatchErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{0}
+}
+
+type ClubSide int32
+
+const (
+	ClubSide_CLUB_SIDE_UNSPECIFIED ClubSide = 0 // home or away
+	ClubSide_CLUB_SIDE_HOME        ClubSide = 1
+	ClubSide_CLUB_SIDE_AWAY        ClubSide = 2
+)
+
+// Enum value maps for ClubSide.
+var (
+	ClubSide_name = map[int32]string{
+		0: "CLUB_SIDE_UNSPECIFIED",
+		1: "CLUB_SIDE_HOME",
+		2: "CLUB_SIDE_AWAY",
+	}
+	ClubSide_value = map[string]int32{
+		"CLUB_SIDE_UNSPECIFIED": 0,
+		"CLUB_SIDE_HOME":        1,
+		"CLUB_SIDE_AWAY":        2,
+	}
+)
+
+func (x ClubSide) Enum() *ClubSide {
+	p := new(ClubSide)
+	*p = x
+	return p
+}
+
+func (x ClubSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_match_v1_match_adapter_proto_enumTypes[1].Descriptor()
+}
+
+func (ClubSide) Type() protoreflect.EnumType {
+	return &file_match_v1_match_adapter_proto_enumTypes[1]
+}
+
+func (x ClubSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubSide.Descriptor instead.
+func (ClubSide) EnumDescriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{1}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0 // ascending by kickoff
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_match_v1_match_adapter_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_match_v1_match_adapter_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{2}
+}
+
+type OverrideField int32
+
+const (
+	OverrideField_OVERRIDE_FIELD_UNSPECIFIED      OverrideField = 0
+	OverrideField_OVERRIDE_FIELD_DESTINATION_IATA OverrideField = 1
+	OverrideField_OVERRIDE_FIELD_CITY             OverrideField = 2
+	OverrideField_OVERRIDE_FIELD_STADIUM          OverrideField = 3
+	OverrideField_OVERRIDE_FIELD_KICKOFF_UTC      OverrideField = 4 // value is RFC3339
+	OverrideField_OVERRIDE_FIELD_TICKETS_LINK     OverrideField = 5
+)
+
+// Enum value maps for OverrideField.
+var (
+	OverrideField_name = map[int32]string{
+		0: "OVERRIDE_FIELD_UNSPECIFIED",
+		1: "OVERRIDE_FIELD_DESTINATION_IATA",
+		2: "OVERRIDE_FIELD_CITY",
+		3: "OVERRIDE_FIELD_STADIUM",
+		4: "OVERRIDE_FIELD_KICKOFF_UTC",
+		5: "OVERRIDE_FIELD_TICKETS_LINK",
+	}
+	OverrideField_value = map[string]int32{
+		"OVERRIDE_FIELD_UNSPECIFIED":      0,
+		"OVERRIDE_FIELD_DESTINATION_IATA": 1,
+		"OVERRIDE_FIELD_CITY":             2,
+		"OVERRIDE_FIELD_STADIUM":          3,
+		"OVERRIDE_FIELD_KICKOFF_UTC":      4,
+		"OVERRIDE_FIELD_TICKETS_LINK":     5,
+	}
+)
+
+func (x OverrideField) Enum() *OverrideField {
+	p := new(OverrideField)
+	*p = x
+	return p
+}
+
+func (x OverrideField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverrideField) Descriptor() protoreflect.EnumDescriptor {
+	return file_match_v1_match_adapter_proto_enumTypes[3].Descriptor()
+}
+
+func (OverrideField) Type() protoreflect.EnumType {
+	return &file_match_v1_match_adapter_proto_enumTypes[3]
+}
+
+func (x OverrideField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverrideField.Descriptor instead.
+func (OverrideField) EnumDescriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{3}
+}
+
 type GetMatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	IncludeClubs  bool                   `protobuf:"varint,2,opt,name=include_clubs,json=includeClubs,proto3" json:"include_clubs,omitempty"` // fill Match.home_club and Match.away_club
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +278,13 @@ func (x *GetMatchRequest) GetMatchId() int64 {
 	return 0
 }
 
+func (x *GetMatchRequest) GetIncludeClubs() bool {
+	if x != nil {
+		return x.IncludeClubs
+	}
+	return false
+}
+
 type GetMatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
@@ -110,28 +329,29 @@ func (x *GetMatchResponse) GetMatch() *Match {
 	return nil
 }
 
-type GetUpcomingMatchesRequest struct {
+// Up to 100 ids; duplicates are answered once.
+type GetMatchesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	ClubId        string                 `protobuf:"bytes,2,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	MatchIds      []int64                `protobuf:"varint,1,rep,packed,name=match_ids,json=matchIds,proto3" json:"match_ids,omitempty"`
+	IncludeClubs  bool                   `protobuf:"varint,2,opt,name=include_clubs,json=includeClubs,proto3" json:"include_clubs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpcomingMatchesRequest) Reset() {
-	*x = GetUpcomingMatchesRequest{}
+func (x *GetMatchesRequest) Reset() {
+	*x = GetMatchesRequest{}
 	mi := &file_match_v1_match_adapter_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingMatchesRequest) String() string {
+func (x *GetMatchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingMatchesRequest) ProtoMessage() {}
+func (*GetMatchesRequest) ProtoMessage() {}
 
-func (x *GetUpcomingMatchesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMatchesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_match_v1_match_adapter_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -143,46 +363,47 @@ func (x *GetUpcomingMatchesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingMatchesRequest.ProtoReflect.Descriptor instead.
-func (*GetUpcomingMatchesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchesRequest) Descriptor() ([]byte, []int) {
 	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetUpcomingMatchesRequest) GetLimit() int32 {
+func (x *GetMatchesRequest) GetMatchIds() []int64 {
 	if x != nil {
-		return x.Limit
+		return x.MatchIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetUpcomingMatchesRequest) GetClubId() string {
+func (x *GetMatchesRequest) GetIncludeClubs() bool {
 	if x != nil {
-		return x.ClubId
+		return x.IncludeClubs
 	}
-	return ""
+	return false
 }
 
-type GetUpcomingMatchesResponse struct {
+type GetMatchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"` // found matches in the order of match_ids
+	Errors        []*MatchError          `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`   // one per id that could not be loaded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpcomingMatchesResponse) Reset() {
-	*x = GetUpcomingMatchesResponse{}
+func (x *GetMatchesResponse) Reset() {
+	*x = GetMatchesResponse{}
 	mi := &file_match_v1_match_adapter_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingMatchesResponse) String() string {
+func (x *GetMatchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingMatchesResponse) ProtoMessage() {}
+func (*GetMatchesResponse) ProtoMessage() {}
 
-func (x *GetUpcomingMatchesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMatchesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_match_v1_match_adapter_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,38 +415,48 @@ func (x *GetUpcomingMatchesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingMatchesResponse.ProtoReflect.Descriptor instead.
-func (*GetUpcomingMatchesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchesResponse) Descriptor() ([]byte, []int) {
 	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetUpcomingMatchesResponse) GetMatches() []*Match {
+func (x *GetMatchesResponse) GetMatches() []*Match {
 	if x != nil {
 		return x.Matches
 	}
 	return nil
 }
 
-type GetClubsRequest struct {
+func (x *GetMatchesResponse) GetErrors() []*MatchError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type MatchError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Reason        MatchErrorReason       `protobuf:"varint,2,opt,name=reason,proto3,enum=match.v1.MatchErrorReason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClubsRequest) Reset() {
-	*x = GetClubsRequest{}
+func (x *MatchError) Reset() {
+	*x = MatchError{}
 	mi := &file_match_v1_match_adapter_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClubsRequest) String() string {
+func (x *MatchError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClubsRequest) ProtoMessage() {}
+func (*MatchError) ProtoMessage() {}
 
-func (x *GetClubsRequest) ProtoReflect() protoreflect.Message {
+func (x *MatchError) ProtoReflect() protoreflect.Message {
 	mi := &file_match_v1_match_adapter_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,32 +468,63 @@ func (x *GetClubsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClubsRequest.ProtoReflect.Descriptor instead.
-func (*GetClubsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MatchError.ProtoReflect.Descriptor instead.
+func (*MatchError) Descriptor() ([]byte, []int) {
 	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{4}
 }
 
-type GetClubsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clubs         []*Club                `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MatchError) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
 }
 
-func (x *GetClubsResponse) Reset() {
-	*x = GetClubsResponse{}
+func (x *MatchError) GetReason() MatchErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return MatchErrorReason_MATCH_ERROR_REASON_UNSPECIFIED
+}
+
+func (x *MatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetUpcomingMatchesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	ClubId          string                 `protobuf:"bytes,2,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`    // kept for old clients, merged into club_ids
+	Competition     string                 `protobuf:"bytes,3,opt,name=competition,proto3" json:"competition,omitempty"`        // empty means all competitions
+	FromUtc         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from_utc,json=fromUtc,proto3" json:"from_utc,omitempty"` // defaults to now, an earlier value is raised to now
+	ToUtc           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to_utc,json=toUtc,proto3" json:"to_utc,omitempty"`       // open range if empty
+	DestinationIata string                 `protobuf:"bytes,6,opt,name=destination_iata,json=destinationIata,proto3" json:"destination_iata,omitempty"`
+	City            string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	ClubIds         []string               `protobuf:"bytes,8,rep,name=club_ids,json=clubIds,proto3" json:"club_ids,omitempty"`
+	ClubSide        ClubSide               `protobuf:"varint,9,opt,name=club_side,json=clubSide,proto3,enum=match.v1.ClubSide" json:"club_side,omitempty"`
+	Cursor          string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor from the previous page
+	IncludeClubs    bool                   `protobuf:"varint,11,opt,name=include_clubs,json=includeClubs,proto3" json:"include_clubs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUpcomingMatchesRequest) Reset() {
+	*x = GetUpcomingMatchesRequest{}
 	mi := &file_match_v1_match_adapter_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClubsResponse) String() string {
+func (x *GetUpcomingMatchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClubsResponse) ProtoMessage() {}
+func (*GetUpcomingMatchesRequest) ProtoMessage() {}
 
-func (x *GetClubsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUpcomingMatchesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_match_v1_match_adapter_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -274,145 +536,1197 @@ func (x *GetClubsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClubsResponse.ProtoReflect.Descriptor instead.
-func (*GetClubsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUpcomingMatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetUpcomingMatchesRequest) Descriptor() ([]byte, []int) {
 	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetClubsResponse) GetClubs() []*Club {
+func (x *GetUpcomingMatchesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Clubs
+		return x.Limit
 	}
-	return nil
-}
-
-type Match struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	MatchId                int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	KickoffUtc             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=kickoff_utc,json=kickoffUtc,proto3" json:"kickoff_utc,omitempty"`
-	City                   string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Stadium                string                 `protobuf:"bytes,4,opt,name=stadium,proto3" json:"stadium,omitempty"`
-	DestinationAirportIata string                 `protobuf:"bytes,5,opt,name=destination_airport_iata,json=destinationAirportIata,proto3" json:"destination_airport_iata,omitempty"`
-	ClubHomeId             string                 `protobuf:"bytes,6,opt,name=club_home_id,json=clubHomeId,proto3" json:"club_home_id,omitempty"`
-	ClubAwayId             string                 `protobuf:"bytes,7,opt,name=club_away_id,json=clubAwayId,proto3" json:"club_away_id,omitempty"`
-	TicketsLink            string                 `protobuf:"bytes,8,opt,name=tickets_link,json=ticketsLink,proto3" json:"tickets_link,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *Match) Reset() {
-	*x = Match{}
-	mi := &file_match_v1_match_adapter_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Match) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*Match) ProtoMessage() {}
-
-func (x *Match) ProtoReflect() protoreflect.Message {
-	mi := &file_match_v1_match_adapter_proto_msgTypes[6]
+func (x *GetUpcomingMatchesRequest) GetClubId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClubId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Match.ProtoReflect.Descriptor instead.
-func (*Match) Descriptor() ([]byte, []int) {
-	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{6}
+func (x *GetUpcomingMatchesRequest) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
 }
 
-func (x *Match) GetMatchId() int64 {
+func (x *GetUpcomingMatchesRequest) GetFromUtc() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MatchId
+		return x.FromUtc
 	}
-	return 0
+	return nil
 }
 
-func (x *Match) GetKickoffUtc() *timestamppb.Timestamp {
+func (x *GetUpcomingMatchesRequest) GetToUtc() *timestamppb.Timestamp {
 	if x != nil {
-		return x.KickoffUtc
+		return x.ToUtc
 	}
 	return nil
 }
 
-func (x *Match) GetCity() string {
+func (x *GetUpcomingMatchesRequest) GetDestinationIata() string {
 	if x != nil {
-		return x.City
+		return x.DestinationIata
 	}
 	return ""
 }
 
-func (x *Match) GetStadium() string {
+func (x *GetUpcomingMatchesRequest) GetCity() string {
 	if x != nil {
-		return x.Stadium
+		return x.City
 	}
 	return ""
 }
 
-func (x *Match) GetDestinationAirportIata() string {
+func (x *GetUpcomingMatchesRequest) GetClubIds() []string {
 	if x != nil {
-		return x.DestinationAirportIata
+		return x.ClubIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Match) GetClubHomeId() string {
+func (x *GetUpcomingMatchesRequest) GetClubSide() ClubSide {
 	if x != nil {
-		return x.ClubHomeId
+		return x.ClubSide
 	}
-	return ""
+	return ClubSide_CLUB_SIDE_UNSPECIFIED
 }
 
-func (x *Match) GetClubAwayId() string {
+func (x *GetUpcomingMatchesRequest) GetCursor() string {
 	if x != nil {
-		return x.ClubAwayId
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *Match) GetTicketsLink() string {
+func (x *GetUpcomingMatchesRequest) GetIncludeClubs() bool {
 	if x != nil {
-		return x.TicketsLink
+		return x.IncludeClubs
 	}
-	return ""
+	return false
 }
 
-type Club struct {
+type GetUpcomingMatchesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
-	NameRu        string                 `protobuf:"bytes,2,opt,name=name_ru,json=nameRu,proto3" json:"name_ru,omitempty"`
-	NameEn        string                 `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
-	Logo          string                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
-	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
-	AirportIata   string                 `protobuf:"bytes,6,opt,name=airport_iata,json=airportIata,proto3" json:"airport_iata,omitempty"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Club) Reset() {
-	*x = Club{}
-	mi := &file_match_v1_match_adapter_proto_msgTypes[7]
+func (x *GetUpcomingMatchesResponse) Reset() {
+	*x = GetUpcomingMatchesResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Club) String() string {
+func (x *GetUpcomingMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingMatchesResponse) ProtoMessage() {}
+
+func (x *GetUpcomingMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingMatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetUpcomingMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUpcomingMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetUpcomingMatchesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListMatchesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limit           int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	FromUtc         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_utc,json=fromUtc,proto3" json:"from_utc,omitempty"`
+	ToUtc           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_utc,json=toUtc,proto3" json:"to_utc,omitempty"`
+	ClubIds         []string               `protobuf:"bytes,4,rep,name=club_ids,json=clubIds,proto3" json:"club_ids,omitempty"`
+	ClubSide        ClubSide               `protobuf:"varint,5,opt,name=club_side,json=clubSide,proto3,enum=match.v1.ClubSide" json:"club_side,omitempty"`
+	Competition     string                 `protobuf:"bytes,6,opt,name=competition,proto3" json:"competition,omitempty"`
+	DestinationIata string                 `protobuf:"bytes,7,opt,name=destination_iata,json=destinationIata,proto3" json:"destination_iata,omitempty"`
+	City            string                 `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	Order           SortOrder              `protobuf:"varint,9,opt,name=order,proto3,enum=match.v1.SortOrder" json:"order,omitempty"`
+	Cursor          string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeClubs    bool                   `protobuf:"varint,11,opt,name=include_clubs,json=includeClubs,proto3" json:"include_clubs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesRequest) ProtoMessage() {}
+
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMatchesRequest) GetFromUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromUtc
+	}
+	return nil
+}
+
+func (x *ListMatchesRequest) GetToUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToUtc
+	}
+	return nil
+}
+
+func (x *ListMatchesRequest) GetClubIds() []string {
+	if x != nil {
+		return x.ClubIds
+	}
+	return nil
+}
+
+func (x *ListMatchesRequest) GetClubSide() ClubSide {
+	if x != nil {
+		return x.ClubSide
+	}
+	return ClubSide_CLUB_SIDE_UNSPECIFIED
+}
+
+func (x *ListMatchesRequest) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetDestinationIata() string {
+	if x != nil {
+		return x.DestinationIata
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListMatchesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetIncludeClubs() bool {
+	if x != nil {
+		return x.IncludeClubs
+	}
+	return false
+}
+
+type ListMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesResponse) ProtoMessage() {}
+
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ListMatchesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Past matches are returned newest first.
+type GetPastMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	FromUtc       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_utc,json=fromUtc,proto3" json:"from_utc,omitempty"`
+	ClubIds       []string               `protobuf:"bytes,3,rep,name=club_ids,json=clubIds,proto3" json:"club_ids,omitempty"`
+	ClubSide      ClubSide               `protobuf:"varint,4,opt,name=club_side,json=clubSide,proto3,enum=match.v1.ClubSide" json:"club_side,omitempty"`
+	Competition   string                 `protobuf:"bytes,5,opt,name=competition,proto3" json:"competition,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeClubs  bool                   `protobuf:"varint,7,opt,name=include_clubs,json=includeClubs,proto3" json:"include_clubs,omitempty"`
+	ToUtc         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=to_utc,json=toUtc,proto3" json:"to_utc,omitempty"` // capped at now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPastMatchesRequest) Reset() {
+	*x = GetPastMatchesRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPastMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPastMatchesRequest) ProtoMessage() {}
+
+func (x *GetPastMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPastMatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetPastMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPastMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPastMatchesRequest) GetFromUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromUtc
+	}
+	return nil
+}
+
+func (x *GetPastMatchesRequest) GetClubIds() []string {
+	if x != nil {
+		return x.ClubIds
+	}
+	return nil
+}
+
+func (x *GetPastMatchesRequest) GetClubSide() ClubSide {
+	if x != nil {
+		return x.ClubSide
+	}
+	return ClubSide_CLUB_SIDE_UNSPECIFIED
+}
+
+func (x *GetPastMatchesRequest) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
+}
+
+func (x *GetPastMatchesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPastMatchesRequest) GetIncludeClubs() bool {
+	if x != nil {
+		return x.IncludeClubs
+	}
+	return false
+}
+
+func (x *GetPastMatchesRequest) GetToUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToUtc
+	}
+	return nil
+}
+
+type GetPastMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPastMatchesResponse) Reset() {
+	*x = GetPastMatchesResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPastMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPastMatchesResponse) ProtoMessage() {}
+
+func (x *GetPastMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPastMatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetPastMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPastMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetPastMatchesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetStadiumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StadiumId     string                 `protobuf:"bytes,1,opt,name=stadium_id,json=stadiumId,proto3" json:"stadium_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStadiumRequest) Reset() {
+	*x = GetStadiumRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStadiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStadiumRequest) ProtoMessage() {}
+
+func (x *GetStadiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStadiumRequest.ProtoReflect.Descriptor instead.
+func (*GetStadiumRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetStadiumRequest) GetStadiumId() string {
+	if x != nil {
+		return x.StadiumId
+	}
+	return ""
+}
+
+type GetStadiumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stadium       *Stadium               `protobuf:"bytes,1,opt,name=stadium,proto3" json:"stadium,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStadiumResponse) Reset() {
+	*x = GetStadiumResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStadiumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStadiumResponse) ProtoMessage() {}
+
+func (x *GetStadiumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStadiumResponse.ProtoReflect.Descriptor instead.
+func (*GetStadiumResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStadiumResponse) GetStadium() *Stadium {
+	if x != nil {
+		return x.Stadium
+	}
+	return nil
+}
+
+type GetClubsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClubsRequest) Reset() {
+	*x = GetClubsRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClubsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClubsRequest) ProtoMessage() {}
+
+func (x *GetClubsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClubsRequest.ProtoReflect.Descriptor instead.
+func (*GetClubsRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{13}
+}
+
+type GetClubsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clubs         []*Club                `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClubsResponse) Reset() {
+	*x = GetClubsResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClubsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClubsResponse) ProtoMessage() {}
+
+func (x *GetClubsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClubsResponse.ProtoReflect.Descriptor instead.
+func (*GetClubsResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetClubsResponse) GetClubs() []*Club {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+type Match struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MatchId                int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	KickoffUtc             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=kickoff_utc,json=kickoffUtc,proto3" json:"kickoff_utc,omitempty"`
+	City                   string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"` // dictionary name in Russian once resolved, the source spelling otherwise
+	Stadium                string                 `protobuf:"bytes,4,opt,name=stadium,proto3" json:"stadium,omitempty"`
+	DestinationAirportIata string                 `protobuf:"bytes,5,opt,name=destination_airport_iata,json=destinationAirportIata,proto3" json:"destination_airport_iata,omitempty"`
+	ClubHomeId             string                 `protobuf:"bytes,6,opt,name=club_home_id,json=clubHomeId,proto3" json:"club_home_id,omitempty"`
+	ClubAwayId             string                 `protobuf:"bytes,7,opt,name=club_away_id,json=clubAwayId,proto3" json:"club_away_id,omitempty"`
+	TicketsLink            string                 `protobuf:"bytes,8,opt,name=tickets_link,json=ticketsLink,proto3" json:"tickets_link,omitempty"`
+	Competition            string                 `protobuf:"bytes,9,opt,name=competition,proto3" json:"competition,omitempty"`                                  // rpl, cup, fnl...
+	KickoffRevision        int32                  `protobuf:"varint,10,opt,name=kickoff_revision,json=kickoffRevision,proto3" json:"kickoff_revision,omitempty"` // bumped every time kickoff_utc changes
+	HomeScore              *int32                 `protobuf:"varint,11,opt,name=home_score,json=homeScore,proto3,oneof" json:"home_score,omitempty"`             // unset until the result is known
+	AwayScore              *int32                 `protobuf:"varint,12,opt,name=away_score,json=awayScore,proto3,oneof" json:"away_score,omitempty"`
+	Venue                  *Stadium               `protobuf:"bytes,13,opt,name=venue,proto3" json:"venue,omitempty"`                       // unset while the stadium string is not mapped to the dictionary
+	HomeClub               *Club                  `protobuf:"bytes,15,opt,name=home_club,json=homeClub,proto3" json:"home_club,omitempty"` // set only with include_clubs and when the club is known
+	AwayClub               *Club                  `protobuf:"bytes,16,opt,name=away_club,json=awayClub,proto3" json:"away_club,omitempty"`
+	CityEn                 string                 `protobuf:"bytes,17,opt,name=city_en,json=cityEn,proto3" json:"city_en,omitempty"`                                // empty while the city is not in the dictionary
+	KickoffTentative       bool                   `protobuf:"varint,18,opt,name=kickoff_tentative,json=kickoffTentative,proto3" json:"kickoff_tentative,omitempty"` // true while kickoff_utc is a placeholder: only its date in Moscow time holds
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Match) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *Match) GetKickoffUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.KickoffUtc
+	}
+	return nil
+}
+
+func (x *Match) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Match) GetStadium() string {
+	if x != nil {
+		return x.Stadium
+	}
+	return ""
+}
+
+func (x *Match) GetDestinationAirportIata() string {
+	if x != nil {
+		return x.DestinationAirportIata
+	}
+	return ""
+}
+
+func (x *Match) GetClubHomeId() string {
+	if x != nil {
+		return x.ClubHomeId
+	}
+	return ""
+}
+
+func (x *Match) GetClubAwayId() string {
+	if x != nil {
+		return x.ClubAwayId
+	}
+	return ""
+}
+
+func (x *Match) GetTicketsLink() string {
+	if x != nil {
+		return x.TicketsLink
+	}
+	return ""
+}
+
+func (x *Match) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
+}
+
+func (x *Match) GetKickoffRevision() int32 {
+	if x != nil {
+		return x.KickoffRevision
+	}
+	return 0
+}
+
+func (x *Match) GetHomeScore() int32 {
+	if x != nil && x.HomeScore != nil {
+		return *x.HomeScore
+	}
+	return 0
+}
+
+func (x *Match) GetAwayScore() int32 {
+	if x != nil && x.AwayScore != nil {
+		return *x.AwayScore
+	}
+	return 0
+}
+
+func (x *Match) GetVenue() *Stadium {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *Match) GetHomeClub() *Club {
+	if x != nil {
+		return x.HomeClub
+	}
+	return nil
+}
+
+func (x *Match) GetAwayClub() *Club {
+	if x != nil {
+		return x.AwayClub
+	}
+	return nil
+}
+
+func (x *Match) GetCityEn() string {
+	if x != nil {
+		return x.CityEn
+	}
+	return ""
+}
+
+func (x *Match) GetKickoffTentative() bool {
+	if x != nil {
+		return x.KickoffTentative
+	}
+	return false
+}
+
+type Stadium struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StadiumId     string                 `protobuf:"bytes,1,opt,name=stadium_id,json=stadiumId,proto3" json:"stadium_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA zone, e.g. Europe/Kaliningrad
+	Airports      []*StadiumAirport      `protobuf:"bytes,7,rep,name=airports,proto3" json:"airports,omitempty"` // nearest first
+	NameEn        string                 `protobuf:"bytes,8,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	CityEn        string                 `protobuf:"bytes,9,opt,name=city_en,json=cityEn,proto3" json:"city_en,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stadium) Reset() {
+	*x = Stadium{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stadium) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stadium) ProtoMessage() {}
+
+func (x *Stadium) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stadium.ProtoReflect.Descriptor instead.
+func (*Stadium) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Stadium) GetStadiumId() string {
+	if x != nil {
+		return x.StadiumId
+	}
+	return ""
+}
+
+func (x *Stadium) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stadium) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Stadium) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Stadium) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Stadium) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Stadium) GetAirports() []*StadiumAirport {
+	if x != nil {
+		return x.Airports
+	}
+	return nil
+}
+
+func (x *Stadium) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *Stadium) GetCityEn() string {
+	if x != nil {
+		return x.CityEn
+	}
+	return ""
+}
+
+type StadiumAirport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Iata            string                 `protobuf:"bytes,1,opt,name=iata,proto3" json:"iata,omitempty"`
+	TransferMinutes int32                  `protobuf:"varint,2,opt,name=transfer_minutes,json=transferMinutes,proto3" json:"transfer_minutes,omitempty"` // typical travel time from the airport to the stadium
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StadiumAirport) Reset() {
+	*x = StadiumAirport{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StadiumAirport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StadiumAirport) ProtoMessage() {}
+
+func (x *StadiumAirport) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StadiumAirport.ProtoReflect.Descriptor instead.
+func (*StadiumAirport) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StadiumAirport) GetIata() string {
+	if x != nil {
+		return x.Iata
+	}
+	return ""
+}
+
+func (x *StadiumAirport) GetTransferMinutes() int32 {
+	if x != nil {
+		return x.TransferMinutes
+	}
+	return 0
+}
+
+type Club struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	NameRu        string                 `protobuf:"bytes,2,opt,name=name_ru,json=nameRu,proto3" json:"name_ru,omitempty"`
+	NameEn        string                 `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Logo          string                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	AirportIata   string                 `protobuf:"bytes,6,opt,name=airport_iata,json=airportIata,proto3" json:"airport_iata,omitempty"`
+	CityEn        string                 `protobuf:"bytes,7,opt,name=city_en,json=cityEn,proto3" json:"city_en,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Club) Reset() {
+	*x = Club{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Club) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*Club) ProtoMessage() {}
 
-func (x *Club) ProtoReflect() protoreflect.Message {
-	mi := &file_match_v1_match_adapter_proto_msgTypes[7]
+func (x *Club) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Club.ProtoReflect.Descriptor instead.
+func (*Club) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Club) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *Club) GetNameRu() string {
+	if x != nil {
+		return x.NameRu
+	}
+	return ""
+}
+
+func (x *Club) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *Club) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *Club) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Club) GetAirportIata() string {
+	if x != nil {
+		return x.AirportIata
+	}
+	return ""
+}
+
+func (x *Club) GetCityEn() string {
+	if x != nil {
+		return x.CityEn
+	}
+	return ""
+}
+
+type MatchOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Field         OverrideField          `protobuf:"varint,2,opt,name=field,proto3,enum=match.v1.OverrideField" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unset means never
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Active        bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"` // false once expired
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchOverride) Reset() {
+	*x = MatchOverride{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchOverride) ProtoMessage() {}
+
+func (x *MatchOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchOverride.ProtoReflect.Descriptor instead.
+func (*MatchOverride) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MatchOverride) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchOverride) GetField() OverrideField {
+	if x != nil {
+		return x.Field
+	}
+	return OverrideField_OVERRIDE_FIELD_UNSPECIFIED
+}
+
+func (x *MatchOverride) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MatchOverride) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MatchOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MatchOverride) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MatchOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MatchOverride) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *MatchOverride) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Setting a field that already has an override replaces it.
+type SetMatchOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Field         OverrideField          `protobuf:"varint,2,opt,name=field,proto3,enum=match.v1.OverrideField" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMatchOverrideRequest) Reset() {
+	*x = SetMatchOverrideRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMatchOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMatchOverrideRequest) ProtoMessage() {}
+
+func (x *SetMatchOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,70 +1737,373 @@ func (x *Club) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Club.ProtoReflect.Descriptor instead.
-func (*Club) Descriptor() ([]byte, []int) {
-	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SetMatchOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetMatchOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Club) GetClubId() string {
+func (x *SetMatchOverrideRequest) GetMatchId() int64 {
 	if x != nil {
-		return x.ClubId
+		return x.MatchId
 	}
-	return ""
+	return 0
 }
 
-func (x *Club) GetNameRu() string {
+func (x *SetMatchOverrideRequest) GetField() OverrideField {
 	if x != nil {
-		return x.NameRu
+		return x.Field
 	}
-	return ""
+	return OverrideField_OVERRIDE_FIELD_UNSPECIFIED
 }
 
-func (x *Club) GetNameEn() string {
+func (x *SetMatchOverrideRequest) GetValue() string {
 	if x != nil {
-		return x.NameEn
+		return x.Value
 	}
 	return ""
 }
 
-func (x *Club) GetLogo() string {
+func (x *SetMatchOverrideRequest) GetAuthor() string {
 	if x != nil {
-		return x.Logo
+		return x.Author
 	}
 	return ""
 }
 
-func (x *Club) GetCity() string {
+func (x *SetMatchOverrideRequest) GetReason() string {
 	if x != nil {
-		return x.City
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Club) GetAirportIata() string {
+func (x *SetMatchOverrideRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AirportIata
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
+}
+
+type SetMatchOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *MatchOverride         `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	Match         *Match                 `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"` // the match with all active overrides applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMatchOverrideResponse) Reset() {
+	*x = SetMatchOverrideResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMatchOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMatchOverrideResponse) ProtoMessage() {}
+
+func (x *SetMatchOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMatchOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetMatchOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetMatchOverrideResponse) GetOverride() *MatchOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+func (x *SetMatchOverrideResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type DeleteMatchOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Field         OverrideField          `protobuf:"varint,2,opt,name=field,proto3,enum=match.v1.OverrideField" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMatchOverrideRequest) Reset() {
+	*x = DeleteMatchOverrideRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMatchOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMatchOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteMatchOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMatchOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMatchOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteMatchOverrideRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *DeleteMatchOverrideRequest) GetField() OverrideField {
+	if x != nil {
+		return x.Field
+	}
+	return OverrideField_OVERRIDE_FIELD_UNSPECIFIED
+}
+
+type DeleteMatchOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"` // reloaded from the source when it is reachable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMatchOverrideResponse) Reset() {
+	*x = DeleteMatchOverrideResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMatchOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMatchOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteMatchOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMatchOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMatchOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteMatchOverrideResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type ListMatchOverridesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MatchId        int64                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"` // 0 lists overrides of all matches
+	IncludeExpired bool                   `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMatchOverridesRequest) Reset() {
+	*x = ListMatchOverridesRequest{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchOverridesRequest) ProtoMessage() {}
+
+func (x *ListMatchOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListMatchOverridesRequest) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *ListMatchOverridesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListMatchOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overrides     []*MatchOverride       `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchOverridesResponse) Reset() {
+	*x = ListMatchOverridesResponse{}
+	mi := &file_match_v1_match_adapter_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchOverridesResponse) ProtoMessage() {}
+
+func (x *ListMatchOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_match_v1_match_adapter_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_match_v1_match_adapter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListMatchOverridesResponse) GetOverrides() []*MatchOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
 }
 
 var File_match_v1_match_adapter_proto protoreflect.FileDescriptor
 
 const file_match_v1_match_adapter_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmatch/v1/match_adapter.proto\x12\bmatch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\",\n" +
+	"\x1cmatch/v1/match_adapter.proto\x12\bmatch.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"Q\n" +
 	"\x0fGetMatchRequest\x12\x19\n" +
-	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\"9\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12#\n" +
+	"\rinclude_clubs\x18\x02 \x01(\bR\fincludeClubs\"9\n" +
 	"\x10GetMatchResponse\x12%\n" +
-	"\x05match\x18\x01 \x01(\v2\x0f.match.v1.MatchR\x05match\"J\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.match.v1.MatchR\x05match\"U\n" +
+	"\x11GetMatchesRequest\x12\x1b\n" +
+	"\tmatch_ids\x18\x01 \x03(\x03R\bmatchIds\x12#\n" +
+	"\rinclude_clubs\x18\x02 \x01(\bR\fincludeClubs\"m\n" +
+	"\x12GetMatchesResponse\x12)\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.match.v1.MatchR\amatches\x12,\n" +
+	"\x06errors\x18\x02 \x03(\v2\x14.match.v1.MatchErrorR\x06errors\"u\n" +
+	"\n" +
+	"MatchError\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x122\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1a.match.v1.MatchErrorReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x9e\x03\n" +
 	"\x19GetUpcomingMatchesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x17\n" +
-	"\aclub_id\x18\x02 \x01(\tR\x06clubId\"G\n" +
+	"\aclub_id\x18\x02 \x01(\tR\x06clubId\x12 \n" +
+	"\vcompetition\x18\x03 \x01(\tR\vcompetition\x125\n" +
+	"\bfrom_utc\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\afromUtc\x121\n" +
+	"\x06to_utc\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05toUtc\x12)\n" +
+	"\x10destination_iata\x18\x06 \x01(\tR\x0fdestinationIata\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\x12\x19\n" +
+	"\bclub_ids\x18\b \x03(\tR\aclubIds\x12/\n" +
+	"\tclub_side\x18\t \x01(\x0e2\x12.match.v1.ClubSideR\bclubSide\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12#\n" +
+	"\rinclude_clubs\x18\v \x01(\bR\fincludeClubs\"h\n" +
 	"\x1aGetUpcomingMatchesResponse\x12)\n" +
-	"\amatches\x18\x01 \x03(\v2\x0f.match.v1.MatchR\amatches\"\x11\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.match.v1.MatchR\amatches\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xa9\x03\n" +
+	"\x12ListMatchesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x125\n" +
+	"\bfrom_utc\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\afromUtc\x121\n" +
+	"\x06to_utc\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05toUtc\x12\x19\n" +
+	"\bclub_ids\x18\x04 \x03(\tR\aclubIds\x12/\n" +
+	"\tclub_side\x18\x05 \x01(\x0e2\x12.match.v1.ClubSideR\bclubSide\x12 \n" +
+	"\vcompetition\x18\x06 \x01(\tR\vcompetition\x12)\n" +
+	"\x10destination_iata\x18\a \x01(\tR\x0fdestinationIata\x12\x12\n" +
+	"\x04city\x18\b \x01(\tR\x04city\x12)\n" +
+	"\x05order\x18\t \x01(\x0e2\x13.match.v1.SortOrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12#\n" +
+	"\rinclude_clubs\x18\v \x01(\bR\fincludeClubs\"a\n" +
+	"\x13ListMatchesResponse\x12)\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.match.v1.MatchR\amatches\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc2\x02\n" +
+	"\x15GetPastMatchesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x125\n" +
+	"\bfrom_utc\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\afromUtc\x12\x19\n" +
+	"\bclub_ids\x18\x03 \x03(\tR\aclubIds\x12/\n" +
+	"\tclub_side\x18\x04 \x01(\x0e2\x12.match.v1.ClubSideR\bclubSide\x12 \n" +
+	"\vcompetition\x18\x05 \x01(\tR\vcompetition\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12#\n" +
+	"\rinclude_clubs\x18\a \x01(\bR\fincludeClubs\x121\n" +
+	"\x06to_utc\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05toUtc\"d\n" +
+	"\x16GetPastMatchesResponse\x12)\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.match.v1.MatchR\amatches\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"2\n" +
+	"\x11GetStadiumRequest\x12\x1d\n" +
+	"\n" +
+	"stadium_id\x18\x01 \x01(\tR\tstadiumId\"A\n" +
+	"\x12GetStadiumResponse\x12+\n" +
+	"\astadium\x18\x01 \x01(\v2\x11.match.v1.StadiumR\astadium\"\x11\n" +
 	"\x0fGetClubsRequest\"8\n" +
 	"\x10GetClubsResponse\x12$\n" +
-	"\x05clubs\x18\x01 \x03(\v2\x0e.match.v1.ClubR\x05clubs\"\xae\x02\n" +
+	"\x05clubs\x18\x01 \x03(\v2\x0e.match.v1.ClubR\x05clubs\"\xc3\x05\n" +
 	"\x05Match\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12;\n" +
 	"\vkickoff_utc\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -498,18 +2115,112 @@ const file_match_v1_match_adapter_proto_rawDesc = "" +
 	"clubHomeId\x12 \n" +
 	"\fclub_away_id\x18\a \x01(\tR\n" +
 	"clubAwayId\x12!\n" +
-	"\ftickets_link\x18\b \x01(\tR\vticketsLink\"\x9c\x01\n" +
+	"\ftickets_link\x18\b \x01(\tR\vticketsLink\x12 \n" +
+	"\vcompetition\x18\t \x01(\tR\vcompetition\x12)\n" +
+	"\x10kickoff_revision\x18\n" +
+	" \x01(\x05R\x0fkickoffRevision\x12\"\n" +
+	"\n" +
+	"home_score\x18\v \x01(\x05H\x00R\thomeScore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"away_score\x18\f \x01(\x05H\x01R\tawayScore\x88\x01\x01\x12'\n" +
+	"\x05venue\x18\r \x01(\v2\x11.match.v1.StadiumR\x05venue\x12+\n" +
+	"\thome_club\x18\x0f \x01(\v2\x0e.match.v1.ClubR\bhomeClub\x12+\n" +
+	"\taway_club\x18\x10 \x01(\v2\x0e.match.v1.ClubR\bawayClub\x12\x17\n" +
+	"\acity_en\x18\x11 \x01(\tR\x06cityEn\x12+\n" +
+	"\x11kickoff_tentative\x18\x12 \x01(\bR\x10kickoffTentativeB\r\n" +
+	"\v_home_scoreB\r\n" +
+	"\v_away_scoreJ\x04\b\x0e\x10\x0fR\x11kickoff_confirmed\"\x8e\x02\n" +
+	"\aStadium\x12\x1d\n" +
+	"\n" +
+	"stadium_id\x18\x01 \x01(\tR\tstadiumId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x124\n" +
+	"\bairports\x18\a \x03(\v2\x18.match.v1.StadiumAirportR\bairports\x12\x17\n" +
+	"\aname_en\x18\b \x01(\tR\x06nameEn\x12\x17\n" +
+	"\acity_en\x18\t \x01(\tR\x06cityEn\"O\n" +
+	"\x0eStadiumAirport\x12\x12\n" +
+	"\x04iata\x18\x01 \x01(\tR\x04iata\x12)\n" +
+	"\x10transfer_minutes\x18\x02 \x01(\x05R\x0ftransferMinutes\"\xb5\x01\n" +
 	"\x04Club\x12\x17\n" +
 	"\aclub_id\x18\x01 \x01(\tR\x06clubId\x12\x17\n" +
 	"\aname_ru\x18\x02 \x01(\tR\x06nameRu\x12\x17\n" +
 	"\aname_en\x18\x03 \x01(\tR\x06nameEn\x12\x12\n" +
 	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\x12\n" +
 	"\x04city\x18\x05 \x01(\tR\x04city\x12!\n" +
-	"\fairport_iata\x18\x06 \x01(\tR\vairportIata2\xfc\x01\n" +
+	"\fairport_iata\x18\x06 \x01(\tR\vairportIata\x12\x17\n" +
+	"\acity_en\x18\a \x01(\tR\x06cityEn\"\xe8\x02\n" +
+	"\rMatchOverride\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12-\n" +
+	"\x05field\x18\x02 \x01(\x0e2\x17.match.v1.OverrideFieldR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\"\xe4\x01\n" +
+	"\x17SetMatchOverrideRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12-\n" +
+	"\x05field\x18\x02 \x01(\x0e2\x17.match.v1.OverrideFieldR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"v\n" +
+	"\x18SetMatchOverrideResponse\x123\n" +
+	"\boverride\x18\x01 \x01(\v2\x17.match.v1.MatchOverrideR\boverride\x12%\n" +
+	"\x05match\x18\x02 \x01(\v2\x0f.match.v1.MatchR\x05match\"f\n" +
+	"\x1aDeleteMatchOverrideRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12-\n" +
+	"\x05field\x18\x02 \x01(\x0e2\x17.match.v1.OverrideFieldR\x05field\"D\n" +
+	"\x1bDeleteMatchOverrideResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.match.v1.MatchR\x05match\"_\n" +
+	"\x19ListMatchOverridesRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x03R\amatchId\x12'\n" +
+	"\x0finclude_expired\x18\x02 \x01(\bR\x0eincludeExpired\"S\n" +
+	"\x1aListMatchOverridesResponse\x125\n" +
+	"\toverrides\x18\x01 \x03(\v2\x17.match.v1.MatchOverrideR\toverrides*\xc9\x01\n" +
+	"\x10MatchErrorReason\x12\"\n" +
+	"\x1eMATCH_ERROR_REASON_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cMATCH_ERROR_REASON_NOT_FOUND\x10\x01\x12)\n" +
+	"%MATCH_ERROR_REASON_SOURCE_UNAVAILABLE\x10\x02\x12#\n" +
+	"\x1fMATCH_ERROR_REASON_RATE_LIMITED\x10\x03\x12\x1f\n" +
+	"\x1bMATCH_ERROR_REASON_INTERNAL\x10\x04*M\n" +
+	"\bClubSide\x12\x19\n" +
+	"\x15CLUB_SIDE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eCLUB_SIDE_HOME\x10\x01\x12\x12\n" +
+	"\x0eCLUB_SIDE_AWAY\x10\x02*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*\xca\x01\n" +
+	"\rOverrideField\x12\x1e\n" +
+	"\x1aOVERRIDE_FIELD_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fOVERRIDE_FIELD_DESTINATION_IATA\x10\x01\x12\x17\n" +
+	"\x13OVERRIDE_FIELD_CITY\x10\x02\x12\x1a\n" +
+	"\x16OVERRIDE_FIELD_STADIUM\x10\x03\x12\x1e\n" +
+	"\x1aOVERRIDE_FIELD_KICKOFF_UTC\x10\x04\x12\x1f\n" +
+	"\x1bOVERRIDE_FIELD_TICKETS_LINK\x10\x052\xaf\x04\n" +
 	"\x13MatchAdapterService\x12A\n" +
-	"\bGetMatch\x12\x19.match.v1.GetMatchRequest\x1a\x1a.match.v1.GetMatchResponse\x12_\n" +
+	"\bGetMatch\x12\x19.match.v1.GetMatchRequest\x1a\x1a.match.v1.GetMatchResponse\x12G\n" +
+	"\n" +
+	"GetMatches\x12\x1b.match.v1.GetMatchesRequest\x1a\x1c.match.v1.GetMatchesResponse\x12_\n" +
 	"\x12GetUpcomingMatches\x12#.match.v1.GetUpcomingMatchesRequest\x1a$.match.v1.GetUpcomingMatchesResponse\x12A\n" +
-	"\bGetClubs\x12\x19.match.v1.GetClubsRequest\x1a\x1a.match.v1.GetClubsResponseB:Z8github.com/ozzus/fan-avia/protos/gen/go/match/v1;matchv1b\x06proto3"
+	"\bGetClubs\x12\x19.match.v1.GetClubsRequest\x1a\x1a.match.v1.GetClubsResponse\x12J\n" +
+	"\vListMatches\x12\x1c.match.v1.ListMatchesRequest\x1a\x1d.match.v1.ListMatchesResponse\x12S\n" +
+	"\x0eGetPastMatches\x12\x1f.match.v1.GetPastMatchesRequest\x1a .match.v1.GetPastMatchesResponse\x12G\n" +
+	"\n" +
+	"GetStadium\x12\x1b.match.v1.GetStadiumRequest\x1a\x1c.match.v1.GetStadiumResponse2\xb3\x02\n" +
+	"\x11MatchAdminService\x12Y\n" +
+	"\x10SetMatchOverride\x12!.match.v1.SetMatchOverrideRequest\x1a\".match.v1.SetMatchOverrideResponse\x12b\n" +
+	"\x13DeleteMatchOverride\x12$.match.v1.DeleteMatchOverrideRequest\x1a%.match.v1.DeleteMatchOverrideResponse\x12_\n" +
+	"\x12ListMatchOverrides\x12#.match.v1.ListMatchOverridesRequest\x1a$.match.v1.ListMatchOverridesResponseB:Z8github.com/ozzus/fan-avia/protos/gen/go/match/v1;matchv1b\x06proto3"
 
 var (
 	file_match_v1_match_adapter_proto_rawDescOnce sync.Once
@@ -523,34 +2234,102 @@ func file_match_v1_match_adapter_proto_rawDescGZIP() []byte {
 	return file_match_v1_match_adapter_proto_rawDescData
 }
 
-var file_match_v1_match_adapter_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_match_v1_match_adapter_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_match_v1_match_adapter_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_match_v1_match_adapter_proto_goTypes = []any{
-	(*GetMatchRequest)(nil),            // 0: match.v1.GetMatchRequest
-	(*GetMatchResponse)(nil),           // 1: match.v1.GetMatchResponse
-	(*GetUpcomingMatchesRequest)(nil),  // 2: match.v1.GetUpcomingMatchesRequest
-	(*GetUpcomingMatchesResponse)(nil), // 3: match.v1.GetUpcomingMatchesResponse
-	(*GetClubsRequest)(nil),            // 4: match.v1.GetClubsRequest
-	(*GetClubsResponse)(nil),           // 5: match.v1.GetClubsResponse
-	(*Match)(nil),                      // 6: match.v1.Match
-	(*Club)(nil),                       // 7: match.v1.Club
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(MatchErrorReason)(0),               // 0: match.v1.MatchErrorReason
+	(ClubSide)(0),                       // 1: match.v1.ClubSide
+	(SortOrder)(0),                      // 2: match.v1.SortOrder
+	(OverrideField)(0),                  // 3: match.v1.OverrideField
+	(*GetMatchRequest)(nil),             // 4: match.v1.GetMatchRequest
+	(*GetMatchResponse)(nil),            // 5: match.v1.GetMatchResponse
+	(*GetMatchesRequest)(nil),           // 6: match.v1.GetMatchesRequest
+	(*GetMatchesResponse)(nil),          // 7: match.v1.GetMatchesResponse
+	(*MatchError)(nil),                  // 8: match.v1.MatchError
+	(*GetUpcomingMatchesRequest)(nil),   // 9: match.v1.GetUpcomingMatchesRequest
+	(*GetUpcomingMatchesResponse)(nil),  // 10: match.v1.GetUpcomingMatchesResponse
+	(*ListMatchesRequest)(nil),          // 11: match.v1.ListMatchesRequest
+	(*ListMatchesResponse)(nil),         // 12: match.v1.ListMatchesResponse
+	(*GetPastMatchesRequest)(nil),       // 13: match.v1.GetPastMatchesRequest
+	(*GetPastMatchesResponse)(nil),      // 14: match.v1.GetPastMatchesResponse
+	(*GetStadiumRequest)(nil),           // 15: match.v1.GetStadiumRequest
+	(*GetStadiumResponse)(nil),          // 16: match.v1.GetStadiumResponse
+	(*GetClubsRequest)(nil),             // 17: match.v1.GetClubsRequest
+	(*GetClubsResponse)(nil),            // 18: match.v1.GetClubsResponse
+	(*Match)(nil),                       // 19: match.v1.Match
+	(*Stadium)(nil),                     // 20: match.v1.Stadium
+	(*StadiumAirport)(nil),              // 21: match.v1.StadiumAirport
+	(*Club)(nil),                        // 22: match.v1.Club
+	(*MatchOverride)(nil),               // 23: match.v1.MatchOverride
+	(*SetMatchOverrideRequest)(nil),     // 24: match.v1.SetMatchOverrideRequest
+	(*SetMatchOverrideResponse)(nil),    // 25: match.v1.SetMatchOverrideResponse
+	(*DeleteMatchOverrideRequest)(nil),  // 26: match.v1.DeleteMatchOverrideRequest
+	(*DeleteMatchOverrideResponse)(nil), // 27: match.v1.DeleteMatchOverrideResponse
+	(*ListMatchOverridesRequest)(nil),   // 28: match.v1.ListMatchOverridesRequest
+	(*ListMatchOverridesResponse)(nil),  // 29: match.v1.ListMatchOverridesResponse
+	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
 }
 var file_match_v1_match_adapter_proto_depIdxs = []int32{
-	6, // 0: match.v1.GetMatchResponse.match:type_name -> match.v1.Match
-	6, // 1: match.v1.GetUpcomingMatchesResponse.matches:type_name -> match.v1.Match
-	7, // 2: match.v1.GetClubsResponse.clubs:type_name -> match.v1.Club
-	8, // 3: match.v1.Match.kickoff_utc:type_name -> google.protobuf.Timestamp
-	0, // 4: match.v1.MatchAdapterService.GetMatch:input_type -> match.v1.GetMatchRequest
-	2, // 5: match.v1.MatchAdapterService.GetUpcomingMatches:input_type -> match.v1.GetUpcomingMatchesRequest
-	4, // 6: match.v1.MatchAdapterService.GetClubs:input_type -> match.v1.GetClubsRequest
-	1, // 7: match.v1.MatchAdapterService.GetMatch:output_type -> match.v1.GetMatchResponse
-	3, // 8: match.v1.MatchAdapterService.GetUpcomingMatches:output_type -> match.v1.GetUpcomingMatchesResponse
-	5, // 9: match.v1.MatchAdapterService.GetClubs:output_type -> match.v1.GetClubsResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	19, // 0: match.v1.GetMatchResponse.match:type_name -> match.v1.Match
+	19, // 1: match.v1.GetMatchesResponse.matches:type_name -> match.v1.Match
+	8,  // 2: match.v1.GetMatchesResponse.errors:type_name -> match.v1.MatchError
+	0,  // 3: match.v1.MatchError.reason:type_name -> match.v1.MatchErrorReason
+	30, // 4: match.v1.GetUpcomingMatchesRequest.from_utc:type_name -> google.protobuf.Timestamp
+	30, // 5: match.v1.GetUpcomingMatchesRequest.to_utc:type_name -> google.protobuf.Timestamp
+	1,  // 6: match.v1.GetUpcomingMatchesRequest.club_side:type_name -> match.v1.ClubSide
+	19, // 7: match.v1.GetUpcomingMatchesResponse.matches:type_name -> match.v1.Match
+	30, // 8: match.v1.ListMatchesRequest.from_utc:type_name -> google.protobuf.Timestamp
+	30, // 9: match.v1.ListMatchesRequest.to_utc:type_name -> google.protobuf.Timestamp
+	1,  // 10: match.v1.ListMatchesRequest.club_side:type_name -> match.v1.ClubSide
+	2,  // 11: match.v1.ListMatchesRequest.order:type_name -> match.v1.SortOrder
+	19, // 12: match.v1.ListMatchesResponse.matches:type_name -> match.v1.Match
+	30, // 13: match.v1.GetPastMatchesRequest.from_utc:type_name -> google.protobuf.Timestamp
+	1,  // 14: match.v1.GetPastMatchesRequest.club_side:type_name -> match.v1.ClubSide
+	30, // 15: match.v1.GetPastMatchesRequest.to_utc:type_name -> google.protobuf.Timestamp
+	19, // 16: match.v1.GetPastMatchesResponse.matches:type_name -> match.v1.Match
+	20, // 17: match.v1.GetStadiumResponse.stadium:type_name -> match.v1.Stadium
+	22, // 18: match.v1.GetClubsResponse.clubs:type_name -> match.v1.Club
+	30, // 19: match.v1.Match.kickoff_utc:type_name -> google.protobuf.Timestamp
+	20, // 20: match.v1.Match.venue:type_name -> match.v1.Stadium
+	22, // 21: match.v1.Match.home_club:type_name -> match.v1.Club
+	22, // 22: match.v1.Match.away_club:type_name -> match.v1.Club
+	21, // 23: match.v1.Stadium.airports:type_name -> match.v1.StadiumAirport
+	3,  // 24: match.v1.MatchOverride.field:type_name -> match.v1.OverrideField
+	30, // 25: match.v1.MatchOverride.expires_at:type_name -> google.protobuf.Timestamp
+	30, // 26: match.v1.MatchOverride.created_at:type_name -> google.protobuf.Timestamp
+	30, // 27: match.v1.MatchOverride.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 28: match.v1.SetMatchOverrideRequest.field:type_name -> match.v1.OverrideField
+	30, // 29: match.v1.SetMatchOverrideRequest.expires_at:type_name -> google.protobuf.Timestamp
+	23, // 30: match.v1.SetMatchOverrideResponse.override:type_name -> match.v1.MatchOverride
+	19, // 31: match.v1.SetMatchOverrideResponse.match:type_name -> match.v1.Match
+	3,  // 32: match.v1.DeleteMatchOverrideRequest.field:type_name -> match.v1.OverrideField
+	19, // 33: match.v1.DeleteMatchOverrideResponse.match:type_name -> match.v1.Match
+	23, // 34: match.v1.ListMatchOverridesResponse.overrides:type_name -> match.v1.MatchOverride
+	4,  // 35: match.v1.MatchAdapterService.GetMatch:input_type -> match.v1.GetMatchRequest
+	6,  // 36: match.v1.MatchAdapterService.GetMatches:input_type -> match.v1.GetMatchesRequest
+	9,  // 37: match.v1.MatchAdapterService.GetUpcomingMatches:input_type -> match.v1.GetUpcomingMatchesRequest
+	17, // 38: match.v1.MatchAdapterService.GetClubs:input_type -> match.v1.GetClubsRequest
+	11, // 39: match.v1.MatchAdapterService.ListMatches:input_type -> match.v1.ListMatchesRequest
+	13, // 40: match.v1.MatchAdapterService.GetPastMatches:input_type -> match.v1.GetPastMatchesRequest
+	15, // 41: match.v1.MatchAdapterService.GetStadium:input_type -> match.v1.GetStadiumRequest
+	24, // 42: match.v1.MatchAdminService.SetMatchOverride:input_type -> match.v1.SetMatchOverrideRequest
+	26, // 43: match.v1.MatchAdminService.DeleteMatchOverride:input_type -> match.v1.DeleteMatchOverrideRequest
+	28, // 44: match.v1.MatchAdminService.ListMatchOverrides:input_type -> match.v1.ListMatchOverridesRequest
+	5,  // 45: match.v1.MatchAdapterService.GetMatch:output_type -> match.v1.GetMatchResponse
+	7,  // 46: match.v1.MatchAdapterService.GetMatches:output_type -> match.v1.GetMatchesResponse
+	10, // 47: match.v1.MatchAdapterService.GetUpcomingMatches:output_type -> match.v1.GetUpcomingMatchesResponse
+	18, // 48: match.v1.MatchAdapterService.GetClubs:output_type -> match.v1.GetClubsResponse
+	12, // 49: match.v1.MatchAdapterService.ListMatches:output_type -> match.v1.ListMatchesResponse
+	14, // 50: match.v1.MatchAdapterService.GetPastMatches:output_type -> match.v1.GetPastMatchesResponse
+	16, // 51: match.v1.MatchAdapterService.GetStadium:output_type -> match.v1.GetStadiumResponse
+	25, // 52: match.v1.MatchAdminService.SetMatchOverride:output_type -> match.v1.SetMatchOverrideResponse
+	27, // 53: match.v1.MatchAdminService.DeleteMatchOverride:output_type -> match.v1.DeleteMatchOverrideResponse
+	29, // 54: match.v1.MatchAdminService.ListMatchOverrides:output_type -> match.v1.ListMatchOverridesResponse
+	45, // [45:55] is the sub-list for method output_type
+	35, // [35:45] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_match_v1_match_adapter_proto_init() }
@@ -558,18 +2337,20 @@ func file_match_v1_match_adapter_proto_init() {
 	if File_match_v1_match_adapter_proto != nil {
 		return
 	}
+	file_match_v1_match_adapter_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_match_v1_match_adapter_proto_rawDesc), len(file_match_v1_match_adapter_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   26,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_match_v1_match_adapter_proto_goTypes,
 		DependencyIndexes: file_match_v1_match_adapter_proto_depIdxs,
+		EnumInfos:         file_match_v1_match_adapter_proto_enumTypes,
 		MessageInfos:      file_match_v1_match_adapter_proto_msgTypes,
 	}.Build()
 	File_match_v1_match_adapter_proto = out.File
@@ -20,8 +20,12 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MatchAdapterService_GetMatch_FullMethodName           = "/match.v1.MatchAdapterService/GetMatch"
+	MatchAdapterService_GetMatches_FullMethodName         = "/match.v1.MatchAdapterService/GetMatches"
 	MatchAdapterService_GetUpcomingMatches_FullMethodName = "/match.v1.MatchAdapterService/GetUpcomingMatches"
 	MatchAdapterService_GetClubs_FullMethodName           = "/match.v1.MatchAdapterService/GetClubs"
+	MatchAdapterService_ListMatches_FullMethodName        = "/match.v1.MatchAdapterService/ListMatches"
+	MatchAdapterService_GetPastMatches_FullMethodName     = "/match.v1.MatchAdapterService/GetPastMatches"
+	MatchAdapterService_GetStadium_FullMethodName         = "/match.v1.MatchAdapterService/GetStadium"
 )
 
 // MatchAdapterServiceClient is the client API for MatchAdapterService service.
@@ -29,8 +33,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MatchAdapterServiceClient interface {
 	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*GetMatchResponse, error)
+	GetMatches(ctx context.Context, in *GetMatchesRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error)
 	GetUpcomingMatches(ctx context.Context, in *GetUpcomingMatchesRequest, opts ...grpc.CallOption) (*GetUpcomingMatchesResponse, error)
 	GetClubs(ctx context.Context, in *GetClubsRequest, opts ...grpc.CallOption) (*GetClubsResponse, error)
+	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
+	GetPastMatches(ctx context.Context, in *GetPastMatchesRequest, opts ...grpc.CallOption) (*GetPastMatchesResponse, error)
+	GetStadium(ctx context.Context, in *GetStadiumRequest, opts ...grpc.CallOption) (*GetStadiumResponse, error)
 }
 
 type matchAdapterServiceClient struct {
@@ -51,6 +59,16 @@ func (c *matchAdapterServiceClient) GetMatch(ctx context.Context, in *GetMatchRe
 	return out, nil
 }
 
+func (c *matchAdapterServiceClient) GetMatches(ctx context.Context, in *GetMatchesRequest, opts ...grpc.CallOption) (*GetMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchesResponse)
+	err := c.cc.Invoke(ctx, MatchAdapterService_GetMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchAdapterServiceClient) GetUpcomingMatches(ctx context.Context, in *GetUpcomingMatchesRequest, opts ...grpc.CallOption) (*GetUpcomingMatchesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUpcomingMatchesResponse)
@@ -71,13 +89,47 @@ func (c *matchAdapterServiceClient) GetClubs(ctx context.Context, in *GetClubsRe
 	return out, nil
 }
 
+func (c *matchAdapterServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchesResponse)
+	err := c.cc.Invoke(ctx, MatchAdapterService_ListMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchAdapterServiceClient) GetPastMatches(ctx context.Context, in *GetPastMatchesRequest, opts ...grpc.CallOption) (*GetPastMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPastMatchesResponse)
+	err := c.cc.Invoke(ctx, MatchAdapterService_GetPastMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchAdapterServiceClient) GetStadium(ctx context.Context, in *GetStadiumRequest, opts ...grpc.CallOption) (*GetStadiumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStadiumResponse)
+	err := c.cc.Invoke(ctx, MatchAdapterService_GetStadium_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchAdapterServiceServer is the server API for MatchAdapterService service.
 // All implementations must embed UnimplementedMatchAdapterServiceServer
 // for forward compatibility.
 type MatchAdapterServiceServer interface {
 	GetMatch(context.Context, *GetMatchRequest) (*GetMatchResponse, error)
+	GetMatches(context.Context, *GetMatchesRequest) (*GetMatchesResponse, error)
 	GetUpcomingMatches(context.Context, *GetUpcomingMatchesRequest) (*GetUpcomingMatchesResponse, error)
 	GetClubs(context.Context, *GetClubsRequest) (*GetClubsResponse, error)
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	GetPastMatches(context.Context, *GetPastMatchesRequest) (*GetPastMatchesResponse, error)
+	GetStadium(context.Context, *GetStadiumRequest) (*GetStadiumResponse, error)
 	mustEmbedUnimplementedMatchAdapterServiceServer()
 }
 
@@ -91,12 +143,24 @@ type UnimplementedMatchAdapterServiceServer struct{}
 func (UnimplementedMatchAdapterServiceServer) GetMatch(context.Context, *GetMatchRequest) (*GetMatchResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMatch not implemented")
 }
+func (UnimplementedMatchAdapterServiceServer) GetMatches(context.Context, *GetMatchesRequest) (*GetMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMatches not implemented")
+}
 func (UnimplementedMatchAdapterServiceServer) GetUpcomingMatches(context.Context, *GetUpcomingMatchesRequest) (*GetUpcomingMatchesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUpcomingMatches not implemented")
 }
 func (UnimplementedMatchAdapterServiceServer) GetClubs(context.Context, *GetClubsRequest) (*GetClubsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetClubs not implemented")
 }
+func (UnimplementedMatchAdapterServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedMatchAdapterServiceServer) GetPastMatches(context.Context, *GetPastMatchesRequest) (*GetPastMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPastMatches not implemented")
+}
+func (UnimplementedMatchAdapterServiceServer) GetStadium(context.Context, *GetStadiumRequest) (*GetStadiumResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStadium not implemented")
+}
 func (UnimplementedMatchAdapterServiceServer) mustEmbedUnimplementedMatchAdapterServiceServer() {}
 func (UnimplementedMatchAdapterServiceServer) testEmbeddedByValue()                             {}
 
@@ -136,6 +200,24 @@ func _MatchAdapterService_GetMatch_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchAdapterService_GetMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdapterServiceServer).GetMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdapterService_GetMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdapterServiceServer).GetMatches(ctx, req.(*GetMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchAdapterService_GetUpcomingMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUpcomingMatchesRequest)
 	if err := dec(in); err != nil {
@@ -172,6 +254,60 @@ func _MatchAdapterService_GetClubs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchAdapterService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdapterServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdapterService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdapterServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchAdapterService_GetPastMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPastMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdapterServiceServer).GetPastMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdapterService_GetPastMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdapterServiceServer).GetPastMatches(ctx, req.(*GetPastMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchAdapterService_GetStadium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStadiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdapterServiceServer).GetStadium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdapterService_GetStadium_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdapterServiceServer).GetStadium(ctx, req.(*GetStadiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchAdapterService_ServiceDesc is the grpc.ServiceDesc for MatchAdapterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +319,10 @@ var MatchAdapterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMatch",
 			Handler:    _MatchAdapterService_GetMatch_Handler,
 		},
+		{
+			MethodName: "GetMatches",
+			Handler:    _MatchAdapterService_GetMatches_Handler,
+		},
 		{
 			MethodName: "GetUpcomingMatches",
 			Handler:    _MatchAdapterService_GetUpcomingMatches_Handler,
@@ -191,6 +331,202 @@ var MatchAdapterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClubs",
 			Handler:    _MatchAdapterService_GetClubs_Handler,
 		},
+		{
+			MethodName: "ListMatches",
+			Handler:    _MatchAdapterService_ListMatches_Handler,
+		},
+		{
+			MethodName: "GetPastMatches",
+			Handler:    _MatchAdapterService_GetPastMatches_Handler,
+		},
+		{
+			MethodName: "GetStadium",
+			Handler:    _MatchAdapterService_GetStadium_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "match/v1/match_adapter.proto",
+}
+
+const (
+	MatchAdminService_SetMatchOverride_FullMethodName    = "/match.v1.MatchAdminService/SetMatchOverride"
+	MatchAdminService_DeleteMatchOverride_FullMethodName = "/match.v1.MatchAdminService/DeleteMatchOverride"
+	MatchAdminService_ListMatchOverrides_FullMethodName  = "/match.v1.MatchAdminService/ListMatchOverrides"
+)
+
+// MatchAdminServiceClient is the client API for MatchAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MatchAdminService manages manual corrections of source data. Overrides win
+// over the source on every sync until they are deleted or expire.
+type MatchAdminServiceClient interface {
+	SetMatchOverride(ctx context.Context, in *SetMatchOverrideRequest, opts ...grpc.CallOption) (*SetMatchOverrideResponse, error)
+	DeleteMatchOverride(ctx context.Context, in *DeleteMatchOverrideRequest, opts ...grpc.CallOption) (*DeleteMatchOverrideResponse, error)
+	ListMatchOverrides(ctx context.Context, in *ListMatchOverridesRequest, opts ...grpc.CallOption) (*ListMatchOverridesResponse, error)
+}
+
+type matchAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchAdminServiceClient(cc grpc.ClientConnInterface) MatchAdminServiceClient {
+	return &matchAdminServiceClient{cc}
+}
+
+func (c *matchAdminServiceClient) SetMatchOverride(ctx context.Context, in *SetMatchOverrideRequest, opts ...grpc.CallOption) (*SetMatchOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMatchOverrideResponse)
+	err := c.cc.Invoke(ctx, MatchAdminService_SetMatchOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchAdminServiceClient) DeleteMatchOverride(ctx context.Context, in *DeleteMatchOverrideRequest, opts ...grpc.CallOption) (*DeleteMatchOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMatchOverrideResponse)
+	err := c.cc.Invoke(ctx, MatchAdminService_DeleteMatchOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchAdminServiceClient) ListMatchOverrides(ctx context.Context, in *ListMatchOverridesRequest, opts ...grpc.CallOption) (*ListMatchOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchOverridesResponse)
+	err := c.cc.Invoke(ctx, MatchAdminService_ListMatchOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatchAdminServiceServer is the server API for MatchAdminService service.
+// All implementations must embed UnimplementedMatchAdminServiceServer
+// for forward compatibility.
+//
+// MatchAdminService manages manual corrections of source data. Overrides win
+// over the source on every sync until they are deleted or expire.
+type MatchAdminServiceServer interface {
+	SetMatchOverride(context.Context, *SetMatchOverrideRequest) (*SetMatchOverrideResponse, error)
+	DeleteMatchOverride(context.Context, *DeleteMatchOverrideRequest) (*DeleteMatchOverrideResponse, error)
+	ListMatchOverrides(context.Context, *ListMatchOverridesRequest) (*ListMatchOverridesResponse, error)
+	mustEmbedUnimplementedMatchAdminServiceServer()
+}
+
+// UnimplementedMatchAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMatchAdminServiceServer struct{}
+
+func (UnimplementedMatchAdminServiceServer) SetMatchOverride(context.Context, *SetMatchOverrideRequest) (*SetMatchOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMatchOverride not implemented")
+}
+func (UnimplementedMatchAdminServiceServer) DeleteMatchOverride(context.Context, *DeleteMatchOverrideRequest) (*DeleteMatchOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMatchOverride not implemented")
+}
+func (UnimplementedMatchAdminServiceServer) ListMatchOverrides(context.Context, *ListMatchOverridesRequest) (*ListMatchOverridesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMatchOverrides not implemented")
+}
+func (UnimplementedMatchAdminServiceServer) mustEmbedUnimplementedMatchAdminServiceServer() {}
+func (UnimplementedMatchAdminServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMatchAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchAdminServiceServer will
+// result in compilation errors.
+type UnsafeMatchAdminServiceServer interface {
+	mustEmbedUnimplementedMatchAdminServiceServer()
+}
+
+func RegisterMatchAdminServiceServer(s grpc.ServiceRegistrar, srv MatchAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedMatchAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MatchAdminService_ServiceDesc, srv)
+}
+
+func _MatchAdminService_SetMatchOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMatchOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdminServiceServer).SetMatchOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdminService_SetMatchOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdminServiceServer).SetMatchOverride(ctx, req.(*SetMatchOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchAdminService_DeleteMatchOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMatchOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdminServiceServer).DeleteMatchOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdminService_DeleteMatchOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdminServiceServer).DeleteMatchOverride(ctx, req.(*DeleteMatchOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchAdminService_ListMatchOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchAdminServiceServer).ListMatchOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchAdminService_ListMatchOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchAdminServiceServer).ListMatchOverrides(ctx, req.(*ListMatchOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MatchAdminService_ServiceDesc is the grpc.ServiceDesc for MatchAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "match.v1.MatchAdminService",
+	HandlerType: (*MatchAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMatchOverride",
+			Handler:    _MatchAdminService_SetMatchOverride_Handler,
+		},
+		{
+			MethodName: "DeleteMatchOverride",
+			Handler:    _MatchAdminService_DeleteMatchOverride_Handler,
+		},
+		{
+			MethodName: "ListMatchOverrides",
+			Handler:    _MatchAdminService_ListMatchOverrides_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "match/v1/match_adapter.proto",